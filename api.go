@@ -1,94 +1,223 @@
 package main
 
 import (
+	"context"
 	"encoding/csv"
+	"fmt"
 	"io"
 	"net/http"
+	"regexp"
 	"strconv"
 	"time"
 
-	"github.com/gin-contrib/cache"
-	"github.com/gin-contrib/cache/persistence"
 	"github.com/gin-gonic/gin"
-	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
 
 	"go.elastic.co/apm/v2"
 )
 
-func addAPIHandlers(r *gin.RouterGroup, db *sqlx.DB) {
-	h := apiHandlers{db}
-	r.GET("/stats", h.getStats)
-	r.GET("/products", h.getProducts)
-	r.GET("/products/:id", h.getProductDetails)
-	r.GET("/products/:id/customers", h.getProductCustomers)
-	r.GET("/types", h.getProductTypes)
-	r.GET("/types/:id", h.getProductTypeDetails)
-	r.GET("/customers", h.getCustomers)
-	r.GET("/customers/:id", h.getCustomerDetails)
-	r.GET("/orders", h.getOrders)
-	r.GET("/orders/:id", h.getOrderDetails)
+func addAPIHandlers(r *gin.RouterGroup, store Store, related *relatedProducts) {
+	h := apiHandlers{store: store, related: related}
+	stats := cacheControl(statsCacheControl)
+	public := cacheControl(publicCacheControl)
+	private := cacheControl(privateCacheControl)
+	r.GET("/stats", stats, h.getStats)
+	r.GET("/stats/timeseries", stats, h.getStatsTimeSeries)
+	r.GET("/stats/types", stats, h.getSalesBreakdown(salesByProductType))
+	r.GET("/stats/countries", stats, h.getSalesBreakdown(salesByCountry))
+	r.GET("/stats/cities", stats, h.getSalesBreakdown(salesByCity))
+	r.GET("/products", public, h.getProducts)
+	r.POST("/products", h.postProduct)
+	r.GET("/products/search", public, h.searchProducts)
+	r.GET("/products/:id", public, h.getProductDetails)
+	r.PUT("/products/:id", h.putProduct)
+	r.PATCH("/products/:id", h.patchProduct)
+	r.DELETE("/products/:id", h.deleteProduct)
+	r.GET("/products/:id/customers", private, h.getProductCustomers)
+	r.GET("/products/:id/related", public, h.getRelatedProducts)
+	r.GET("/types", public, h.getProductTypes)
+	r.GET("/types/:id", public, h.getProductTypeDetails)
+	r.GET("/customers", private, h.getCustomers)
+	r.POST("/customers", h.postCustomer)
+	r.GET("/customers/:id", private, h.getCustomerDetails)
+	r.PUT("/customers/:id", h.putCustomer)
+	r.PATCH("/customers/:id", h.patchCustomer)
+	r.DELETE("/customers/:id", h.deleteCustomer)
+	r.GET("/customers/:id/orders", private, h.getCustomerOrders)
+	r.GET("/orders", private, h.getOrders)
+	r.GET("/orders/:id", private, h.getOrderDetails)
+	r.POST("/orders/:id/transitions", h.postOrderTransition)
 	r.POST("/orders", h.postOrder)
 	r.POST("/orders/csv", h.postOrderCSV)
 }
 
 type apiHandlers struct {
-	db *sqlx.DB
+	store   Store
+	related *relatedProducts
+}
+
+const statsCacheKey = "shop-stats"
+
+// errNotFound is returned by the fetch functions passed to
+// serveCachedJSON when the requested resource does not exist.
+var errNotFound = errors.New("not found")
+
+// invalidateCache invalidates the cache entries with the given
+// tags, so that subsequent requests observe the results of writes.
+func invalidateCache(c *gin.Context, tags ...string) {
+	if err := requestCache(c).invalidate(tags...); err != nil {
+		contextLogger(c).WithError(err).Warn("failed to invalidate cache")
+	}
+}
+
+// abortWithJSONError aborts the request with the given status code,
+// recording err and describing it to the client in the response body.
+func abortWithJSONError(c *gin.Context, code int, err error) {
+	c.Error(err)
+	c.AbortWithStatusJSON(code, gin.H{"error": err.Error()})
 }
 
 func (h apiHandlers) getStats(c *gin.Context) {
-	cacheValue, _ := c.Get(cache.CACHE_MIDDLEWARE_KEY)
-	cache := *cacheValue.(*persistence.CacheStore)
-
-	const cacheKey = "shop-stats"
-	var stats *Stats
-	err := cache.Get(cacheKey, &stats)
-	switch err {
-	case nil:
-		contextLogger(c).Debug("serving stats from cache")
-		c.JSON(http.StatusOK, stats)
-		if tx := apm.TransactionFromContext(c.Request.Context()); tx != nil {
-			tx.Context.SetLabel("served_from_cache", "true")
-		}
+	if checkNotModified(c, []string{statsCacheTag}) {
 		return
-	case persistence.ErrCacheMiss:
-		// fetch and cache below
-		if tx := apm.TransactionFromContext(c.Request.Context()); tx != nil {
-			tx.Context.SetLabel("served_from_cache", "false")
-		}
-		break
-	default:
-		err := errors.Wrap(err, "failed to get stats from cache")
-		c.AbortWithError(http.StatusInternalServerError, err)
+	}
+	serveCachedJSON(c, statsCacheKey, []string{statsCacheTag}, h.store.getStats)
+}
+
+func (h apiHandlers) getStatsTimeSeries(c *gin.Context) {
+	interval := statsInterval(c.DefaultQuery("interval", string(statsIntervalDay)))
+	if !interval.Valid() {
+		err := errors.Errorf("invalid interval %q, expected hour, day or week", interval)
+		abortWithJSONError(c, http.StatusBadRequest, err)
+		return
+	}
+	from, err := queryTime(c, "from")
+	if err != nil {
+		abortWithJSONError(c, http.StatusBadRequest, err)
+		return
+	}
+	to, err := queryTime(c, "to")
+	if err != nil {
+		abortWithJSONError(c, http.StatusBadRequest, err)
+		return
+	}
+	if to.IsZero() {
+		// Default to the end of the current bucket, so the
+		// cache key is stable for the duration of the bucket.
+		to = interval.Truncate(time.Now()).Add(interval.Duration())
+	}
+	if from.IsZero() {
+		from = to.Add(-interval.DefaultRange())
+	}
+	if !from.Before(to) {
+		abortWithJSONError(c, http.StatusBadRequest, errors.New("from must be before to"))
+		return
+	}
+	if n := to.Sub(from) / interval.Duration(); n > maxStatsBuckets {
+		err := errors.Errorf("time range spans %d buckets, exceeding the maximum of %d", n, maxStatsBuckets)
+		abortWithJSONError(c, http.StatusBadRequest, err)
 		return
 	}
 
-	stats, err = getStats(c.Request.Context(), h.db)
+	if checkNotModified(c, []string{statsCacheTag}, strconv.FormatInt(from.Unix(), 10), strconv.FormatInt(to.Unix(), 10)) {
+		return
+	}
+	cacheKey := fmt.Sprintf("%s-timeseries:%s:%d:%d", statsCacheKey, interval, from.Unix(), to.Unix())
+	serveCachedJSON(c, cacheKey, []string{statsCacheTag}, func(ctx context.Context) ([]TimeSeriesBucket, error) {
+		return h.store.getStatsTimeSeries(ctx, interval, from, to)
+	})
+}
+
+// getSalesBreakdown returns a handler responding with sales numbers
+// segmented by the given dimension. The optional "limit" query
+// parameter restricts the response to the top segments by revenue.
+func (h apiHandlers) getSalesBreakdown(dimension salesDimension) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		limit, err := queryInt(c, "limit")
+		if err != nil || limit != nil && *limit <= 0 {
+			abortWithJSONError(c, http.StatusBadRequest, errors.Errorf("invalid limit %q", c.Query("limit")))
+			return
+		}
+		var n int
+		if limit != nil {
+			n = *limit
+		}
+		if checkNotModified(c, []string{statsCacheTag}) {
+			return
+		}
+		cacheKey := fmt.Sprintf("%s-%s:%d", statsCacheKey, dimension, n)
+		serveCachedJSON(c, cacheKey, []string{statsCacheTag}, func(ctx context.Context) ([]SalesBreakdown, error) {
+			return h.store.getSalesBreakdown(ctx, dimension, n)
+		})
+	}
+}
+
+// serveCachedJSON responds with the JSON encoding of the value cached
+// under key with the given tags, calling fetch to obtain the value if it is
+// not cached. Concurrent fetches are coalesced, and stale values are served
+// while being refreshed; see getOrFetch. If fetch returns errNotFound, the
+// response is 404.
+func serveCachedJSON[T any](c *gin.Context, key string, tags []string, fetch func(context.Context) (T, error)) {
+	cache := requestCache(c)
+	key, err := cache.taggedKey(key, tags...)
 	if err != nil {
-		err := errors.Wrap(err, "failed to query stats")
 		c.AbortWithError(http.StatusInternalServerError, err)
 		return
 	}
-	if err := cache.Set(cacheKey, stats, time.Minute); err != nil {
-		err := errors.Wrap(err, "failed to cache stats")
+
+	value, status, err := getOrFetch(c.Request.Context(), cache, key, fetch)
+	if tx := apm.TransactionFromContext(c.Request.Context()); tx != nil {
+		servedFromCache := status == cacheFresh || status == cacheStale
+		tx.Context.SetLabel("served_from_cache", strconv.FormatBool(servedFromCache))
+		if status != "" {
+			tx.Context.SetLabel("cache_status", string(status))
+		}
+	}
+	if err != nil {
+		if err == errNotFound {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		err := errors.Wrapf(err, "failed to get %q", key)
 		c.AbortWithError(http.StatusInternalServerError, err)
 		return
 	}
-	contextLogger(c).Debug("cached stats")
-	c.JSON(http.StatusOK, stats)
+	contextLogger(c).Debugf("serving %q (%s)", key, status)
+	c.JSON(http.StatusOK, value)
 }
 
 func (h apiHandlers) getProducts(c *gin.Context) {
-	products, err := getProducts(c.Request.Context(), h.db)
+	page, err := parsePageRequest(c, productSortColumns...)
+	if err != nil {
+		abortWithJSONError(c, http.StatusBadRequest, err)
+		return
+	}
+	var filter productFilter
+	if filter.TypeID, err = queryInt(c, "type_id"); err != nil {
+		abortWithJSONError(c, http.StatusBadRequest, err)
+		return
+	}
+	if filter.MinStock, err = queryInt(c, "min_stock"); err != nil {
+		abortWithJSONError(c, http.StatusBadRequest, err)
+		return
+	}
+	if filter.MaxStock, err = queryInt(c, "max_stock"); err != nil {
+		abortWithJSONError(c, http.StatusBadRequest, err)
+		return
+	}
+	if checkNotModified(c, []string{productsCacheTag}) {
+		return
+	}
+	products, err := h.store.getProducts(c.Request.Context(), filter, page)
 	if err != nil {
 		c.AbortWithError(http.StatusInternalServerError, err)
 		return
 	}
-	c.JSON(http.StatusOK, products)
+	c.JSON(http.StatusOK, paginate(c, page, products, func(p Product) int { return p.ID }))
 }
 
 func (h apiHandlers) getTopProducts(c *gin.Context) {
-	products, err := getTopProducts(c.Request.Context(), h.db)
+	products, err := h.store.getTopProducts(c.Request.Context())
 	if err != nil {
 		c.AbortWithError(http.StatusInternalServerError, err)
 		return
@@ -99,7 +228,10 @@ func (h apiHandlers) getTopProducts(c *gin.Context) {
 func (h apiHandlers) getProductDetails(c *gin.Context) {
 	idString := c.Param("id")
 	if idString == "top" {
-		products, err := getTopProducts(c.Request.Context(), h.db)
+		if checkNotModified(c, []string{productsCacheTag, ordersCacheTag}) {
+			return
+		}
+		products, err := h.store.getTopProducts(c.Request.Context())
 		if err != nil {
 			c.AbortWithError(http.StatusInternalServerError, err)
 			return
@@ -115,7 +247,152 @@ func (h apiHandlers) getProductDetails(c *gin.Context) {
 		c.AbortWithError(http.StatusInternalServerError, err)
 		return
 	}
-	product, err := getProduct(c.Request.Context(), h.db, id)
+	if checkNotModified(c, []string{productsCacheTag}) {
+		return
+	}
+	serveCachedJSON(c, "product:"+strconv.Itoa(id), []string{productsCacheTag}, func(ctx context.Context) (*Product, error) {
+		product, err := h.store.getProduct(ctx, id)
+		if err == nil && product == nil {
+			err = errNotFound
+		}
+		return product, err
+	})
+}
+
+func (h apiHandlers) searchProducts(c *gin.Context) {
+	query := c.Query("q")
+	if query == "" {
+		abortWithJSONError(c, http.StatusBadRequest, errors.New("missing search query"))
+		return
+	}
+	limit := 20
+	if limitString := c.Query("limit"); limitString != "" {
+		var err error
+		if limit, err = strconv.Atoi(limitString); err != nil || limit <= 0 {
+			abortWithJSONError(c, http.StatusBadRequest, errors.Errorf("invalid limit %q", limitString))
+			return
+		}
+		if limit > 100 {
+			limit = 100
+		}
+	}
+	if checkNotModified(c, []string{productsCacheTag}) {
+		return
+	}
+	results, err := h.store.searchProducts(c.Request.Context(), query, limit)
+	if err != nil {
+		err := errors.Wrap(err, "failed to search products")
+		c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	if results == nil {
+		results = []ProductSearchResult{}
+	}
+	c.JSON(http.StatusOK, results)
+}
+
+// productRequest holds the client-provided fields
+// for creating or replacing a product.
+type productRequest struct {
+	SKU          string `json:"sku" binding:"required,max=64"`
+	Name         string `json:"name" binding:"required,max=255"`
+	Description  string `json:"description" binding:"max=4096"`
+	TypeID       int    `json:"type_id" binding:"required,min=1"`
+	Stock        int    `json:"stock" binding:"min=0"`
+	Cost         int    `json:"cost" binding:"min=0"`
+	SellingPrice int    `json:"selling_price" binding:"required,min=1"`
+}
+
+func (r *productRequest) apply(p *Product) {
+	p.SKU = r.SKU
+	p.Name = r.Name
+	p.Description = r.Description
+	p.TypeID = r.TypeID
+	p.Stock = r.Stock
+	p.Cost = r.Cost
+	p.SellingPrice = r.SellingPrice
+}
+
+// productPatchRequest holds the client-provided fields
+// for partially updating a product.
+type productPatchRequest struct {
+	SKU          *string `json:"sku" binding:"omitempty,min=1,max=64"`
+	Name         *string `json:"name" binding:"omitempty,min=1,max=255"`
+	Description  *string `json:"description" binding:"omitempty,max=4096"`
+	TypeID       *int    `json:"type_id" binding:"omitempty,min=1"`
+	Stock        *int    `json:"stock" binding:"omitempty,min=0"`
+	Cost         *int    `json:"cost" binding:"omitempty,min=0"`
+	SellingPrice *int    `json:"selling_price" binding:"omitempty,min=1"`
+}
+
+func (r *productPatchRequest) apply(p *Product) {
+	if r.SKU != nil {
+		p.SKU = *r.SKU
+	}
+	if r.Name != nil {
+		p.Name = *r.Name
+	}
+	if r.Description != nil {
+		p.Description = *r.Description
+	}
+	if r.TypeID != nil {
+		p.TypeID = *r.TypeID
+	}
+	if r.Stock != nil {
+		p.Stock = *r.Stock
+	}
+	if r.Cost != nil {
+		p.Cost = *r.Cost
+	}
+	if r.SellingPrice != nil {
+		p.SellingPrice = *r.SellingPrice
+	}
+}
+
+func (h apiHandlers) postProduct(c *gin.Context) {
+	var req productRequest
+	if err := c.BindJSON(&req); err != nil {
+		return
+	}
+	var product Product
+	req.apply(&product)
+	id, err := h.store.createProduct(c.Request.Context(), &product)
+	if err != nil {
+		abortWithProductError(c, errors.Wrap(err, "failed to create product"))
+		return
+	}
+	invalidateCache(c, statsCacheTag, productsCacheTag)
+	h.writeProduct(c, http.StatusCreated, id)
+}
+
+func (h apiHandlers) putProduct(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		err := errors.Wrap(err, "failed to parse product ID")
+		c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+	var req productRequest
+	if err := c.BindJSON(&req); err != nil {
+		return
+	}
+	product := Product{ID: id}
+	req.apply(&product)
+	h.updateProduct(c, &product)
+}
+
+func (h apiHandlers) patchProduct(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		err := errors.Wrap(err, "failed to parse product ID")
+		c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+	var req productPatchRequest
+	if err := c.BindJSON(&req); err != nil {
+		return
+	}
+	product, err := h.store.getProduct(c.Request.Context(), id)
 	if err != nil {
 		err := errors.Wrap(err, "failed to get product")
 		c.AbortWithError(http.StatusInternalServerError, err)
@@ -125,7 +402,68 @@ func (h apiHandlers) getProductDetails(c *gin.Context) {
 		c.AbortWithStatus(http.StatusNotFound)
 		return
 	}
-	c.JSON(http.StatusOK, product)
+	req.apply(product)
+	h.updateProduct(c, product)
+}
+
+func (h apiHandlers) updateProduct(c *gin.Context, product *Product) {
+	found, err := h.store.updateProduct(c.Request.Context(), product)
+	if err != nil {
+		abortWithProductError(c, errors.Wrap(err, "failed to update product"))
+		return
+	}
+	if !found {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+	invalidateCache(c, statsCacheTag, productsCacheTag)
+	h.writeProduct(c, http.StatusOK, product.ID)
+}
+
+func (h apiHandlers) deleteProduct(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		err := errors.Wrap(err, "failed to parse product ID")
+		c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+	found, err := h.store.deleteProduct(c.Request.Context(), id)
+	if err != nil {
+		abortWithProductError(c, errors.Wrap(err, "failed to delete product"))
+		return
+	}
+	if !found {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+	invalidateCache(c, statsCacheTag, productsCacheTag)
+	c.Status(http.StatusNoContent)
+}
+
+// writeProduct responds with the current state of the product with the given ID.
+func (h apiHandlers) writeProduct(c *gin.Context, code int, id int) {
+	product, err := h.store.getProduct(c.Request.Context(), id)
+	if err != nil {
+		err := errors.Wrap(err, "failed to get product")
+		c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	if product == nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+	c.JSON(code, product)
+}
+
+func abortWithProductError(c *gin.Context, err error) {
+	switch errors.Cause(err) {
+	case errDuplicateSKU, errProductHasOrders:
+		abortWithJSONError(c, http.StatusConflict, err)
+	case errUnknownProductType:
+		abortWithJSONError(c, http.StatusBadRequest, err)
+	default:
+		c.AbortWithError(http.StatusInternalServerError, err)
+	}
 }
 
 func (h apiHandlers) getProductCustomers(c *gin.Context) {
@@ -134,24 +472,53 @@ func (h apiHandlers) getProductCustomers(c *gin.Context) {
 		c.AbortWithError(http.StatusInternalServerError, err)
 		return
 	}
-	limit := 1000
-	if countString := c.Param("count"); countString != "" {
-		limit, err = strconv.Atoi(countString)
-		if err != nil {
-			c.AbortWithError(http.StatusInternalServerError, err)
-			return
-		}
+	page, err := parsePageRequest(c, customerSortColumns...)
+	if err != nil {
+		abortWithJSONError(c, http.StatusBadRequest, err)
+		return
 	}
-	customers, err := getProductCustomers(c.Request.Context(), h.db, id, limit)
+	if checkNotModified(c, []string{productsCacheTag, customersCacheTag, ordersCacheTag}) {
+		return
+	}
+	customers, err := h.store.getProductCustomers(c.Request.Context(), id, page)
 	if err != nil {
 		c.AbortWithError(http.StatusInternalServerError, err)
 		return
 	}
-	c.JSON(http.StatusOK, customers)
+	c.JSON(http.StatusOK, paginate(c, page, customers, func(c Customer) int { return c.ID }))
+}
+
+func (h apiHandlers) getRelatedProducts(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		err := errors.Wrap(err, "failed to parse product ID")
+		c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+	limit := 5
+	if n, err := queryInt(c, "limit"); err != nil || n != nil && *n <= 0 {
+		abortWithJSONError(c, http.StatusBadRequest, errors.Errorf("invalid limit %q", c.Query("limit")))
+		return
+	} else if n != nil {
+		limit = *n
+	}
+	related, ok := h.related.get(id, limit)
+	if !ok {
+		c.Header("Retry-After", "5")
+		abortWithJSONError(c, http.StatusServiceUnavailable, errors.New("related products are being computed"))
+		return
+	}
+	if related == nil {
+		related = []RelatedProduct{}
+	}
+	c.JSON(http.StatusOK, related)
 }
 
 func (h apiHandlers) getProductTypes(c *gin.Context) {
-	productTypes, err := getProductTypes(c.Request.Context(), h.db)
+	if checkNotModified(c, []string{productsCacheTag}) {
+		return
+	}
+	productTypes, err := h.store.getProductTypes(c.Request.Context())
 	if err != nil {
 		c.AbortWithError(http.StatusInternalServerError, err)
 		return
@@ -166,7 +533,10 @@ func (h apiHandlers) getProductTypeDetails(c *gin.Context) {
 		c.AbortWithError(http.StatusInternalServerError, err)
 		return
 	}
-	productType, err := getProductType(c.Request.Context(), h.db, id)
+	if checkNotModified(c, []string{productsCacheTag}) {
+		return
+	}
+	productType, err := h.store.getProductType(c.Request.Context(), id)
 	if err != nil {
 		err := errors.Wrap(err, "failed to get product type details")
 		c.AbortWithError(http.StatusInternalServerError, err)
@@ -180,12 +550,24 @@ func (h apiHandlers) getProductTypeDetails(c *gin.Context) {
 }
 
 func (h apiHandlers) getCustomers(c *gin.Context) {
-	customers, err := getCustomers(c.Request.Context(), h.db)
+	page, err := parsePageRequest(c, customerSortColumns...)
+	if err != nil {
+		abortWithJSONError(c, http.StatusBadRequest, err)
+		return
+	}
+	filter := customerFilter{
+		Country: c.Query("country"),
+		City:    c.Query("city"),
+	}
+	if checkNotModified(c, []string{customersCacheTag}) {
+		return
+	}
+	customers, err := h.store.getCustomers(c.Request.Context(), filter, page)
 	if err != nil {
 		c.AbortWithError(http.StatusInternalServerError, err)
 		return
 	}
-	c.JSON(http.StatusOK, customers)
+	c.JSON(http.StatusOK, paginate(c, page, customers, func(c Customer) int { return c.ID }))
 }
 
 func (h apiHandlers) getCustomerDetails(c *gin.Context) {
@@ -195,7 +577,173 @@ func (h apiHandlers) getCustomerDetails(c *gin.Context) {
 		c.AbortWithError(http.StatusInternalServerError, err)
 		return
 	}
-	customer, err := getCustomer(c.Request.Context(), h.db, id)
+	if checkNotModified(c, []string{customerCacheTag(id)}) {
+		return
+	}
+	cacheKey := "customer:" + strconv.Itoa(id)
+	serveCachedJSON(c, cacheKey, []string{customerCacheTag(id)}, func(ctx context.Context) (*CustomerDetails, error) {
+		customer, err := h.store.getCustomerDetails(ctx, id)
+		if err == nil && customer == nil {
+			err = errNotFound
+		}
+		return customer, err
+	})
+}
+
+func (h apiHandlers) getCustomerOrders(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		err := errors.Wrap(err, "failed to parse customer ID")
+		c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+	page, err := parsePageRequest(c, orderSortColumns...)
+	if err != nil {
+		abortWithJSONError(c, http.StatusBadRequest, err)
+		return
+	}
+	if checkNotModified(c, []string{customerCacheTag(id), ordersCacheTag}) {
+		return
+	}
+	customer, err := h.store.getCustomer(c.Request.Context(), id)
+	if err != nil {
+		err := errors.Wrap(err, "failed to get customer")
+		c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	if customer == nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+	orders, err := h.store.getOrders(c.Request.Context(), orderFilter{CustomerID: &id}, page)
+	if err != nil {
+		err := errors.Wrap(err, "failed to get customer orders")
+		c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	c.JSON(http.StatusOK, paginate(c, page, orders, func(o Order) int { return o.ID }))
+}
+
+// postalCodeRegexp matches postal codes in the formats used
+// around the world: 2-10 letters and digits, optionally
+// separated by spaces or hyphens.
+var postalCodeRegexp = regexp.MustCompile(`^[A-Za-z0-9](?:[A-Za-z0-9 -]{0,8}[A-Za-z0-9])?$`)
+
+// customerRequest holds the client-provided fields
+// for creating or replacing a customer.
+type customerRequest struct {
+	FullName    string `json:"full_name" binding:"required,max=255"`
+	CompanyName string `json:"company_name" binding:"max=255"`
+	Email       string `json:"email" binding:"required,email,max=255"`
+	Address     string `json:"address" binding:"required,max=255"`
+	PostalCode  string `json:"postal_code" binding:"required"`
+	City        string `json:"city" binding:"required,max=255"`
+	Country     string `json:"country" binding:"required,max=255"`
+}
+
+func (r *customerRequest) apply(c *Customer) {
+	c.FullName = r.FullName
+	c.CompanyName = r.CompanyName
+	c.Email = r.Email
+	c.Address = r.Address
+	c.PostalCode = r.PostalCode
+	c.City = r.City
+	c.Country = r.Country
+}
+
+// customerPatchRequest holds the client-provided fields
+// for partially updating a customer.
+type customerPatchRequest struct {
+	FullName    *string `json:"full_name" binding:"omitempty,min=1,max=255"`
+	CompanyName *string `json:"company_name" binding:"omitempty,max=255"`
+	Email       *string `json:"email" binding:"omitempty,email,max=255"`
+	Address     *string `json:"address" binding:"omitempty,min=1,max=255"`
+	PostalCode  *string `json:"postal_code"`
+	City        *string `json:"city" binding:"omitempty,min=1,max=255"`
+	Country     *string `json:"country" binding:"omitempty,min=1,max=255"`
+}
+
+func (r *customerPatchRequest) apply(c *Customer) {
+	if r.FullName != nil {
+		c.FullName = *r.FullName
+	}
+	if r.CompanyName != nil {
+		c.CompanyName = *r.CompanyName
+	}
+	if r.Email != nil {
+		c.Email = *r.Email
+	}
+	if r.Address != nil {
+		c.Address = *r.Address
+	}
+	if r.PostalCode != nil {
+		c.PostalCode = *r.PostalCode
+	}
+	if r.City != nil {
+		c.City = *r.City
+	}
+	if r.Country != nil {
+		c.Country = *r.Country
+	}
+}
+
+func validateCustomer(c *Customer) error {
+	if !postalCodeRegexp.MatchString(c.PostalCode) {
+		return errors.Errorf("invalid postal code %q", c.PostalCode)
+	}
+	return nil
+}
+
+func (h apiHandlers) postCustomer(c *gin.Context) {
+	var req customerRequest
+	if err := c.BindJSON(&req); err != nil {
+		return
+	}
+	var customer Customer
+	req.apply(&customer)
+	if err := validateCustomer(&customer); err != nil {
+		abortWithJSONError(c, http.StatusBadRequest, err)
+		return
+	}
+	id, err := h.store.createCustomer(c.Request.Context(), &customer)
+	if err != nil {
+		err := errors.Wrap(err, "failed to create customer")
+		c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	invalidateCache(c, statsCacheTag, customersCacheTag)
+	customer.ID = id
+	c.JSON(http.StatusCreated, customer)
+}
+
+func (h apiHandlers) putCustomer(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		err := errors.Wrap(err, "failed to parse customer ID")
+		c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+	var req customerRequest
+	if err := c.BindJSON(&req); err != nil {
+		return
+	}
+	customer := Customer{ID: id}
+	req.apply(&customer)
+	h.updateCustomer(c, &customer)
+}
+
+func (h apiHandlers) patchCustomer(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		err := errors.Wrap(err, "failed to parse customer ID")
+		c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+	var req customerPatchRequest
+	if err := c.BindJSON(&req); err != nil {
+		return
+	}
+	customer, err := h.store.getCustomer(c.Request.Context(), id)
 	if err != nil {
 		err := errors.Wrap(err, "failed to get customer details")
 		c.AbortWithError(http.StatusInternalServerError, err)
@@ -205,16 +753,100 @@ func (h apiHandlers) getCustomerDetails(c *gin.Context) {
 		c.AbortWithStatus(http.StatusNotFound)
 		return
 	}
+	req.apply(customer)
+	h.updateCustomer(c, customer)
+}
+
+func (h apiHandlers) updateCustomer(c *gin.Context, customer *Customer) {
+	if err := validateCustomer(customer); err != nil {
+		abortWithJSONError(c, http.StatusBadRequest, err)
+		return
+	}
+	found, err := h.store.updateCustomer(c.Request.Context(), customer)
+	if err != nil {
+		err := errors.Wrap(err, "failed to update customer")
+		c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	if !found {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+	invalidateCache(c, statsCacheTag, customersCacheTag, customerCacheTag(customer.ID))
 	c.JSON(http.StatusOK, customer)
 }
 
+// deleteCustomer deletes a customer. Customers with orders are
+// refused deletion, unless the "anonymize" query parameter is
+// true, in which case their personal details are removed instead.
+func (h apiHandlers) deleteCustomer(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		err := errors.Wrap(err, "failed to parse customer ID")
+		c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+	anonymize, _ := strconv.ParseBool(c.Query("anonymize"))
+
+	found, err := h.store.deleteCustomer(c.Request.Context(), id)
+	if errors.Cause(err) == errCustomerHasOrders {
+		if !anonymize {
+			err := errors.Wrap(err, "refusing to delete customer; set anonymize=true to remove their personal details instead")
+			abortWithJSONError(c, http.StatusConflict, err)
+			return
+		}
+		found, err = h.store.anonymizeCustomer(c.Request.Context(), id)
+		if err == nil && found {
+			invalidateCache(c, statsCacheTag, customersCacheTag, customerCacheTag(id))
+			h.getCustomerDetails(c)
+			return
+		}
+	}
+	if err != nil {
+		err := errors.Wrap(err, "failed to delete customer")
+		c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	if !found {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+	invalidateCache(c, statsCacheTag, customersCacheTag, customerCacheTag(id))
+	c.Status(http.StatusNoContent)
+}
+
 func (h apiHandlers) getOrders(c *gin.Context) {
-	orders, err := getOrders(c.Request.Context(), h.db)
+	page, err := parsePageRequest(c, orderSortColumns...)
+	if err != nil {
+		abortWithJSONError(c, http.StatusBadRequest, err)
+		return
+	}
+	var filter orderFilter
+	if filter.From, err = queryTime(c, "from"); err != nil {
+		abortWithJSONError(c, http.StatusBadRequest, err)
+		return
+	}
+	if filter.To, err = queryTime(c, "to"); err != nil {
+		abortWithJSONError(c, http.StatusBadRequest, err)
+		return
+	}
+	if filter.CustomerID, err = queryInt(c, "customer_id"); err != nil {
+		abortWithJSONError(c, http.StatusBadRequest, err)
+		return
+	}
+	if filter.ProductID, err = queryInt(c, "product_id"); err != nil {
+		abortWithJSONError(c, http.StatusBadRequest, err)
+		return
+	}
+	if checkNotModified(c, []string{ordersCacheTag, customersCacheTag}) {
+		return
+	}
+	orders, err := h.store.getOrders(c.Request.Context(), filter, page)
 	if err != nil {
 		c.AbortWithError(http.StatusInternalServerError, err)
 		return
 	}
-	c.JSON(http.StatusOK, orders)
+	c.JSON(http.StatusOK, paginate(c, page, orders, func(o Order) int { return o.ID }))
 }
 
 func (h apiHandlers) getOrderDetails(c *gin.Context) {
@@ -223,7 +855,11 @@ func (h apiHandlers) getOrderDetails(c *gin.Context) {
 		c.AbortWithError(http.StatusInternalServerError, err)
 		return
 	}
-	customer, err := getOrder(c.Request.Context(), h.db, id)
+	// Order lines include the products' current details.
+	if checkNotModified(c, []string{ordersCacheTag, customersCacheTag, productsCacheTag}) {
+		return
+	}
+	customer, err := h.store.getOrder(c.Request.Context(), id)
 	if err != nil {
 		c.AbortWithError(http.StatusInternalServerError, err)
 		return
@@ -235,6 +871,53 @@ func (h apiHandlers) getOrderDetails(c *gin.Context) {
 	c.JSON(http.StatusOK, customer)
 }
 
+func (h apiHandlers) postOrderTransition(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		err := errors.Wrap(err, "failed to parse order ID")
+		c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+	var transition struct {
+		Status OrderStatus `json:"status" binding:"required"`
+	}
+	if err := c.BindJSON(&transition); err != nil {
+		return
+	}
+	if !transition.Status.Valid() {
+		err := errors.Errorf("invalid order status %q", transition.Status)
+		abortWithJSONError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	found, err := h.store.transitionOrder(c.Request.Context(), id, transition.Status)
+	if err != nil {
+		if _, ok := errors.Cause(err).(*invalidTransitionError); ok {
+			abortWithJSONError(c, http.StatusConflict, err)
+			return
+		}
+		err := errors.Wrap(err, "failed to transition order")
+		c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	if !found {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+	order, err := h.store.getOrder(c.Request.Context(), id)
+	if err != nil {
+		c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	if order == nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+	// Cancelling an order restocks its products.
+	invalidateCache(c, statsCacheTag, productsCacheTag, ordersCacheTag, customerCacheTag(order.CustomerID))
+	c.JSON(http.StatusOK, order)
+}
+
 func (h apiHandlers) postOrder(c *gin.Context) {
 	type line struct {
 		ID     int `json:"id" binding:"required"`
@@ -310,17 +993,36 @@ func (h apiHandlers) postOrderCSV(c *gin.Context) {
 }
 
 func (h apiHandlers) postOrderCommon(c *gin.Context, customerID int, lines []ProductOrderLine) {
-	customer, err := getCustomer(c.Request.Context(), h.db, customerID)
-	if err != nil {
+	for _, line := range lines {
+		if line.Amount <= 0 {
+			err := errors.Errorf("invalid amount %d for product %d", line.Amount, line.Product.ID)
+			abortWithJSONError(c, http.StatusBadRequest, err)
+			return
+		}
+	}
+	customer, err := h.store.getCustomer(c.Request.Context(), customerID)
+	if err != nil || customer == nil {
 		c.AbortWithStatus(http.StatusNotFound)
 		return
 	}
-	orderID, err := createOrder(c.Request.Context(), h.db, customer, lines)
+	orderID, err := h.store.createOrder(c.Request.Context(), customer, lines)
 	if err != nil {
-		err := errors.Wrap(err, "failed to create order")
-		c.AbortWithError(http.StatusInternalServerError, err)
+		switch cause := errors.Cause(err).(type) {
+		case *insufficientStockError:
+			c.Error(err)
+			c.AbortWithStatusJSON(http.StatusConflict, gin.H{
+				"error": err.Error(),
+				"lines": cause.Lines,
+			})
+		case *unknownProductError:
+			abortWithJSONError(c, http.StatusBadRequest, err)
+		default:
+			err := errors.Wrap(err, "failed to create order")
+			c.AbortWithError(http.StatusInternalServerError, err)
+		}
 		return
 	}
+	invalidateCache(c, statsCacheTag, productsCacheTag, ordersCacheTag, customerCacheTag(customerID))
 
 	if tx := apm.TransactionFromContext(c.Request.Context()); tx != nil {
 		tx.Context.SetLabel("customer_name", customer.FullName)
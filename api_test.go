@@ -0,0 +1,250 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-contrib/cache"
+	"github.com/gin-contrib/cache/persistence"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	opbeansdb "github.com/elastic/opbeans-go/db"
+)
+
+func newTestServer(t *testing.T) (*httptest.Server, *memoryStore) {
+	return newTestServerWithCache(t, newTaggedCache(persistence.NewInMemoryStore(time.Minute), nil))
+}
+
+func newTestServerWithCache(t *testing.T, taggedCache *taggedCache) (*httptest.Server, *memoryStore) {
+	gin.SetMode(gin.TestMode)
+	store := newMemoryStore()
+	require.NoError(t, store.seed(opbeansdb.SeedConfig{
+		Seed:   1,
+		Time:   seedEpoch,
+		Orders: 100,
+	}))
+
+	r := gin.New()
+	cacheStore := persistence.CacheStore(taggedCache)
+	r.Use(cache.Cache(&cacheStore))
+	addAPIHandlers(r.Group("/api"), store, newRelatedProducts(store))
+	srv := httptest.NewServer(r)
+	t.Cleanup(srv.Close)
+	return srv, store
+}
+
+func doJSON(t *testing.T, method, url, body string, out interface{}) *http.Response {
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	if out != nil {
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(out))
+	}
+	return resp
+}
+
+func TestMemoryStoreStats(t *testing.T) {
+	srv, _ := newTestServer(t)
+	var stats Stats
+	resp := doJSON(t, "GET", srv.URL+"/api/stats", "", &stats)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 9, stats.Products)
+	assert.Equal(t, 1000, stats.Customers)
+	assert.Equal(t, 100, stats.Orders)
+}
+
+func TestMemoryStorePagination(t *testing.T) {
+	srv, _ := newTestServer(t)
+
+	var all []Customer
+	url := srv.URL + "/api/customers?sort=-country&limit=300"
+	for url != "" {
+		var page []Customer
+		resp := doJSON(t, "GET", url, "", &page)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		all = append(all, page...)
+		url = ""
+		if link := resp.Header.Get("Link"); link != "" {
+			url = srv.URL + link[1:strings.Index(link, ">")]
+		}
+	}
+	require.Len(t, all, 1000)
+	seen := make(map[int]bool)
+	for i, c := range all {
+		assert.False(t, seen[c.ID], "customer %d returned twice", c.ID)
+		seen[c.ID] = true
+		if i > 0 {
+			assert.True(t, all[i-1].Country >= c.Country)
+		}
+	}
+}
+
+func TestMemoryStoreOrderLifecycle(t *testing.T) {
+	srv, store := newTestServer(t)
+	product, err := store.getProduct(context.Background(), 1)
+	require.NoError(t, err)
+
+	var created struct{ ID int }
+	resp := doJSON(t, "POST", srv.URL+"/api/orders", `{"customer_id":1,"lines":[{"id":1,"amount":2}]}`, &created)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	reserved, err := store.getProduct(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, product.Stock-2, reserved.Stock)
+
+	resp = doJSON(t, "POST", srv.URL+"/api/orders", `{"customer_id":1,"lines":[{"id":1,"amount":100000}]}`, nil)
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+
+	var order Order
+	resp = doJSON(t, "POST", srv.URL+"/api/orders/"+strconv.Itoa(created.ID)+"/transitions", `{"status":"cancelled"}`, &order)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, OrderCancelled, order.Status)
+	assert.Len(t, order.History, 2)
+
+	restored, err := store.getProduct(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, product.Stock, restored.Stock)
+}
+
+func TestSearchProductsEscapesSnippets(t *testing.T) {
+	srv, _ := newTestServer(t)
+	resp := doJSON(t, "POST", srv.URL+"/api/products", `{
+  "sku": "OP-XSS", "name": "Xyzzy", "type_id": 1, "selling_price": 100,
+  "description": "<img src=x onerror=alert(1)> xyzzy & \"quoted\" script"
+}`, nil)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var results []ProductSearchResult
+	resp = doJSON(t, "GET", srv.URL+"/api/products/search?q=xyzzy+script", "", &results)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Len(t, results, 1)
+	assert.Equal(t,
+		"&lt;img src=x onerror=alert(1)&gt; <mark>xyzzy</mark> &amp; &#34;quoted&#34; <mark>script</mark>",
+		results[0].Snippet,
+	)
+}
+
+func TestCacheInvalidation(t *testing.T) {
+	srv, _ := newTestServer(t)
+
+	var stats Stats
+	var breakdown []SalesBreakdown
+	var product Product
+	var customer CustomerDetails
+	get := func() {
+		doJSON(t, "GET", srv.URL+"/api/stats", "", &stats)
+		doJSON(t, "GET", srv.URL+"/api/stats/types", "", &breakdown)
+		doJSON(t, "GET", srv.URL+"/api/products/1", "", &product)
+		doJSON(t, "GET", srv.URL+"/api/customers/1", "", &customer)
+	}
+	get()
+	ordersBefore, unitsBefore := stats.Orders, 0
+	for _, b := range breakdown {
+		unitsBefore += b.Units
+	}
+	stockBefore, customerOrdersBefore := product.Stock, customer.OrderCount
+
+	var created struct{ ID int }
+	resp := doJSON(t, "POST", srv.URL+"/api/orders", `{"customer_id":1,"lines":[{"id":1,"amount":2}]}`, &created)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	get()
+	var units int
+	for _, b := range breakdown {
+		units += b.Units
+	}
+	assert.Equal(t, ordersBefore+1, stats.Orders)
+	assert.Equal(t, unitsBefore+2, units)
+	assert.Equal(t, stockBefore-2, product.Stock)
+	assert.Equal(t, customerOrdersBefore+1, customer.OrderCount)
+
+	resp = doJSON(t, "POST", srv.URL+"/api/orders/"+strconv.Itoa(created.ID)+"/transitions", `{"status":"cancelled"}`, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	doJSON(t, "GET", srv.URL+"/api/products/1", "", &product)
+	assert.Equal(t, stockBefore, product.Stock)
+
+	resp = doJSON(t, "GET", srv.URL+"/api/products/100000", "", nil)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestConditionalGET(t *testing.T) {
+	// Set the tag versions in the past, so that Last-Modified is sent.
+	taggedCache := newTaggedCache(persistence.NewInMemoryStore(time.Minute), nil)
+	modified := uint64(time.Now().Add(-time.Minute).UnixNano())
+	for _, tag := range []string{productsCacheTag, customerCacheTag(1)} {
+		require.NoError(t, taggedCache.Set(tagVersionKey(tag), modified, tagVersionExpiration))
+	}
+	srv, _ := newTestServerWithCache(t, taggedCache)
+	get := func(path string, header ...string) *http.Response {
+		req, err := http.NewRequest("GET", srv.URL+path, nil)
+		require.NoError(t, err)
+		for i := 0; i < len(header); i += 2 {
+			req.Header.Set(header[i], header[i+1])
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		return resp
+	}
+
+	resp := get("/api/products/1")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "no-cache", resp.Header.Get("Cache-Control"))
+	etag := resp.Header.Get("ETag")
+	lastModified := resp.Header.Get("Last-Modified")
+	require.NotEmpty(t, etag)
+	require.NotEmpty(t, lastModified)
+
+	resp = get("/api/products/1", "If-None-Match", `"other", `+etag)
+	assert.Equal(t, http.StatusNotModified, resp.StatusCode)
+	assert.Equal(t, etag, resp.Header.Get("ETag"))
+	resp = get("/api/products/1", "If-Modified-Since", lastModified)
+	assert.Equal(t, http.StatusNotModified, resp.StatusCode)
+	resp = get("/api/products/2", "If-None-Match", etag)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// Updating a product changes the validators of product responses,
+	// but not those of unrelated resources.
+	customer := get("/api/customers/1")
+	assert.Equal(t, "private, no-cache", customer.Header.Get("Cache-Control"))
+	resp = doJSON(t, "PATCH", srv.URL+"/api/products/1", `{"stock":1}`, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp = get("/api/products/1", "If-None-Match", etag)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.NotEqual(t, etag, resp.Header.Get("ETag"))
+	resp = get("/api/products/1", "If-Modified-Since", lastModified)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// Last-Modified is not sent, and If-Modified-Since is ignored, until
+	// the second in which the product was updated has passed, as it may
+	// be updated again within that second.
+	assert.Empty(t, resp.Header.Get("Last-Modified"))
+	resp = get("/api/products/1", "If-Modified-Since", time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	resp = get("/api/customers/1", "If-None-Match", customer.Header.Get("ETag"))
+	assert.Equal(t, http.StatusNotModified, resp.StatusCode)
+
+	resp = get("/api/stats")
+	assert.Equal(t, "max-age=10", resp.Header.Get("Cache-Control"))
+
+	// Order details include the current details of their products.
+	var order Order
+	resp = doJSON(t, "GET", srv.URL+"/api/orders/2", "", &order)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.NotEmpty(t, order.Lines)
+	etag = resp.Header.Get("ETag")
+	resp = doJSON(t, "PATCH", srv.URL+"/api/products/"+strconv.Itoa(order.Lines[0].ID), `{"name":"Renamed"}`, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp = get("/api/orders/2", "If-None-Match", etag)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
@@ -0,0 +1,167 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-contrib/cache"
+	"github.com/gin-contrib/cache/persistence"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"golang.org/x/sync/singleflight"
+)
+
+// Tags for invalidating cache entries.
+const (
+	statsCacheTag     = "stats"
+	productsCacheTag  = "products"
+	customersCacheTag = "customers"
+	ordersCacheTag    = "orders"
+)
+
+// customerCacheTag returns the tag for cache entries
+// describing the customer with the given ID.
+func customerCacheTag(id int) string {
+	return "customers:" + strconv.Itoa(id)
+}
+
+// tagVersionExpiration is the expiration of tag versions. This must
+// exceed that of tagged entries, as expiring a tag's version also
+// invalidates the entries tagged with it.
+const tagVersionExpiration = 24 * time.Hour
+
+// taggedCache is a persistence.CacheStore in which entries may be tagged,
+// so that all entries with a tag can be invalidated at once, with either
+// the in-memory or Redis store.
+//
+// Each tag has a version, stored in the cache, which is included in the
+// keys of the entries tagged with it. Invalidating a tag advances its
+// version, so that its entries are no longer found, and expire. Versions
+// are the times, in nanoseconds since the epoch, at which the tags were
+// last invalidated, so they also serve as modification times.
+type taggedCache struct {
+	persistence.CacheStore
+
+	// refreshes coalesces concurrent refreshes of entries,
+	// and locker, if non-nil, coalesces them across processes.
+	refreshes *singleflight.Group
+	locker    cacheLocker
+
+	// backgroundRefreshes holds the keys of entries being
+	// refreshed in the background, for serving stale values.
+	backgroundRefreshes *sync.Map
+}
+
+func newTaggedCache(store persistence.CacheStore, locker cacheLocker) *taggedCache {
+	return &taggedCache{
+		CacheStore:          store,
+		refreshes:           new(singleflight.Group),
+		locker:              locker,
+		backgroundRefreshes: new(sync.Map),
+	}
+}
+
+// contextStore is implemented by stores which report their
+// operations as spans of the transaction in a context.
+type contextStore interface {
+	persistence.CacheStore
+
+	// withContext returns a copy of the store using ctx.
+	withContext(ctx context.Context) persistence.CacheStore
+}
+
+// withContext returns a copy of the cache whose store, if it is
+// a contextStore, reports its operations in ctx.
+func (c *taggedCache) withContext(ctx context.Context) *taggedCache {
+	store, ok := c.CacheStore.(contextStore)
+	if !ok {
+		return c
+	}
+	bound := *c
+	bound.CacheStore = store.withContext(ctx)
+	return &bound
+}
+
+// requestCache returns the cache installed by the cache
+// middleware, reporting its operations in the request's context.
+func requestCache(c *gin.Context) *taggedCache {
+	cacheValue, _ := c.Get(cache.CACHE_MIDDLEWARE_KEY)
+	return (*cacheValue.(*persistence.CacheStore)).(*taggedCache).withContext(c.Request.Context())
+}
+
+// taggedKey returns the key under which to get or set the entry with the
+// given key and tags. The returned key changes when any of the tags are
+// invalidated, so it should be computed before fetching the value to be
+// cached; otherwise a value fetched before the invalidation could be
+// cached afterwards.
+func (c *taggedCache) taggedKey(key string, tags ...string) (string, error) {
+	versions, err := c.tagVersions(tags...)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	b.WriteString(key)
+	for i, tag := range tags {
+		b.WriteString("|" + tag + "@" + strconv.FormatUint(versions[i], 10))
+	}
+	return b.String(), nil
+}
+
+// tagVersions returns the current versions of the given tags.
+func (c *taggedCache) tagVersions(tags ...string) ([]uint64, error) {
+	versions := make([]uint64, len(tags))
+	for i, tag := range tags {
+		version, err := c.tagVersion(tag)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get version of cache tag %q", tag)
+		}
+		versions[i] = version
+	}
+	return versions, nil
+}
+
+// invalidate invalidates the entries with any of the given tags.
+func (c *taggedCache) invalidate(tags ...string) error {
+	for _, tag := range tags {
+		key := tagVersionKey(tag)
+		var version uint64
+		if err := c.Get(key, &version); err != nil {
+			if err == persistence.ErrCacheMiss {
+				// A tag without a version has no current entries.
+				continue
+			}
+			return errors.Wrapf(err, "failed to invalidate cache tag %q", tag)
+		}
+		version = max(version+1, uint64(time.Now().UnixNano()))
+		if err := c.Set(key, version, tagVersionExpiration); err != nil {
+			return errors.Wrapf(err, "failed to invalidate cache tag %q", tag)
+		}
+	}
+	return nil
+}
+
+func (c *taggedCache) tagVersion(tag string) (uint64, error) {
+	key := tagVersionKey(tag)
+	var version uint64
+	for {
+		err := c.Get(key, &version)
+		if err != persistence.ErrCacheMiss {
+			return version, err
+		}
+		// Versions are times, so entries tagged with
+		// an expired version are not revived.
+		version = uint64(time.Now().UnixNano())
+		err = c.Add(key, version, tagVersionExpiration)
+		if err != persistence.ErrNotStored {
+			return version, err
+		}
+		// Another request added the version concurrently.
+	}
+}
+
+func tagVersionKey(tag string) string {
+	return "tag-version:" + tag
+}
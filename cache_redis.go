@@ -0,0 +1,310 @@
+package main
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-contrib/cache/persistence"
+	"github.com/gin-contrib/cache/utils"
+	"github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
+
+	"go.elastic.co/apm/v2"
+)
+
+// newRedisPool returns a connection pool for the given redis:// or
+// rediss:// URL. The pool and connection timeouts may be configured
+// with the URL's query parameters:
+//
+//	max_idle           maximum number of idle connections (default 5)
+//	max_active         maximum number of connections (default unlimited)
+//	wait               wait for a connection when max_active are in use,
+//	                   rather than failing (default false)
+//	idle_timeout       close connections idle for this long (default 4m)
+//	max_conn_lifetime  close connections older than this (default unlimited)
+//	connect_timeout    timeout for connecting (default 5s)
+//	read_timeout       timeout for reading replies (default 3s)
+//	write_timeout      timeout for writing commands (default 3s)
+//	tls_skip_verify    skip verifying the server's certificate with
+//	                   rediss:// (default false)
+func newRedisPool(rawurl string) (*redis.Pool, error) {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid Redis URL")
+	}
+	pool := &redis.Pool{
+		MaxIdle:     5,
+		IdleTimeout: 240 * time.Second,
+	}
+	dialOptions := []redis.DialOption{
+		redis.DialConnectTimeout(5 * time.Second),
+		redis.DialReadTimeout(3 * time.Second),
+		redis.DialWriteTimeout(3 * time.Second),
+	}
+	for name, values := range u.Query() {
+		value := values[len(values)-1]
+		var d time.Duration
+		var b bool
+		switch name {
+		case "max_idle":
+			pool.MaxIdle, err = strconv.Atoi(value)
+		case "max_active":
+			pool.MaxActive, err = strconv.Atoi(value)
+		case "wait":
+			pool.Wait, err = strconv.ParseBool(value)
+		case "idle_timeout":
+			pool.IdleTimeout, err = time.ParseDuration(value)
+		case "max_conn_lifetime":
+			pool.MaxConnLifetime, err = time.ParseDuration(value)
+		case "connect_timeout":
+			d, err = time.ParseDuration(value)
+			dialOptions = append(dialOptions, redis.DialConnectTimeout(d))
+		case "read_timeout":
+			d, err = time.ParseDuration(value)
+			dialOptions = append(dialOptions, redis.DialReadTimeout(d))
+		case "write_timeout":
+			d, err = time.ParseDuration(value)
+			dialOptions = append(dialOptions, redis.DialWriteTimeout(d))
+		case "tls_skip_verify":
+			b, err = strconv.ParseBool(value)
+			dialOptions = append(dialOptions, redis.DialTLSSkipVerify(b))
+		default:
+			return nil, errors.Errorf("unknown Redis URL parameter %q", name)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid Redis URL parameter %q", name)
+		}
+	}
+
+	u.RawQuery = ""
+	dialURL := u.String()
+	pool.DialContext = func(ctx context.Context) (redis.Conn, error) {
+		return redis.DialURLContext(ctx, dialURL, dialOptions...)
+	}
+	pool.TestOnBorrow = func(c redis.Conn, t time.Time) error {
+		// Only check connections which may have been closed by the server.
+		if time.Since(t) < time.Minute {
+			return nil
+		}
+		_, err := c.Do("PING")
+		return err
+	}
+	return pool, nil
+}
+
+var _ persistence.CacheStore = (*redisStore)(nil)
+
+// redisStore is a persistence.CacheStore using Redis, which reports the
+// commands it executes as spans of the transaction in its context.
+//
+// Unlike persistence.RedisStore, Add and Replace are atomic.
+type redisStore struct {
+	pool              *redis.Pool
+	defaultExpiration time.Duration
+	ctx               context.Context
+}
+
+func newRedisStore(pool *redis.Pool, defaultExpiration time.Duration) *redisStore {
+	return &redisStore{pool: pool, defaultExpiration: defaultExpiration, ctx: context.Background()}
+}
+
+func (s *redisStore) withContext(ctx context.Context) persistence.CacheStore {
+	bound := *s
+	bound.ctx = ctx
+	return &bound
+}
+
+// Get (see CacheStore interface)
+func (s *redisStore) Get(key string, value interface{}) error {
+	reply, err := redis.Bytes(s.do("GET", key))
+	switch err {
+	case nil:
+		return utils.Deserialize(reply, value)
+	case redis.ErrNil:
+		return persistence.ErrCacheMiss
+	default:
+		return err
+	}
+}
+
+// Set (see CacheStore interface)
+func (s *redisStore) Set(key string, value interface{}, expire time.Duration) error {
+	_, err := s.set(key, value, expire)
+	return err
+}
+
+// Add (see CacheStore interface)
+func (s *redisStore) Add(key string, value interface{}, expire time.Duration) error {
+	return s.setIf(key, value, expire, "NX")
+}
+
+// Replace (see CacheStore interface)
+func (s *redisStore) Replace(key string, value interface{}, expire time.Duration) error {
+	return s.setIf(key, value, expire, "XX")
+}
+
+// Delete (see CacheStore interface)
+func (s *redisStore) Delete(key string) error {
+	n, err := redis.Int(s.do("DEL", key))
+	if err == nil && n == 0 {
+		return persistence.ErrCacheMiss
+	}
+	return err
+}
+
+// Increment (see CacheStore interface)
+func (s *redisStore) Increment(key string, delta uint64) (uint64, error) {
+	return s.increment(key, int64(delta))
+}
+
+// Decrement (see CacheStore interface)
+func (s *redisStore) Decrement(key string, delta uint64) (uint64, error) {
+	return s.increment(key, -int64(delta))
+}
+
+// Flush (see CacheStore interface)
+func (s *redisStore) Flush() error {
+	_, err := s.do("FLUSHDB")
+	return err
+}
+
+// redisIncrementScript increments an existing value, without
+// changing its expiration, and without going below zero.
+var redisIncrementScript = redis.NewScript(1, `
+if redis.call("EXISTS", KEYS[1]) == 0 then
+  return false
+end
+local value = redis.call("INCRBY", KEYS[1], ARGV[1])
+if value < 0 then
+  redis.call("INCRBY", KEYS[1], -value)
+  value = 0
+end
+return value
+`)
+
+func (s *redisStore) increment(key string, delta int64) (uint64, error) {
+	conn, err := s.conn()
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+	value, err := redis.Uint64(redisIncrementScript.Do(conn, key, delta))
+	if err == redis.ErrNil {
+		return 0, persistence.ErrCacheMiss
+	}
+	return value, err
+}
+
+func (s *redisStore) setIf(key string, value interface{}, expire time.Duration, condition string) error {
+	reply, err := s.set(key, value, expire, condition)
+	if err == nil && reply == nil {
+		return persistence.ErrNotStored
+	}
+	return err
+}
+
+func (s *redisStore) set(key string, value interface{}, expire time.Duration, options ...interface{}) (interface{}, error) {
+	b, err := utils.Serialize(value)
+	if err != nil {
+		return nil, err
+	}
+	switch expire {
+	case persistence.DEFAULT:
+		expire = s.defaultExpiration
+	case persistence.FOREVER:
+		expire = 0
+	}
+	args := []interface{}{key, b}
+	if expire > 0 {
+		args = append(args, "PX", expire.Milliseconds())
+	}
+	return s.do("SET", append(args, options...)...)
+}
+
+func (s *redisStore) do(command string, args ...interface{}) (interface{}, error) {
+	conn, err := s.conn()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	return conn.Do(command, args...)
+}
+
+func (s *redisStore) conn() (redis.Conn, error) {
+	return getRedisConn(s.ctx, s.pool)
+}
+
+// getRedisConn returns a connection from pool which reports
+// the commands it executes as spans of the transaction in ctx.
+func getRedisConn(ctx context.Context, pool *redis.Pool) (redis.Conn, error) {
+	conn, err := pool.GetContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tracedRedisConn{Conn: conn, ctx: ctx}, nil
+}
+
+// tracedRedisConn is a redis.Conn reporting the commands executed
+// with Do as spans, named by the command and the prefix of its key,
+// so that they may be grouped without the cardinality of the keys.
+// Pipelined commands are not reported.
+type tracedRedisConn struct {
+	redis.Conn
+	ctx context.Context
+}
+
+func (c tracedRedisConn) Do(command string, args ...interface{}) (interface{}, error) {
+	if command == "" {
+		// Flushes pipelined commands.
+		return c.Conn.Do(command, args...)
+	}
+	name := strings.ToUpper(command)
+	if prefix, ok := redisKeyPrefix(name, args); ok {
+		name += " " + prefix
+	}
+	span, _ := apm.StartSpanOptions(c.ctx, name, "db.redis.query", apm.SpanOptions{ExitSpan: true})
+	defer span.End()
+	span.Context.SetDatabase(apm.DatabaseSpanContext{Type: "redis", Statement: name})
+
+	reply, err := c.Conn.Do(command, args...)
+	if err != nil {
+		span.Outcome = "failure"
+	} else {
+		span.Outcome = "success"
+	}
+	return reply, err
+}
+
+// redisKeyPrefix returns the prefix of the key of a command with the
+// given arguments, up to the first ':' or '|', as used by the API's
+// cache keys, or false if the command has no key.
+func redisKeyPrefix(command string, args []interface{}) (string, bool) {
+	keyIndex := 0
+	switch command {
+	case "PING", "FLUSHDB", "FLUSHALL", "SCRIPT":
+		return "", false
+	case "EVAL", "EVALSHA":
+		// EVAL script numkeys key...
+		if len(args) < 2 {
+			return "", false
+		}
+		if numKeys, ok := args[1].(int); !ok || numKeys == 0 {
+			return "", false
+		}
+		keyIndex = 2
+	}
+	if len(args) <= keyIndex {
+		return "", false
+	}
+	key, ok := args[keyIndex].(string)
+	if !ok {
+		return "", false
+	}
+	if i := strings.IndexAny(key, ":|"); i >= 0 {
+		key = key[:i]
+	}
+	return key, true
+}
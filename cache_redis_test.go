@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.elastic.co/apm/v2/apmtest"
+)
+
+func TestNewRedisPool(t *testing.T) {
+	pool, err := newRedisPool("rediss://:secret@redis:6380/1?max_idle=2&max_active=10&wait=true&idle_timeout=1m&read_timeout=1s&tls_skip_verify=true")
+	require.NoError(t, err)
+	assert.Equal(t, 2, pool.MaxIdle)
+	assert.Equal(t, 10, pool.MaxActive)
+	assert.True(t, pool.Wait)
+	assert.Equal(t, time.Minute, pool.IdleTimeout)
+
+	for url, expected := range map[string]string{
+		"redis://redis?max_idle=x":     `invalid Redis URL parameter "max_idle": strconv.Atoi: parsing "x": invalid syntax`,
+		"redis://redis?read_timeout=1": `invalid Redis URL parameter "read_timeout": time: missing unit in duration "1"`,
+		"redis://redis?pool_size=10":   `unknown Redis URL parameter "pool_size"`,
+		"redis://redis?wait=sometimes": `invalid Redis URL parameter "wait": strconv.ParseBool: parsing "sometimes": invalid syntax`,
+	} {
+		_, err := newRedisPool(url)
+		assert.EqualError(t, err, expected, url)
+	}
+}
+
+func TestTracedRedisConn(t *testing.T) {
+	_, spans, _ := apmtest.WithUncompressedTransaction(func(ctx context.Context) {
+		conn := tracedRedisConn{Conn: fakeRedisConn{}, ctx: ctx}
+		conn.Do("GET", "product:1|products@123")
+		conn.Do("set", "tag-version:stats", "1", "PX", 1000)
+		conn.Do("PING")
+		redisUnlockScript.Do(conn, "lock:customer:1", "token")
+	})
+	require.Len(t, spans, 4)
+	var names []string
+	for _, span := range spans {
+		names = append(names, span.Name)
+		assert.Equal(t, "db", span.Type)
+		assert.Equal(t, "redis", span.Subtype)
+		assert.Equal(t, "success", span.Outcome)
+	}
+	assert.Equal(t, []string{"GET product", "SET tag-version", "PING", "EVALSHA lock"}, names)
+	assert.Equal(t, "GET product", spans[0].Context.Database.Statement)
+}
+
+// fakeRedisConn is a redis.Conn replying OK to all commands.
+type fakeRedisConn struct {
+	redis.Conn
+}
+
+func (fakeRedisConn) Do(string, ...interface{}) (interface{}, error) {
+	return "OK", nil
+}
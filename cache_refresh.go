@@ -0,0 +1,231 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/gin-contrib/cache/persistence"
+	"github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"go.elastic.co/apm/v2"
+)
+
+const (
+	// cacheFreshExpiration is the period for which cached
+	// values are served without being refreshed.
+	cacheFreshExpiration = time.Minute
+
+	// cacheStaleExpiration is the further period for which cached
+	// values may be served while being refreshed in the background.
+	cacheStaleExpiration = 10 * time.Minute
+
+	// cacheRefreshTimeout bounds the time taken to refresh a cached
+	// value, and the time for which a refresh holds its lock.
+	cacheRefreshTimeout = 10 * time.Second
+
+	// cacheLockPollInterval is the interval at which a process waiting
+	// for another to refresh a value checks whether it has been cached.
+	cacheLockPollInterval = 50 * time.Millisecond
+)
+
+// cacheStatus describes how a cached value was obtained.
+type cacheStatus string
+
+const (
+	// cacheFresh values were served from the cache.
+	cacheFresh cacheStatus = "fresh"
+
+	// cacheStale values were served from the cache,
+	// and are being refreshed in the background.
+	cacheStale cacheStatus = "stale"
+
+	// cacheCoalesced values were fetched by a concurrent
+	// request for the same value, in this or another process.
+	cacheCoalesced cacheStatus = "coalesced"
+
+	// cacheMiss values were fetched by the request.
+	cacheMiss cacheStatus = "miss"
+)
+
+// errLocked is returned by cacheLocker.tryLock when the lock is held.
+var errLocked = errors.New("locked")
+
+// cacheLocker provides locks shared by the processes using a cache.
+type cacheLocker interface {
+	// tryLock acquires the named lock for at most ttl, returning a
+	// function which releases it, or errLocked if it is already held.
+	tryLock(ctx context.Context, name string, ttl time.Duration) (unlock func(), err error)
+}
+
+// getOrFetch returns the value cached under key, calling fetch to obtain
+// and cache it if it is not cached. Values are fresh for one minute, after
+// which they are served stale, and refreshed in the background, for up to
+// ten minutes. Concurrent refreshes of the same key are coalesced.
+func getOrFetch[T any](ctx context.Context, cache *taggedCache, key string, fetch func(context.Context) (T, error)) (T, cacheStatus, error) {
+	var value T
+	switch err := cache.Get(key, &value); err {
+	case nil:
+		var fresh bool
+		if cache.Get(freshCacheKey(key), &fresh) == nil {
+			return value, cacheFresh, nil
+		}
+		// Only start a refresh, and its transaction,
+		// if the key is not already being refreshed.
+		if _, refreshing := cache.backgroundRefreshes.LoadOrStore(key, struct{}{}); !refreshing {
+			go refreshInBackground(cache, key, fetch)
+		}
+		return value, cacheStale, nil
+	case persistence.ErrCacheMiss:
+	default:
+		return value, "", err
+	}
+
+	// Coalesced refreshes must not be cancelled
+	// with the request which started them.
+	value, coalesced, err := refreshCached(context.WithoutCancel(ctx), cache, key, fetch)
+	if coalesced {
+		return value, cacheCoalesced, err
+	}
+	return value, cacheMiss, err
+}
+
+// refreshInBackground refreshes the value cached under key
+// in a new transaction, for serving stale values.
+func refreshInBackground[T any](cache *taggedCache, key string, fetch func(context.Context) (T, error)) {
+	defer cache.backgroundRefreshes.Delete(key)
+	tx := apm.DefaultTracer().StartTransaction("refresh cache", "cache")
+	defer tx.End()
+	tx.Context.SetLabel("cache_key", key)
+	ctx := apm.ContextWithTransaction(context.Background(), tx)
+	if _, _, err := refreshCached(ctx, cache.withContext(ctx), key, fetch); err != nil {
+		apm.CaptureError(ctx, err).Send()
+		logrus.WithError(err).Warnf("failed to refresh %q", key)
+	}
+}
+
+// refreshCached calls fetch and caches the result under key, unless a
+// refresh of the same key is already in progress, in which case it waits
+// for that refresh and returns its result, and coalesced is true.
+func refreshCached[T any](ctx context.Context, cache *taggedCache, key string, fetch func(context.Context) (T, error)) (_ T, coalesced bool, _ error) {
+	// Only the caller whose function is executed
+	// refreshes the value; the others share its result.
+	var refreshed bool
+	result, err, _ := cache.refreshes.Do(key, func() (interface{}, error) {
+		refreshed = true
+		ctx, cancel := context.WithTimeout(ctx, cacheRefreshTimeout)
+		defer cancel()
+		if cache.locker != nil {
+			unlock, err := cache.locker.tryLock(ctx, "lock:"+key, cacheRefreshTimeout)
+			switch err {
+			case nil:
+				defer unlock()
+			case errLocked:
+				// Another process is refreshing the value.
+				if value, ok := waitCached[T](ctx, cache, key); ok {
+					return refreshResult{value: value, remote: true}, nil
+				}
+			default:
+				return nil, errors.Wrap(err, "failed to lock cache entry")
+			}
+		}
+		value, err := fetch(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := cache.Set(key, value, cacheFreshExpiration+cacheStaleExpiration); err != nil {
+			return nil, err
+		}
+		if err := cache.Set(freshCacheKey(key), true, cacheFreshExpiration); err != nil {
+			return nil, err
+		}
+		return refreshResult{value: value}, nil
+	})
+	if err != nil {
+		var zero T
+		return zero, !refreshed, err
+	}
+	r := result.(refreshResult)
+	return r.value.(T), !refreshed || r.remote, nil
+}
+
+type refreshResult struct {
+	value  interface{}
+	remote bool // refreshed by another process
+}
+
+// waitCached waits for the value under key to be freshly cached by another
+// process, returning false if it is not cached before ctx is done.
+func waitCached[T any](ctx context.Context, cache *taggedCache, key string) (T, bool) {
+	ticker := time.NewTicker(cacheLockPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			var zero T
+			return zero, false
+		case <-ticker.C:
+		}
+		var fresh bool
+		if cache.Get(freshCacheKey(key), &fresh) != nil {
+			continue
+		}
+		var value T
+		if cache.Get(key, &value) == nil {
+			return value, true
+		}
+	}
+}
+
+func freshCacheKey(key string) string {
+	return key + "|fresh"
+}
+
+// redisLocker is a cacheLocker using Redis.
+type redisLocker struct {
+	pool *redis.Pool
+}
+
+// redisUnlockScript deletes a lock only if it is
+// still held by the token which acquired it.
+var redisUnlockScript = redis.NewScript(1, `
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+  return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+func (l redisLocker) tryLock(ctx context.Context, name string, ttl time.Duration) (func(), error) {
+	var token [16]byte
+	if _, err := rand.Read(token[:]); err != nil {
+		return nil, err
+	}
+	tokenString := hex.EncodeToString(token[:])
+
+	conn, err := getRedisConn(ctx, l.pool)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	reply, err := conn.Do("SET", name, tokenString, "NX", "PX", ttl.Milliseconds())
+	if err != nil {
+		return nil, err
+	}
+	if reply == nil {
+		return nil, errLocked
+	}
+	return func() {
+		// Release the lock even if the refresh timed out.
+		conn, err := getRedisConn(context.WithoutCancel(ctx), l.pool)
+		if err == nil {
+			defer conn.Close()
+			_, err = redisUnlockScript.Do(conn, name, tokenString)
+		}
+		if err != nil {
+			logrus.WithError(err).Warnf("failed to release lock %q", name)
+		}
+	}, nil
+}
@@ -0,0 +1,243 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-contrib/cache/persistence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.elastic.co/apm/v2"
+	"go.elastic.co/apm/v2/apmtest"
+)
+
+func TestTaggedCache(t *testing.T) {
+	cache := newTaggedCache(persistence.NewInMemoryStore(time.Minute), nil)
+
+	statsKey, err := cache.taggedKey("stats", statsCacheTag)
+	require.NoError(t, err)
+	customerKey, err := cache.taggedKey("customer", statsCacheTag, customerCacheTag(1))
+	require.NoError(t, err)
+	require.NoError(t, cache.Set(statsKey, 1, time.Minute))
+	require.NoError(t, cache.Set(customerKey, 2, time.Minute))
+
+	// Keys are stable until their tags are invalidated.
+	key, err := cache.taggedKey("stats", statsCacheTag)
+	require.NoError(t, err)
+	assert.Equal(t, statsKey, key)
+
+	require.NoError(t, cache.invalidate(customerCacheTag(1), customerCacheTag(2)))
+	key, err = cache.taggedKey("stats", statsCacheTag)
+	require.NoError(t, err)
+	assert.Equal(t, statsKey, key)
+	key, err = cache.taggedKey("customer", statsCacheTag, customerCacheTag(1))
+	require.NoError(t, err)
+	assert.NotEqual(t, customerKey, key)
+
+	var value int
+	assert.Equal(t, persistence.ErrCacheMiss, cache.Get(key, &value))
+	require.NoError(t, cache.Get(statsKey, &value))
+	assert.Equal(t, 1, value)
+
+	require.NoError(t, cache.invalidate(statsCacheTag))
+	key, err = cache.taggedKey("stats", statsCacheTag)
+	require.NoError(t, err)
+	assert.Equal(t, persistence.ErrCacheMiss, cache.Get(key, &value))
+}
+
+func TestGetOrFetchCoalesces(t *testing.T) {
+	cache := newTaggedCache(persistence.NewInMemoryStore(time.Minute), nil)
+	var fetches int32
+	release := make(chan struct{})
+	fetch := func(ctx context.Context) (int, error) {
+		atomic.AddInt32(&fetches, 1)
+		<-release
+		return 42, nil
+	}
+
+	const n = 10
+	var wg sync.WaitGroup
+	statuses := make(chan cacheStatus, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			value, status, err := getOrFetch(context.Background(), cache, "key", fetch)
+			assert.NoError(t, err)
+			assert.Equal(t, 42, value)
+			statuses <- status
+		}()
+	}
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	close(statuses)
+
+	counts := make(map[cacheStatus]int)
+	for status := range statuses {
+		counts[status]++
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&fetches))
+	assert.Equal(t, map[cacheStatus]int{cacheMiss: 1, cacheCoalesced: n - 1}, counts)
+
+	value, status, err := getOrFetch(context.Background(), cache, "key", fetch)
+	require.NoError(t, err)
+	assert.Equal(t, 42, value)
+	assert.Equal(t, cacheFresh, status)
+}
+
+func TestGetOrFetchStale(t *testing.T) {
+	cache := newTaggedCache(persistence.NewInMemoryStore(time.Minute), nil)
+	require.NoError(t, cache.Set("key", 1, time.Minute))
+
+	refreshed := make(chan struct{})
+	value, status, err := getOrFetch(context.Background(), cache, "key", func(ctx context.Context) (int, error) {
+		defer close(refreshed)
+		return 2, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, value)
+	assert.Equal(t, cacheStale, status)
+
+	<-refreshed
+	assert.Eventually(t, func() bool {
+		value, status, err := getOrFetch(context.Background(), cache, "key", func(ctx context.Context) (int, error) {
+			return 3, nil
+		})
+		return err == nil && value == 2 && status == cacheFresh
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestGetOrFetchStaleRefreshesOnce(t *testing.T) {
+	tracer := apmtest.NewRecordingTracer()
+	defer tracer.Close()
+	defaultTracer := apm.DefaultTracer()
+	apm.SetDefaultTracer(tracer.Tracer)
+	defer apm.SetDefaultTracer(defaultTracer)
+
+	cache := newTaggedCache(persistence.NewInMemoryStore(time.Minute), nil)
+	require.NoError(t, cache.Set("key", 1, time.Minute))
+	var fetches int32
+	release := make(chan struct{})
+	fetch := func(ctx context.Context) (int, error) {
+		atomic.AddInt32(&fetches, 1)
+		<-release
+		return 2, nil
+	}
+
+	// Stale hits while the value is being refreshed
+	// do not start further refreshes.
+	for i := 0; i < 10; i++ {
+		value, status, err := getOrFetch(context.Background(), cache, "key", fetch)
+		require.NoError(t, err)
+		assert.Equal(t, 1, value)
+		assert.Equal(t, cacheStale, status)
+	}
+	close(release)
+	assert.Eventually(t, func() bool {
+		_, refreshing := cache.backgroundRefreshes.Load("key")
+		return !refreshing
+	}, time.Second, 10*time.Millisecond)
+	value, status, err := getOrFetch(context.Background(), cache, "key", fetch)
+	require.NoError(t, err)
+	assert.Equal(t, 2, value)
+	assert.Equal(t, cacheFresh, status)
+
+	tracer.Flush(nil)
+	assert.Len(t, tracer.Payloads().Transactions, 1)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&fetches))
+}
+
+// heldLocker is a cacheLocker whose locks are always held by another process.
+type heldLocker struct{}
+
+func (heldLocker) tryLock(context.Context, string, time.Duration) (func(), error) {
+	return nil, errLocked
+}
+
+func TestGetOrFetchLocked(t *testing.T) {
+	cache := newTaggedCache(persistence.NewInMemoryStore(time.Minute), heldLocker{})
+	go func() {
+		// Another process refreshes the value.
+		time.Sleep(100 * time.Millisecond)
+		cache.Set("key", 1, time.Minute)
+		cache.Set(freshCacheKey("key"), true, time.Minute)
+	}()
+	value, status, err := getOrFetch(context.Background(), cache, "key", func(ctx context.Context) (int, error) {
+		return 2, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, value)
+	assert.Equal(t, cacheCoalesced, status)
+}
+
+func TestTieredStore(t *testing.T) {
+	l2 := persistence.NewInMemoryStore(time.Minute)
+	store := newTieredStore(l2, time.Minute)
+
+	// Writes go through to L2.
+	require.NoError(t, store.Set("a", 1, time.Minute))
+	var value int
+	require.NoError(t, l2.Get("a", &value))
+	assert.Equal(t, 1, value)
+
+	// Reads are served from L1 when present there,
+	// and otherwise populate L1 from L2.
+	require.NoError(t, l2.Set("a", 2, time.Minute))
+	require.NoError(t, l2.Set("b", 3, time.Minute))
+	require.NoError(t, store.Get("a", &value))
+	assert.Equal(t, 1, value)
+	require.NoError(t, store.Get("b", &value))
+	assert.Equal(t, 3, value)
+	require.NoError(t, l2.Delete("b"))
+	require.NoError(t, store.Get("b", &value))
+	assert.Equal(t, 3, value)
+
+	// L2 is authoritative for conditional operations.
+	require.NoError(t, l2.Set("c", 4, time.Minute))
+	assert.Equal(t, persistence.ErrNotStored, store.Add("c", 5, time.Minute))
+	require.NoError(t, store.Get("c", &value))
+	assert.Equal(t, 4, value)
+
+	require.NoError(t, store.Delete("a"))
+	assert.Equal(t, persistence.ErrCacheMiss, store.Get("a", &value))
+	assert.Equal(t, persistence.ErrCacheMiss, l2.Get("a", &value))
+}
+
+func TestNewCache(t *testing.T) {
+	defer func(url string, lock bool) { *cacheURL, *cacheLock = url, lock }(*cacheURL, *cacheLock)
+
+	for url, expected := range map[string]string{
+		"inmem":                           "*persistence.InMemoryStore",
+		"redis://localhost:6379":          "*main.redisStore",
+		"memcached://a,b:11212":           "*persistence.MemcachedStore",
+		"inmem+memcached://localhost":     "*main.tieredStore",
+		"inmem:1s+redis://localhost:6379": "*main.tieredStore",
+	} {
+		*cacheURL, *cacheLock = url, false
+		store, err := newCache()
+		require.NoError(t, err, url)
+		assert.Equal(t, expected, fmt.Sprintf("%T", store.(*taggedCache).CacheStore), url)
+	}
+
+	for url, expected := range map[string]string{
+		"inmem+inmem":       `invalid cache URL "inmem+inmem"`,
+		"inmem:0s+redis://": `invalid in-process cache expiration "0s"`,
+		"memcached://":      `invalid cache URL "memcached://", no memcached servers specified`,
+		"mongodb://":        `invalid cache URL "mongodb://"`,
+	} {
+		*cacheURL, *cacheLock = url, false
+		_, err := newCache()
+		require.Error(t, err, url)
+		assert.Contains(t, err.Error(), expected, url)
+	}
+
+	*cacheURL, *cacheLock = "inmem+memcached://localhost", true
+	_, err := newCache()
+	assert.EqualError(t, err, "-cache-lock requires a Redis cache")
+}
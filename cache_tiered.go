@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/gin-contrib/cache/persistence"
+)
+
+const (
+	// defaultL1Expiration is the default expiration of entries in the
+	// in-process tier of a tiered cache, bounding the time for which
+	// the process may serve entries changed by other processes.
+	defaultL1Expiration = 5 * time.Second
+)
+
+var _ persistence.CacheStore = (*tieredStore)(nil)
+
+// tieredStore is a persistence.CacheStore with a short-lived
+// in-process tier (L1) in front of a shared store (L2), such as Redis
+// or memcached. Entries are written through to L2, which is
+// authoritative for conditional and atomic operations, and read
+// from L1 when present there.
+type tieredStore struct {
+	l1           *persistence.InMemoryStore
+	l2           persistence.CacheStore
+	l1Expiration time.Duration
+}
+
+func newTieredStore(l2 persistence.CacheStore, l1Expiration time.Duration) *tieredStore {
+	return &tieredStore{
+		l1:           persistence.NewInMemoryStore(l1Expiration),
+		l2:           l2,
+		l1Expiration: l1Expiration,
+	}
+}
+
+func (s *tieredStore) withContext(ctx context.Context) persistence.CacheStore {
+	l2, ok := s.l2.(contextStore)
+	if !ok {
+		return s
+	}
+	bound := *s
+	bound.l2 = l2.withContext(ctx)
+	return &bound
+}
+
+// Get (see CacheStore interface)
+func (s *tieredStore) Get(key string, value interface{}) error {
+	if s.l1.Get(key, value) == nil {
+		return nil
+	}
+	if err := s.l2.Get(key, value); err != nil {
+		return err
+	}
+	// The entry's remaining expiration in L2 is unknown,
+	// so it may outlive that by up to l1Expiration in L1.
+	s.setL1(key, reflect.ValueOf(value).Elem().Interface(), s.l1Expiration)
+	return nil
+}
+
+// Set (see CacheStore interface)
+func (s *tieredStore) Set(key string, value interface{}, expire time.Duration) error {
+	if err := s.l2.Set(key, value, expire); err != nil {
+		s.l1.Delete(key)
+		return err
+	}
+	s.setL1(key, value, expire)
+	return nil
+}
+
+// Add (see CacheStore interface)
+func (s *tieredStore) Add(key string, value interface{}, expire time.Duration) error {
+	if err := s.l2.Add(key, value, expire); err != nil {
+		return err
+	}
+	s.setL1(key, value, expire)
+	return nil
+}
+
+// Replace (see CacheStore interface)
+func (s *tieredStore) Replace(key string, value interface{}, expire time.Duration) error {
+	if err := s.l2.Replace(key, value, expire); err != nil {
+		return err
+	}
+	s.setL1(key, value, expire)
+	return nil
+}
+
+// Delete (see CacheStore interface)
+func (s *tieredStore) Delete(key string) error {
+	s.l1.Delete(key)
+	return s.l2.Delete(key)
+}
+
+// Increment (see CacheStore interface)
+func (s *tieredStore) Increment(key string, delta uint64) (uint64, error) {
+	s.l1.Delete(key)
+	return s.l2.Increment(key, delta)
+}
+
+// Decrement (see CacheStore interface)
+func (s *tieredStore) Decrement(key string, delta uint64) (uint64, error) {
+	s.l1.Delete(key)
+	return s.l2.Decrement(key, delta)
+}
+
+// Flush (see CacheStore interface)
+func (s *tieredStore) Flush() error {
+	s.l1.Flush()
+	return s.l2.Flush()
+}
+
+// setL1 caches value in L1 for no longer than l1Expiration.
+func (s *tieredStore) setL1(key string, value interface{}, expire time.Duration) {
+	if expire <= 0 || expire > s.l1Expiration {
+		expire = s.l1Expiration
+	}
+	s.l1.Set(key, value, expire)
+}
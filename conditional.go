@@ -0,0 +1,106 @@
+package main
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Cache-Control directives for API responses. Most responses must be
+// revalidated with their ETag or Last-Modified validators before being
+// reused, and those describing customers and orders must not be stored
+// in shared caches. Statistics may be reused for a few seconds.
+const (
+	publicCacheControl  = "no-cache"
+	privateCacheControl = "private, no-cache"
+	statsCacheControl   = "max-age=10"
+)
+
+// bootID distinguishes the validators of responses served by
+// different runs of the server, as the in-memory store's data
+// and the in-memory cache's tag versions do not persist.
+var bootID = strconv.FormatInt(time.Now().UnixNano(), 36)
+
+// cacheControl returns middleware which sets the Cache-Control
+// header of successful GET responses to the given directives.
+func cacheControl(directives string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method == http.MethodGet || c.Request.Method == http.MethodHead {
+			c.Header("Cache-Control", directives)
+		}
+	}
+}
+
+// checkNotModified sets the ETag and Last-Modified headers of the response
+// to a GET request, derived from the request URI, the versions of the given
+// cache tags, which must cover all data in the response, and any additional
+// values affecting the response, such as the current time. If the request's
+// If-None-Match or If-Modified-Since header shows that the client already has
+// the current response, checkNotModified responds with 304 Not Modified and
+// returns true; the caller should then return without further processing.
+//
+// Last-Modified has a resolution of a second, so it is only sent once the
+// second in which the data was last modified has passed: until then, the
+// data may be modified again without changing Last-Modified.
+func checkNotModified(c *gin.Context, tags []string, extra ...string) bool {
+	if c.Request.Method != http.MethodGet && c.Request.Method != http.MethodHead {
+		return false
+	}
+	versions, err := requestCache(c).tagVersions(tags...)
+	if err != nil {
+		// Serve the response without validators.
+		contextLogger(c).WithError(err).Warn("failed to compute response validators")
+		return false
+	}
+
+	hash := sha1.New()
+	hash.Write([]byte(bootID + "\n" + c.Request.URL.RequestURI() + "\n"))
+	var lastModifiedNanos uint64
+	for _, version := range versions {
+		hash.Write([]byte(strconv.FormatUint(version, 10) + "\n"))
+		lastModifiedNanos = max(lastModifiedNanos, version)
+	}
+	for _, value := range extra {
+		hash.Write([]byte(value + "\n"))
+	}
+	etag := `W/"` + hex.EncodeToString(hash.Sum(nil)[:12]) + `"`
+	lastModified := time.Unix(0, int64(lastModifiedNanos)).UTC().Truncate(time.Second)
+	lastModifiedFinal := !time.Now().Before(lastModified.Add(time.Second))
+	c.Header("ETag", etag)
+	if lastModifiedFinal {
+		c.Header("Last-Modified", lastModified.Format(http.TimeFormat))
+	}
+
+	notModified := false
+	if ifNoneMatch := c.GetHeader("If-None-Match"); ifNoneMatch != "" {
+		// If-None-Match takes precedence over If-Modified-Since.
+		notModified = etagMatches(ifNoneMatch, etag)
+	} else if len(extra) == 0 && lastModifiedFinal {
+		// Only the ETag covers the extra values.
+		if t, err := http.ParseTime(c.GetHeader("If-Modified-Since")); err == nil {
+			notModified = !lastModified.After(t)
+		}
+	}
+	if notModified {
+		c.AbortWithStatus(http.StatusNotModified)
+	}
+	return notModified
+}
+
+// etagMatches reports whether the value of an If-None-Match header
+// matches etag, using the weak comparison function.
+func etagMatches(ifNoneMatch, etag string) bool {
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
@@ -3,8 +3,10 @@ package main
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
 )
 
 type Customer struct {
@@ -18,44 +20,69 @@ type Customer struct {
 	Country     string `json:"country"`
 }
 
-func getCustomers(ctx context.Context, db *sqlx.DB) ([]Customer, error) {
-	return queryCustomers(ctx, db, nil, nil, nil)
+// customerFilter restricts the customers returned by queryCustomers.
+type customerFilter struct {
+	ID        *int
+	ProductID *int
+	Country   string
+	City      string
 }
 
-func getProductCustomers(ctx context.Context, db *sqlx.DB, productId, limit int) ([]Customer, error) {
-	return queryCustomers(ctx, db, nil, &productId, &limit)
+// customerSortColumns holds the columns by which customers may be sorted.
+var customerSortColumns = []string{"full_name", "company_name", "country", "city"}
+
+func getCustomers(ctx context.Context, db *sqlx.DB, filter customerFilter, page pageRequest) ([]Customer, error) {
+	return queryCustomers(ctx, db, filter, page)
+}
+
+func getProductCustomers(ctx context.Context, db *sqlx.DB, productId int, page pageRequest) ([]Customer, error) {
+	return queryCustomers(ctx, db, customerFilter{ProductID: &productId}, page)
 }
 
 func getCustomer(ctx context.Context, db *sqlx.DB, id int) (*Customer, error) {
-	customers, err := queryCustomers(ctx, db, &id, nil, nil)
+	customers, err := queryCustomers(ctx, db, customerFilter{ID: &id}, pageRequest{})
 	if err != nil || len(customers) == 0 {
 		return nil, err
 	}
 	return &customers[0], nil
 }
 
-func queryCustomers(ctx context.Context, db *sqlx.DB, id, productId, limit *int) ([]Customer, error) {
+func queryCustomers(ctx context.Context, db *sqlx.DB, filter customerFilter, page pageRequest) ([]Customer, error) {
+	var conds []string
 	var args []interface{}
+	if filter.ID != nil {
+		conds = append(conds, "customers.id=?")
+		args = append(args, *filter.ID)
+	}
+	if filter.ProductID != nil {
+		conds = append(conds, `customers.id IN (
+  SELECT orders.customer_id FROM orders
+  JOIN order_lines ON orders.id=order_lines.order_id
+  WHERE order_lines.product_id=?
+)`)
+		args = append(args, *filter.ProductID)
+	}
+	if filter.Country != "" {
+		conds = append(conds, "customers.country=?")
+		args = append(args, filter.Country)
+	}
+	if filter.City != "" {
+		conds = append(conds, "customers.city=?")
+		args = append(args, filter.City)
+	}
+	if cond, pageArgs := page.where("customers"); cond != "" {
+		conds = append(conds, cond)
+		args = append(args, pageArgs...)
+	}
+
 	queryString := `
 SELECT
   customers.id, full_name, company_name, email,
   address, postal_code, city, country
 FROM customers
 `
-	if id != nil {
-		queryString += "WHERE id=?\n"
-		args = append(args, *id)
-	}
-	if productId != nil {
-		queryString += "" +
-			"JOIN orders ON customers.id=orders.customer_id " +
-			"JOIN order_lines ON orders.id=order_lines.order_id " +
-			"WHERE order_lines.product_id=?\n"
-		args = append(args, *productId)
-	}
-	if limit != nil {
-		queryString += fmt.Sprintf("LIMIT %d\n", *limit)
-	}
+	queryString += whereClause(conds)
+	queryString += page.orderBy("customers")
 
 	rows, err := db.QueryContext(ctx, db.Rebind(queryString), args...)
 	if err != nil {
@@ -77,3 +104,117 @@ FROM customers
 	}
 	return customers, rows.Err()
 }
+
+// CustomerDetails holds a customer's
+// profile and lifetime order statistics.
+type CustomerDetails struct {
+	Customer
+	OrderCount   int        `json:"order_count"`
+	TotalSpent   int        `json:"total_spent"`
+	FirstOrderAt *time.Time `json:"first_order_at,omitempty"`
+	LastOrderAt  *time.Time `json:"last_order_at,omitempty"`
+}
+
+// getCustomerDetails returns the customer with the given ID along
+// with their order statistics, or nil if there is no such customer.
+// Cancelled orders do not count towards the total spent.
+func getCustomerDetails(ctx context.Context, db *sqlx.DB, id int) (*CustomerDetails, error) {
+	customer, err := getCustomer(ctx, db, id)
+	if err != nil || customer == nil {
+		return nil, err
+	}
+	details := CustomerDetails{Customer: *customer}
+
+	var first, last nullTime
+	row := db.QueryRowContext(ctx, db.Rebind(`SELECT
+  COUNT(DISTINCT orders.id), MIN(orders.created_at), MAX(orders.created_at),
+  COALESCE(SUM(CASE WHEN orders.status<>'cancelled' THEN order_lines.amount*order_lines.unit_price END), 0)
+FROM orders LEFT JOIN order_lines ON order_lines.order_id=orders.id
+WHERE orders.customer_id=?`), id)
+	if err := row.Scan(&details.OrderCount, &first, &last, &details.TotalSpent); err != nil {
+		return nil, errors.Wrap(err, "querying customer order statistics")
+	}
+	if first.Valid {
+		details.FirstOrderAt = &first.Time
+	}
+	if last.Valid {
+		details.LastOrderAt = &last.Time
+	}
+	return &details, nil
+}
+
+var errCustomerHasOrders = errors.New("customer has existing orders")
+
+func createCustomer(ctx context.Context, db *sqlx.DB, c *Customer) (int, error) {
+	id, err := insertReturningID(ctx, db, `INSERT INTO customers
+  (full_name, company_name, email, address, postal_code, city, country)
+VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		c.FullName, c.CompanyName, c.Email, c.Address, c.PostalCode, c.City, c.Country,
+	)
+	if err != nil {
+		return -1, errors.Wrap(err, "inserting customer")
+	}
+	return id, nil
+}
+
+// updateCustomer updates the customer with ID c.ID, returning
+// false if no such customer exists.
+func updateCustomer(ctx context.Context, db *sqlx.DB, c *Customer) (bool, error) {
+	result, err := db.ExecContext(ctx, db.Rebind(`UPDATE customers SET
+  full_name=?, company_name=?, email=?, address=?, postal_code=?, city=?, country=?
+WHERE id=?`),
+		c.FullName, c.CompanyName, c.Email, c.Address, c.PostalCode, c.City, c.Country, c.ID,
+	)
+	if err != nil {
+		return false, errors.Wrap(err, "updating customer")
+	}
+	n, err := result.RowsAffected()
+	return n != 0, err
+}
+
+// deleteCustomer deletes the customer with the given ID, returning
+// false if no such customer exists. Customers with orders cannot be
+// deleted, as that would leave the orders dangling; they may instead
+// be anonymised with anonymizeCustomer.
+func deleteCustomer(ctx context.Context, db *sqlx.DB, id int) (bool, error) {
+	tx, err := db.BeginTxx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	var ordered bool
+	row := tx.QueryRowxContext(ctx, tx.Rebind(
+		"SELECT EXISTS (SELECT 1 FROM orders WHERE customer_id=?)",
+	), id)
+	if err := row.Scan(&ordered); err != nil {
+		return false, errors.Wrap(err, "querying customer orders")
+	}
+	if ordered {
+		return false, errCustomerHasOrders
+	}
+	result, err := tx.ExecContext(ctx, tx.Rebind("DELETE FROM customers WHERE id=?"), id)
+	if err != nil {
+		return false, errors.Wrap(err, "deleting customer")
+	}
+	if n, err := result.RowsAffected(); err != nil || n == 0 {
+		return false, err
+	}
+	return true, tx.Commit()
+}
+
+// anonymizeCustomer replaces the personal details of the customer
+// with the given ID, retaining the record and its orders. The country
+// is retained so that sales statistics remain meaningful.
+func anonymizeCustomer(ctx context.Context, db *sqlx.DB, id int) (bool, error) {
+	result, err := db.ExecContext(ctx, db.Rebind(`UPDATE customers SET
+  full_name=?, company_name='', email=?, address='', postal_code='', city=''
+WHERE id=?`),
+		"Anonymous", fmt.Sprintf("anonymous-%d@example.invalid", id), id,
+	)
+	if err != nil {
+		return false, errors.Wrap(err, "anonymizing customer")
+	}
+	n, err := result.RowsAffected()
+	return n != 0, err
+}
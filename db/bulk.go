@@ -0,0 +1,68 @@
+package opbeansdb
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+// maxBulkParams is the maximum number of parameters in a multi-row
+// INSERT statement. This is SQLite's limit; MySQL's is higher.
+const maxBulkParams = 32766
+
+// loadRows inserts rows into the given columns of table. On Postgres
+// the rows are loaded with COPY; on other drivers they are inserted
+// with as few multi-row INSERT statements as possible.
+func loadRows(ctx context.Context, tx *sqlx.Tx, driver, table string, columns []string, rows [][]interface{}) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	if driver == "postgres" {
+		return copyRows(ctx, tx, table, columns, rows)
+	}
+	return insertRows(ctx, tx, table, columns, rows)
+}
+
+func insertRows(ctx context.Context, tx *sqlx.Tx, table string, columns []string, rows [][]interface{}) error {
+	rowPlaceholders := "(" + strings.Repeat("?, ", len(columns)-1) + "?)"
+	batchSize := maxBulkParams / len(columns)
+	args := make([]interface{}, 0, batchSize*len(columns))
+	for len(rows) > 0 {
+		n := min(batchSize, len(rows))
+		var query strings.Builder
+		query.WriteString("INSERT INTO " + table + " (" + strings.Join(columns, ", ") + ") VALUES ")
+		args = args[:0]
+		for i, row := range rows[:n] {
+			if i > 0 {
+				query.WriteString(", ")
+			}
+			query.WriteString(rowPlaceholders)
+			args = append(args, row...)
+		}
+		if _, err := tx.ExecContext(ctx, query.String(), args...); err != nil {
+			return errors.Wrapf(err, "inserting into %s", table)
+		}
+		rows = rows[n:]
+	}
+	return nil
+}
+
+func copyRows(ctx context.Context, tx *sqlx.Tx, table string, columns []string, rows [][]interface{}) error {
+	stmt, err := tx.PrepareContext(ctx, pq.CopyIn(table, columns...))
+	if err != nil {
+		return errors.Wrapf(err, "copying into %s", table)
+	}
+	defer stmt.Close()
+	for _, row := range rows {
+		if _, err := stmt.ExecContext(ctx, row...); err != nil {
+			return errors.Wrapf(err, "copying into %s", table)
+		}
+	}
+	if _, err := stmt.ExecContext(ctx); err != nil {
+		return errors.Wrapf(err, "copying into %s", table)
+	}
+	return nil
+}
@@ -0,0 +1,428 @@
+package opbeansdb
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ProductType is a product type in a Dataset.
+type ProductType struct {
+	ID   int
+	Name string
+}
+
+// Product is a product in a Dataset.
+type Product struct {
+	ID           int
+	SKU          string
+	Name         string
+	Description  string
+	TypeID       int
+	Stock        int
+	Cost         int
+	SellingPrice int
+}
+
+// Customer is a customer in a Dataset.
+type Customer struct {
+	ID          int
+	FullName    string
+	CompanyName string
+	Email       string
+	Address     string
+	PostalCode  string
+	City        string
+	Country     string
+}
+
+// Dataset holds the product catalog and customers
+// with which a database is seeded.
+type Dataset struct {
+	ProductTypes []ProductType
+	Products     []Product
+	Customers    []Customer
+}
+
+// SeedConfig controls the data with which a database is seeded.
+//
+// Seeding is deterministic: seeding with the same configuration
+// always produces identical data.
+type SeedConfig struct {
+	// Seed is the seed for the random number generator.
+	Seed int64
+
+	// Time is the time at which the last generated order is created.
+	Time time.Time
+
+	// Window is the period of time before Time over which
+	// generated orders are spread. If zero, all orders
+	// are created at Time.
+	Window time.Duration
+
+	// Orders is the number of orders to generate.
+	Orders int
+
+	// Customers is the number of customers to create. If zero, the
+	// customers are those in CustomersFile. If more customers are
+	// requested than are in the file, additional customers are
+	// generated by recombining their details.
+	Customers int
+
+	// ProductsFile and CustomersFile, if non-empty, hold the names of
+	// JSON or CSV files defining the product catalog and customers.
+	// Otherwise the built-in sample catalog and customers are used.
+	//
+	// JSON files must contain an array of objects, and CSV files must
+	// have a header row, with keys or columns named after the fields:
+	// "sku", "name", "description", "type", "stock", "cost" and
+	// "selling_price" for products, where "type" is the name of the
+	// product type; and "full_name", "company_name", "email", "address",
+	// "postal_code", "city" and "country" for customers. IDs are
+	// assigned in file order.
+	ProductsFile  string
+	CustomersFile string
+}
+
+// NewDataset returns the dataset described by cfg, using rng
+// to generate any additional customers.
+func NewDataset(cfg SeedConfig, rng *rand.Rand) (*Dataset, error) {
+	var ds Dataset
+	if cfg.ProductsFile != "" {
+		if err := ds.loadProducts(cfg.ProductsFile); err != nil {
+			return nil, errors.Wrapf(err, "loading products from %q", cfg.ProductsFile)
+		}
+	} else if err := ds.loadSQLFile("products.sql"); err != nil {
+		return nil, err
+	}
+	if cfg.CustomersFile != "" {
+		if err := ds.loadCustomers(cfg.CustomersFile); err != nil {
+			return nil, errors.Wrapf(err, "loading customers from %q", cfg.CustomersFile)
+		}
+	} else if err := ds.loadSQLFile("customers.sql"); err != nil {
+		return nil, err
+	}
+	if len(ds.Products) == 0 {
+		return nil, errors.New("no products defined")
+	}
+	if len(ds.Customers) == 0 {
+		return nil, errors.New("no customers defined")
+	}
+	if cfg.Customers > 0 {
+		ds.resizeCustomers(cfg.Customers, rng)
+	}
+	return &ds, nil
+}
+
+// resizeCustomers truncates or extends the customers to n. Customers are
+// added by combining the names, companies and locations of randomly chosen
+// existing customers.
+func (ds *Dataset) resizeCustomers(n int, rng *rand.Rand) {
+	if n <= len(ds.Customers) {
+		ds.Customers = ds.Customers[:n]
+		return
+	}
+	base := ds.Customers
+	pick := func() Customer { return base[rng.Intn(len(base))] }
+	for id := ds.Customers[len(ds.Customers)-1].ID + 1; len(ds.Customers) < n; id++ {
+		first, _ := splitName(pick().FullName)
+		_, last := splitName(pick().FullName)
+		location := pick()
+		domain := "example.com"
+		if email := pick().Email; strings.Contains(email, "@") {
+			domain = email[strings.LastIndex(email, "@")+1:]
+		}
+		ds.Customers = append(ds.Customers, Customer{
+			ID:          id,
+			FullName:    strings.TrimSpace(first + " " + last),
+			CompanyName: pick().CompanyName,
+			Email: fmt.Sprintf("%s%s%d@%s",
+				strings.ToLower(first[:min(1, len(first))]),
+				strings.ToLower(strings.ReplaceAll(last, " ", "")),
+				id, domain,
+			),
+			Address:    pick().Address,
+			PostalCode: location.PostalCode,
+			City:       location.City,
+			Country:    location.Country,
+		})
+	}
+}
+
+func splitName(name string) (first, last string) {
+	if i := strings.IndexByte(name, ' '); i >= 0 {
+		return name[:i], name[i+1:]
+	}
+	return name, ""
+}
+
+// loadProducts loads the product catalog from a JSON or CSV file,
+// creating product types in order of their first appearance.
+func (ds *Dataset) loadProducts(filename string) error {
+	records, err := readRecords(filename)
+	if err != nil {
+		return err
+	}
+	typeIDs := make(map[string]int)
+	for i, r := range records {
+		typeName := r.string("type")
+		typeID, ok := typeIDs[typeName]
+		if !ok {
+			typeID = len(ds.ProductTypes) + 1
+			typeIDs[typeName] = typeID
+			ds.ProductTypes = append(ds.ProductTypes, ProductType{ID: typeID, Name: typeName})
+		}
+		p := Product{
+			ID:           i + 1,
+			SKU:          r.string("sku"),
+			Name:         r.string("name"),
+			Description:  r.string("description"),
+			TypeID:       typeID,
+			Stock:        r.int("stock"),
+			Cost:         r.int("cost"),
+			SellingPrice: r.int("selling_price"),
+		}
+		if r.err != nil {
+			return errors.Wrapf(r.err, "product %d", i+1)
+		}
+		if p.SKU == "" || p.Name == "" || typeName == "" {
+			return errors.Errorf("product %d: sku, name and type are required", i+1)
+		}
+		ds.Products = append(ds.Products, p)
+	}
+	return nil
+}
+
+// loadCustomers loads customers from a JSON or CSV file.
+func (ds *Dataset) loadCustomers(filename string) error {
+	records, err := readRecords(filename)
+	if err != nil {
+		return err
+	}
+	for i, r := range records {
+		c := Customer{
+			ID:          i + 1,
+			FullName:    r.string("full_name"),
+			CompanyName: r.string("company_name"),
+			Email:       r.string("email"),
+			Address:     r.string("address"),
+			PostalCode:  r.string("postal_code"),
+			City:        r.string("city"),
+			Country:     r.string("country"),
+		}
+		if r.err != nil {
+			return errors.Wrapf(r.err, "customer %d", i+1)
+		}
+		if c.FullName == "" || c.Email == "" {
+			return errors.Errorf("customer %d: full_name and email are required", i+1)
+		}
+		ds.Customers = append(ds.Customers, c)
+	}
+	return nil
+}
+
+// record holds the fields of a JSON object or CSV row.
+type record struct {
+	fields map[string]interface{}
+	err    error
+}
+
+func (r *record) string(key string) string {
+	switch v := r.fields[key].(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	default:
+		if r.err == nil {
+			r.err = errors.Errorf("invalid %s %v", key, v)
+		}
+		return ""
+	}
+}
+
+func (r *record) int(key string) int {
+	s := r.string(key)
+	if s == "" {
+		return 0
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil && r.err == nil {
+		r.err = errors.Errorf("invalid %s %q", key, s)
+	}
+	return n
+}
+
+// readRecords reads the records from a JSON or CSV file,
+// determining the format from the file's extension.
+func readRecords(filename string) ([]*record, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	switch ext := strings.ToLower(filepath.Ext(filename)); ext {
+	case ".json":
+		return readJSONRecords(f)
+	case ".csv":
+		return readCSVRecords(f)
+	default:
+		return nil, errors.Errorf("unsupported file type %q, expected .json or .csv", ext)
+	}
+}
+
+func readJSONRecords(r io.Reader) ([]*record, error) {
+	var objects []map[string]interface{}
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+	if err := decoder.Decode(&objects); err != nil {
+		return nil, err
+	}
+	records := make([]*record, len(objects))
+	for i, object := range objects {
+		records[i] = &record{fields: object}
+	}
+	return records, nil
+}
+
+func readCSVRecords(r io.Reader) ([]*record, error) {
+	rows, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, errors.New("missing header row")
+	}
+	header := rows[0]
+	records := make([]*record, 0, len(rows)-1)
+	for _, row := range rows[1:] {
+		fields := make(map[string]interface{}, len(header))
+		for i, column := range header {
+			fields[strings.TrimSpace(column)] = row[i]
+		}
+		records = append(records, &record{fields: fields})
+	}
+	return records, nil
+}
+
+var insertStatementRegexp = regexp.MustCompile(`(?is)^insert\s+into\s+(\w+)\s*\(([^)]*)\)\s*values\s*\((.*)\)$`)
+
+// loadSQLFile loads the rows inserted by the INSERT
+// statements in one of the built-in SQL data files.
+func (ds *Dataset) loadSQLFile(filename string) error {
+	f, err := SQL.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	stmts, err := ReadStatements(f)
+	if err != nil {
+		return errors.Wrapf(err, "reading %q", filename)
+	}
+	for _, stmt := range stmts {
+		match := insertStatementRegexp.FindStringSubmatch(stmt.Text)
+		if match == nil {
+			return errors.Errorf("%s:%d: unsupported statement: %s", filename, stmt.Line, stmt.Text)
+		}
+		columns := strings.Split(match[2], ",")
+		values, err := splitSQLValues(match[3])
+		if err != nil {
+			return errors.Wrapf(err, "%s:%d", filename, stmt.Line)
+		}
+		if len(columns) != len(values) {
+			return errors.Errorf("%s:%d: expected %d values, got %d", filename, stmt.Line, len(columns), len(values))
+		}
+		r := &record{fields: make(map[string]interface{})}
+		for i, column := range columns {
+			r.fields[strings.TrimSpace(column)] = values[i]
+		}
+		switch table := strings.ToLower(match[1]); table {
+		case "product_types":
+			ds.ProductTypes = append(ds.ProductTypes, ProductType{ID: r.int("id"), Name: r.string("name")})
+		case "products":
+			ds.Products = append(ds.Products, Product{
+				ID:           r.int("id"),
+				SKU:          r.string("sku"),
+				Name:         r.string("name"),
+				Description:  r.string("description"),
+				TypeID:       r.int("type_id"),
+				Stock:        r.int("stock"),
+				Cost:         r.int("cost"),
+				SellingPrice: r.int("selling_price"),
+			})
+		case "customers":
+			ds.Customers = append(ds.Customers, Customer{
+				ID:          r.int("id"),
+				FullName:    r.string("full_name"),
+				CompanyName: r.string("company_name"),
+				Email:       r.string("email"),
+				Address:     r.string("address"),
+				PostalCode:  r.string("postal_code"),
+				City:        r.string("city"),
+				Country:     r.string("country"),
+			})
+		default:
+			return errors.Errorf("%s:%d: unknown table %q", filename, stmt.Line, table)
+		}
+		if r.err != nil {
+			return errors.Wrapf(r.err, "%s:%d", filename, stmt.Line)
+		}
+	}
+	return nil
+}
+
+// splitSQLValues splits a comma-separated list of SQL literals,
+// unquoting string literals.
+func splitSQLValues(s string) ([]string, error) {
+	var values []string
+	for {
+		s = strings.TrimSpace(s)
+		var value string
+		if strings.HasPrefix(s, "'") {
+			var b strings.Builder
+			i := 1
+			for {
+				if i >= len(s) {
+					return nil, errors.New("unterminated string literal")
+				}
+				if s[i] == '\'' {
+					if i+1 < len(s) && s[i+1] == '\'' {
+						b.WriteByte('\'')
+						i += 2
+						continue
+					}
+					break
+				}
+				b.WriteByte(s[i])
+				i++
+			}
+			value, s = b.String(), strings.TrimSpace(s[i+1:])
+		} else {
+			end := strings.IndexByte(s, ',')
+			if end < 0 {
+				end = len(s)
+			}
+			value, s = strings.TrimSpace(s[:end]), s[end:]
+		}
+		values = append(values, value)
+		if s == "" {
+			return values, nil
+		}
+		if s[0] != ',' {
+			return nil, errors.Errorf("unexpected %q after value", s[0])
+		}
+		s = s[1:]
+	}
+}
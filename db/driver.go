@@ -0,0 +1,26 @@
+package opbeansdb
+
+import "time"
+
+// UsesLastInsertID reports whether IDs generated for inserted rows must be
+// obtained with LastInsertId for the given driver, as the database does not
+// support INSERT ... RETURNING.
+func UsesLastInsertID(driver string) bool {
+	switch driver {
+	case "sqlite3", "mysql":
+		return true
+	}
+	return false
+}
+
+// TimeArg returns t in a form suitable for storing in, or comparing
+// against, TIMESTAMP columns with the given driver.
+func TimeArg(driver string, t time.Time) interface{} {
+	t = t.UTC()
+	if driver == "sqlite3" {
+		// SQLite stores timestamps as text; CURRENT_TIMESTAMP
+		// produces values in this format.
+		return t.Format("2006-01-02 15:04:05")
+	}
+	return t
+}
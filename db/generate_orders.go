@@ -2,7 +2,10 @@ package opbeansdb
 
 import (
 	"context"
+	"math"
 	"math/rand"
+	"sort"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
@@ -10,15 +13,221 @@ import (
 
 const (
 	maxOrderAmount = 3
+	maxOrderLines  = 5
+
+	// orderBatchSize is the number of orders generated
+	// and inserted at a time.
+	orderBatchSize = 5000
+
+	// DefaultOrderWindow is the default period of
+	// time over which generated orders are spread.
+	DefaultOrderWindow = 90 * 24 * time.Hour
+
+	// repeatCustomerProbability is the probability that an order
+	// is placed by a customer who has placed an order before.
+	repeatCustomerProbability = 0.6
+
+	// extraLineProbability is the probability that an order
+	// has another line, making basket sizes roughly geometric.
+	extraLineProbability = 0.45
+
+	// extraAmountProbability is the probability that
+	// the amount of an order line is incremented.
+	extraAmountProbability = 0.3
+)
+
+var (
+	orderColumns       = []string{"id", "customer_id", "created_at"}
+	orderLineColumns   = []string{"order_id", "product_id", "amount", "unit_price", "unit_cost"}
+	orderStatusColumns = []string{"order_id", "status", "created_at"}
+)
+
+// hourWeights and weekdayWeights describe the relative number
+// of orders placed in each hour of the day (UTC), and on each
+// day of the week, starting on Sunday.
+var (
+	hourWeights = [24]float64{
+		0.1, 0.05, 0.05, 0.05, 0.05, 0.1, 0.3, 0.6, 0.9, 1.0, 1.0, 1.1,
+		1.3, 1.2, 1.0, 0.9, 0.9, 1.0, 1.2, 1.4, 1.4, 1.1, 0.6, 0.3,
+	}
+	weekdayWeights = [7]float64{0.7, 1.0, 1.0, 1.0, 1.05, 1.15, 0.8}
 )
 
-// GenerateOrders generates n orders, randomizing the
-// products and customers in use.
+// Order is a generated order.
+type Order struct {
+	CustomerID int
+	CreatedAt  time.Time
+	Lines      []OrderLine
+}
+
+// OrderLine is a line of a generated order.
+type OrderLine struct {
+	ProductID int
+	Amount    int
+	UnitPrice int
+	UnitCost  int
+}
+
+// OrderGenerator generates random orders, for a given set of products
+// and customers. Products are ordered with Zipf-distributed popularity,
+// customers tend to order repeatedly, and orders are spread over time
+// with daily and weekly seasonality.
+type OrderGenerator struct {
+	rng         *rand.Rand
+	products    []Product
+	popularity  []float64 // cumulative product weights
+	customerIDs []int
+	ordered     []int // customer IDs of previous orders
+
+	hours     []orderHour
+	remaining int
+	position  float64 // in [0, 1), position of the previous order
+}
+
+// orderHour is a period of at most an hour, during
+// which orders are placed at a constant rate.
+type orderHour struct {
+	start    time.Time
+	duration time.Duration
+	weight   float64
+	cum      float64 // cumulative weight up to and including this hour
+}
+
+// NewOrderGenerator returns an OrderGenerator which generates n orders
+// created between from and to, in order of creation, using rng to choose
+// the products, customers and times. If from is not before to, all orders
+// are created at time to.
+func NewOrderGenerator(products []Product, customerIDs []int, rng *rand.Rand, from, to time.Time, n int) *OrderGenerator {
+	g := &OrderGenerator{
+		rng:         rng,
+		products:    products,
+		popularity:  make([]float64, len(products)),
+		customerIDs: customerIDs,
+		remaining:   n,
+	}
+
+	// Assign popularity ranks to the products at random,
+	// so that popularity is independent of their order.
+	var total float64
+	for i, rank := range rng.Perm(len(products)) {
+		total += 1 / float64(rank+1)
+		g.popularity[i] = total
+	}
+
+	from = from.UTC().Truncate(time.Second)
+	to = to.UTC().Truncate(time.Second)
+	if !from.Before(to) {
+		g.hours = []orderHour{{start: to, weight: 1, cum: 1}}
+		return g
+	}
+	var cum float64
+	for t := from; t.Before(to); {
+		end := t.Truncate(time.Hour).Add(time.Hour)
+		if end.After(to) {
+			end = to
+		}
+		hour := orderHour{
+			start:    t,
+			duration: end.Sub(t),
+			weight:   hourWeights[t.Hour()] * weekdayWeights[t.Weekday()],
+		}
+		cum += hour.weight * hour.duration.Hours()
+		hour.cum = cum
+		g.hours = append(g.hours, hour)
+		t = end
+	}
+	return g
+}
+
+// Next returns the next generated order, or false
+// if all orders have been generated.
+func (g *OrderGenerator) Next() (Order, bool) {
+	if g.remaining == 0 {
+		return Order{}, false
+	}
+	order := Order{
+		CustomerID: g.nextCustomerID(),
+		CreatedAt:  g.nextTime(),
+	}
+	chosen := make(map[int]bool)
+	for len(order.Lines) < maxOrderLines && len(order.Lines) < len(g.products) {
+		product := g.nextProduct()
+		if chosen[product.ID] {
+			continue
+		}
+		chosen[product.ID] = true
+		amount := 1
+		for amount < maxOrderAmount && g.rng.Float64() < extraAmountProbability {
+			amount++
+		}
+		order.Lines = append(order.Lines, OrderLine{
+			ProductID: product.ID,
+			Amount:    amount,
+			UnitPrice: product.SellingPrice,
+			UnitCost:  product.Cost,
+		})
+		if g.rng.Float64() >= extraLineProbability {
+			break
+		}
+	}
+	return order, true
+}
+
+func (g *OrderGenerator) nextProduct() Product {
+	x := g.rng.Float64() * g.popularity[len(g.popularity)-1]
+	i := sort.SearchFloat64s(g.popularity, x)
+	if i == len(g.popularity) {
+		i--
+	}
+	return g.products[i]
+}
+
+func (g *OrderGenerator) nextCustomerID() int {
+	var id int
+	if len(g.ordered) > 0 && g.rng.Float64() < repeatCustomerProbability {
+		// Choosing from previous orders favours
+		// customers who have ordered the most.
+		id = g.ordered[g.rng.Intn(len(g.ordered))]
+	} else {
+		id = g.customerIDs[g.rng.Intn(len(g.customerIDs))]
+	}
+	g.ordered = append(g.ordered, id)
+	return id
+}
+
+// nextTime returns the time of the next order. The times of the
+// remaining orders are uniformly distributed over the cumulative
+// weight of the hours following the previous order, so the next
+// position is the minimum of that many uniform random variables.
+func (g *OrderGenerator) nextTime() time.Time {
+	g.position += (1 - g.position) * (1 - math.Pow(g.rng.Float64(), 1/float64(g.remaining)))
+	g.remaining--
+
+	x := g.position * g.hours[len(g.hours)-1].cum
+	i := sort.Search(len(g.hours), func(i int) bool { return g.hours[i].cum > x })
+	if i == len(g.hours) {
+		i--
+	}
+	hour := g.hours[i]
+	if hour.duration == 0 {
+		// from is not before to, so all orders are created at to.
+		return hour.start
+	}
+	offset := (x - (hour.cum - hour.weight*hour.duration.Hours())) / hour.weight
+	t := hour.start.Add(time.Duration(offset * float64(time.Hour)))
+	if max := hour.start.Add(hour.duration); t.After(max) {
+		t = max
+	}
+	return t.Truncate(time.Second)
+}
+
+// GenerateOrders generates n orders, randomizing the products and
+// customers in use, created over the DefaultOrderWindow until now.
 func GenerateOrders(db *sqlx.DB, driver string, n int, rng *rand.Rand) error {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	productIDs, err := getIDs(ctx, db, "products")
+	products, err := getProducts(ctx, db)
 	if err != nil {
 		return err
 	}
@@ -28,65 +237,114 @@ func GenerateOrders(db *sqlx.DB, driver string, n int, rng *rand.Rand) error {
 		return err
 	}
 
-	tx, err := db.BeginTx(ctx, nil)
+	tx, err := db.BeginTxx(ctx, nil)
 	if err != nil {
 		return err
 	}
+	defer tx.Rollback()
 
-	returningID := "RETURNING id"
-	if driver == "sqlite3" {
-		returningID = ""
+	now := time.Now()
+	gen := NewOrderGenerator(products, customerIDs, rng, now.Add(-DefaultOrderWindow), now, n)
+	if err := insertOrders(ctx, tx, driver, gen); err != nil {
+		return err
 	}
+	return tx.Commit()
+}
 
-	insertOrderStmt, err := tx.PrepareContext(ctx, db.Rebind(
-		"INSERT INTO orders (customer_id) VALUES (?) "+returningID,
-	))
-	if err != nil {
-		return errors.Wrap(err, "failed to prepare insert orders statement")
+// insertOrders inserts the orders generated by gen, in batches of
+// orderBatchSize. The orders are assigned IDs following the highest
+// existing order ID, and each batch is generated while the previous
+// one is being inserted.
+func insertOrders(ctx context.Context, tx *sqlx.Tx, driver string, gen *OrderGenerator) error {
+	var maxID int64
+	if err := tx.QueryRowContext(ctx, "SELECT COALESCE(MAX(id), 0) FROM orders").Scan(&maxID); err != nil {
+		return errors.Wrap(err, "querying order IDs")
 	}
-	defer insertOrderStmt.Close()
 
-	insertOrderLineStmt, err := tx.PrepareContext(ctx, db.Rebind(
-		"INSERT INTO order_lines (order_id, product_id, amount) VALUES(?, ?, ?)",
-	))
-	if err != nil {
-		return errors.Wrap(err, "failed to prepare insert order lines statement")
-	}
-	defer insertOrderLineStmt.Close()
-
-	for i := 0; i < n; i++ {
-		productID := productIDs[rng.Intn(len(productIDs))]
-		customerID := customerIDs[rng.Intn(len(customerIDs))]
-		var orderID int64
-		if driver == "sqlite3" {
-			result, err := insertOrderStmt.ExecContext(ctx, customerID)
-			if err != nil {
-				return err
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	batches := make(chan []Order, 1)
+	go func() {
+		defer close(batches)
+		for {
+			batch := make([]Order, 0, orderBatchSize)
+			for len(batch) < orderBatchSize {
+				order, ok := gen.Next()
+				if !ok {
+					break
+				}
+				batch = append(batch, order)
 			}
-			rowID, err := result.LastInsertId()
-			if err != nil {
-				return err
+			if len(batch) == 0 {
+				return
 			}
-			orderID = rowID
-		} else {
-			err := insertOrderStmt.QueryRowContext(ctx, customerID).Scan(&orderID)
-			if err != nil {
-				return err
+			select {
+			case batches <- batch:
+			case <-ctx.Done():
+				return
 			}
 		}
+	}()
 
-		amount := rng.Intn(maxOrderAmount + 1)
-		if _, err := insertOrderLineStmt.ExecContext(ctx, orderID, productID, amount); err != nil {
+	nextID := maxID + 1
+	for batch := range batches {
+		orders := make([][]interface{}, len(batch))
+		history := make([][]interface{}, len(batch))
+		var lines [][]interface{}
+		for i, order := range batch {
+			id := nextID
+			nextID++
+			createdAt := TimeArg(driver, order.CreatedAt)
+			orders[i] = []interface{}{id, order.CustomerID, createdAt}
+			history[i] = []interface{}{id, "pending", createdAt}
+			for _, line := range order.Lines {
+				lines = append(lines, []interface{}{
+					id, line.ProductID, line.Amount, line.UnitPrice, line.UnitCost,
+				})
+			}
+		}
+		if err := loadRows(ctx, tx, driver, "orders", orderColumns, orders); err != nil {
 			return err
 		}
+		if err := loadRows(ctx, tx, driver, "order_lines", orderLineColumns, lines); err != nil {
+			return err
+		}
+		if err := loadRows(ctx, tx, driver, "order_status_history", orderStatusColumns, history); err != nil {
+			return err
+		}
+	}
+	if driver == "postgres" && nextID > maxID+1 {
+		// IDs were inserted explicitly, so the serial
+		// sequence must be advanced past them.
+		if _, err := tx.ExecContext(ctx,
+			"SELECT setval(pg_get_serial_sequence('orders', 'id'), $1)", nextID-1,
+		); err != nil {
+			return errors.Wrap(err, "advancing orders sequence")
+		}
 	}
+	return nil
+}
 
-	return tx.Commit()
+func getProducts(ctx context.Context, db *sqlx.DB) ([]Product, error) {
+	var products []Product
+	rows, err := db.QueryContext(ctx, "SELECT id, selling_price, cost FROM products ORDER BY id")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var p Product
+		if err := rows.Scan(&p.ID, &p.SellingPrice, &p.Cost); err != nil {
+			return nil, err
+		}
+		products = append(products, p)
+	}
+	return products, rows.Close()
 }
 
 func getIDs(ctx context.Context, db *sqlx.DB, table string) ([]int, error) {
 	var ids []int
-	rows, err := db.QueryContext(ctx, "SELECT id FROM "+table)
+	rows, err := db.QueryContext(ctx, "SELECT id FROM "+table+" ORDER BY id")
 	if err != nil {
 		return nil, err
 	}
@@ -4,8 +4,11 @@ import (
 	"context"
 	"math/rand"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
+	_ "github.com/go-sql-driver/mysql"
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
 	_ "github.com/mattn/go-sqlite3"
@@ -18,7 +21,7 @@ func TestGenerateOrdersSQLite3(t *testing.T) {
 	require.NoError(t, err)
 	defer db.Close()
 
-	requireExecCommands(t, db, "sql/schema_sqlite3.sql")
+	require.NoError(t, MigrateUp(context.Background(), db, "sqlite3", 0))
 	requireExecCommands(t, db, "sql/products.sql")
 	requireExecCommands(t, db, "sql/customers.sql")
 	assertGenerateOrders(t, db, "sqlite3")
@@ -35,12 +38,28 @@ func TestGenerateOrdersPostgres(t *testing.T) {
 	db.Exec("CREATE DATABASE opbeans_go_test")
 	defer db.Exec("DROP DATABASE opbeans_go_test")
 
-	requireExecCommands(t, db, "sql/schema_postgres.sql")
+	require.NoError(t, MigrateUp(context.Background(), db, "postgres", 0))
 	requireExecCommands(t, db, "sql/products.sql")
 	requireExecCommands(t, db, "sql/customers.sql")
 	assertGenerateOrders(t, db, "postgres")
 }
 
+func TestGenerateOrdersMySQL(t *testing.T) {
+	dsn := os.Getenv("MYSQL_DSN")
+	if dsn == "" {
+		t.Skip("MYSQL_DSN not set")
+	}
+	db, err := sqlx.Open("mysql", dsn)
+	require.NoError(t, err)
+	defer db.Close()
+
+	require.NoError(t, MigrateUp(context.Background(), db, "mysql", 0))
+	defer MigrateDown(context.Background(), db, "mysql", 1)
+	requireExecCommands(t, db, "sql/products.sql")
+	requireExecCommands(t, db, "sql/customers.sql")
+	assertGenerateOrders(t, db, "mysql")
+}
+
 func assertGenerateOrders(t *testing.T, db *sqlx.DB, driver string) {
 	rng := rand.New(rand.NewSource(0))
 	err := GenerateOrders(db, driver, 100, rng)
@@ -58,13 +77,165 @@ func assertGenerateOrders(t *testing.T, db *sqlx.DB, driver string) {
 	row = db.QueryRow("SELECT COUNT(*) FROM order_lines")
 	err = row.Scan(&orderLinesCount)
 	require.NoError(t, err)
-	assert.Equal(t, 100, orderLinesCount)
+	assert.True(t, orderLinesCount >= 100 && orderLinesCount <= 100*maxOrderLines, orderLinesCount)
+
+	var zeroAmountCount int
+	row = db.QueryRow("SELECT COUNT(*) FROM order_lines WHERE amount <= 0")
+	err = row.Scan(&zeroAmountCount)
+	require.NoError(t, err)
+	assert.Zero(t, zeroAmountCount)
+}
+
+func TestOrderGenerator(t *testing.T) {
+	var products []Product
+	for i := 1; i <= 20; i++ {
+		products = append(products, Product{ID: i, Cost: i, SellingPrice: 2 * i})
+	}
+	var customerIDs []int
+	for i := 1; i <= 1000; i++ {
+		customerIDs = append(customerIDs, i)
+	}
+	to := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	from := to.Add(-28 * 24 * time.Hour)
+	gen := NewOrderGenerator(products, customerIDs, rand.New(rand.NewSource(0)), from, to, 10000)
+
+	var orders []Order
+	for {
+		order, ok := gen.Next()
+		if !ok {
+			break
+		}
+		orders = append(orders, order)
+	}
+	require.Len(t, orders, 10000)
+
+	productOrders := make(map[int]int)
+	customerOrders := make(map[int]int)
+	var hourOrders [24]int
+	var multiLineOrders int
+	for i, order := range orders {
+		assert.False(t, order.CreatedAt.Before(from))
+		assert.False(t, order.CreatedAt.After(to))
+		if i > 0 {
+			assert.False(t, order.CreatedAt.Before(orders[i-1].CreatedAt))
+		}
+		require.NotEmpty(t, order.Lines)
+		if len(order.Lines) > 1 {
+			multiLineOrders++
+		}
+		seen := make(map[int]bool)
+		for _, line := range order.Lines {
+			assert.False(t, seen[line.ProductID])
+			seen[line.ProductID] = true
+			assert.True(t, line.Amount >= 1 && line.Amount <= maxOrderAmount)
+			assert.Equal(t, 2*line.UnitCost, line.UnitPrice)
+			productOrders[line.ProductID]++
+		}
+		customerOrders[order.CustomerID]++
+		hourOrders[order.CreatedAt.Hour()]++
+	}
+
+	// Roughly half of the orders should have multiple lines.
+	assert.InDelta(t, 0.45, float64(multiLineOrders)/float64(len(orders)), 0.05)
+
+	// Products should have skewed popularity.
+	var minProductOrders, maxProductOrders int = len(orders), 0
+	for _, n := range productOrders {
+		minProductOrders = min(minProductOrders, n)
+		maxProductOrders = max(maxProductOrders, n)
+	}
+	assert.Greater(t, maxProductOrders, 5*minProductOrders)
+
+	// Many customers should order repeatedly, some of them frequently.
+	var maxCustomerOrders int
+	for _, n := range customerOrders {
+		maxCustomerOrders = max(maxCustomerOrders, n)
+	}
+	assert.Less(t, len(customerOrders), 1000)
+	assert.Greater(t, maxCustomerOrders, 20)
+
+	// Orders should follow the daily cycle.
+	assert.Greater(t, hourOrders[19], 5*hourOrders[3])
+
+	// With an empty window, all orders are created at to.
+	gen = NewOrderGenerator(products, customerIDs, rand.New(rand.NewSource(0)), to, to, 100)
+	for {
+		order, ok := gen.Next()
+		if !ok {
+			break
+		}
+		assert.Equal(t, to, order.CreatedAt)
+	}
 }
 
 func requireExecCommands(t *testing.T, db *sqlx.DB, filename string) {
 	f, err := os.Open(filename)
 	require.NoError(t, err)
 	defer f.Close()
-	err = ExecCommands(context.Background(), db, f)
+	err = ExecScript(context.Background(), db, filename, f)
 	require.NoError(t, err)
 }
+
+func BenchmarkOrderGenerator(b *testing.B) {
+	products := make([]Product, 20)
+	for i := range products {
+		products[i] = Product{ID: i + 1}
+	}
+	customerIDs := make([]int, 1000)
+	for i := range customerIDs {
+		customerIDs[i] = i + 1
+	}
+	now := time.Now()
+	gen := NewOrderGenerator(products, customerIDs, rand.New(rand.NewSource(0)), now.Add(-DefaultOrderWindow), now, b.N)
+	b.ResetTimer()
+	for {
+		if _, ok := gen.Next(); !ok {
+			break
+		}
+	}
+	reportOrderThroughput(b)
+}
+
+func BenchmarkGenerateOrdersSQLite3(b *testing.B) {
+	db, err := sqlx.Open("sqlite3", filepath.Join(b.TempDir(), "opbeans.db"))
+	require.NoError(b, err)
+	defer db.Close()
+	benchmarkGenerateOrders(b, db, "sqlite3")
+}
+
+func BenchmarkGenerateOrdersPostgres(b *testing.B) {
+	if os.Getenv("PGHOST") == "" {
+		b.Skip("PGHOST not set")
+	}
+	db, err := sqlx.Open("postgres", "")
+	require.NoError(b, err)
+	defer db.Close()
+	defer MigrateDown(context.Background(), db, "postgres", 1)
+	benchmarkGenerateOrders(b, db, "postgres")
+}
+
+func BenchmarkGenerateOrdersMySQL(b *testing.B) {
+	dsn := os.Getenv("MYSQL_DSN")
+	if dsn == "" {
+		b.Skip("MYSQL_DSN not set")
+	}
+	db, err := sqlx.Open("mysql", dsn)
+	require.NoError(b, err)
+	defer db.Close()
+	defer MigrateDown(context.Background(), db, "mysql", 1)
+	benchmarkGenerateOrders(b, db, "mysql")
+}
+
+// benchmarkGenerateOrders measures the throughput of generating
+// and inserting b.N orders into a newly seeded database.
+func benchmarkGenerateOrders(b *testing.B, db *sqlx.DB, driver string) {
+	require.NoError(b, MigrateUp(context.Background(), db, driver, 0))
+	require.NoError(b, Seed(context.Background(), db, driver, SeedConfig{Time: time.Now()}))
+	b.ResetTimer()
+	require.NoError(b, GenerateOrders(db, driver, b.N, rand.New(rand.NewSource(0))))
+	reportOrderThroughput(b)
+}
+
+func reportOrderThroughput(b *testing.B) {
+	b.ReportMetric(float64(b.N)/b.Elapsed().Seconds(), "orders/s")
+}
@@ -0,0 +1,271 @@
+package opbeansdb
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+// migrationsTable records the schema migrations that have been applied.
+const migrationsTable = "schema_migrations"
+
+var migrationFilenameRegexp = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// migrationRequiresPrefix introduces a query in the first line of
+// an up file, which must return true for the migration to be applied.
+const migrationRequiresPrefix = "-- requires:"
+
+// Migration is a versioned, reversible schema change. The statements
+// for applying and reverting each migration are held in the files
+// sql/migrations/<driver>/<version>_<name>.{up,down}.sql.
+//
+// A migration which needs an optional database feature, such as an
+// SQLite extension, may start its up file with a line of the form
+// "-- requires: <query>". The migration is then applied only if the
+// query returns true, and otherwise remains pending. Later migrations
+// must not depend on such migrations.
+type Migration struct {
+	Version int
+	Name    string
+
+	// AppliedAt holds the time at which the migration was applied,
+	// or nil if it has not been applied. It is set only by
+	// MigrationStatus.
+	AppliedAt *time.Time
+
+	up, down string
+	requires string
+}
+
+// Migrations returns the migrations defined for the given driver,
+// in ascending order of version.
+func Migrations(driver string) ([]Migration, error) {
+	dir := path.Join("migrations", driver)
+	infos, err := fs.ReadDir(SQL, dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "no migrations for driver %q", driver)
+	}
+
+	byVersion := make(map[int]*Migration)
+	for _, info := range infos {
+		match := migrationFilenameRegexp.FindStringSubmatch(info.Name())
+		if match == nil {
+			return nil, errors.Errorf("invalid migration filename %q", info.Name())
+		}
+		version, _ := strconv.Atoi(match[1])
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		} else if m.Name != match[2] {
+			return nil, errors.Errorf("conflicting names for migration %d: %q and %q", version, m.Name, match[2])
+		}
+		filename := path.Join(dir, info.Name())
+		if match[3] == "up" {
+			m.up = filename
+		} else {
+			m.down = filename
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.up == "" || m.down == "" {
+			return nil, errors.Errorf("migration %d (%s) must have both up and down files", m.Version, m.Name)
+		}
+		up, err := fs.ReadFile(SQL, m.up)
+		if err != nil {
+			return nil, err
+		}
+		firstLine, _, _ := strings.Cut(string(up), "\n")
+		if query, ok := strings.CutPrefix(firstLine, migrationRequiresPrefix); ok {
+			m.requires = strings.TrimSpace(query)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+// MigrationStatus returns the migrations defined for the driver,
+// with AppliedAt set for those which have been applied to db.
+func MigrationStatus(ctx context.Context, db *sqlx.DB, driver string) ([]Migration, error) {
+	migrations, err := Migrations(driver)
+	if err != nil {
+		return nil, err
+	}
+	applied, err := appliedMigrations(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	for i, m := range migrations {
+		if t, ok := applied[m.Version]; ok {
+			t := t
+			migrations[i].AppliedAt = &t
+		}
+	}
+	return migrations, nil
+}
+
+// MigrateUp applies, in order, each pending migration with a version
+// less than or equal to target. If target is zero, all pending
+// migrations are applied. Each migration is applied in its own
+// transaction. Note that MySQL implicitly commits DDL statements, so
+// a failed migration may be partially applied with that driver.
+//
+// Databases created before the introduction of migrations, which have
+// the tables defined by the initial migration but no migrations table,
+// are recorded as having the initial migration applied. The initial
+// migration must therefore create exactly the schema created by earlier
+// versions; later changes belong in later migrations.
+func MigrateUp(ctx context.Context, db *sqlx.DB, driver string, target int) error {
+	migrations, err := Migrations(driver)
+	if err != nil {
+		return err
+	}
+	if err := baselineLegacySchema(ctx, db, migrations); err != nil {
+		return err
+	}
+	applied, err := appliedMigrations(ctx, db)
+	if err != nil {
+		return err
+	}
+	for _, m := range migrations {
+		if target > 0 && m.Version > target {
+			break
+		}
+		if _, ok := applied[m.Version]; ok {
+			continue
+		}
+		if m.requires != "" {
+			var supported bool
+			if err := db.GetContext(ctx, &supported, m.requires); err != nil {
+				return errors.Wrapf(err, "checking requirements of migration %d (%s)", m.Version, m.Name)
+			}
+			if !supported {
+				continue
+			}
+		}
+		if err := runMigration(ctx, db, m, m.up, func(tx *sqlx.Tx) error {
+			_, err := tx.ExecContext(ctx, tx.Rebind(
+				"INSERT INTO "+migrationsTable+" (version, name) VALUES (?, ?)",
+			), m.Version, m.Name)
+			return err
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MigrateDown reverts the most recently applied migrations, up to
+// the given number of steps, in reverse order of version.
+func MigrateDown(ctx context.Context, db *sqlx.DB, driver string, steps int) error {
+	migrations, err := Migrations(driver)
+	if err != nil {
+		return err
+	}
+	applied, err := appliedMigrations(ctx, db)
+	if err != nil {
+		return err
+	}
+	for i := len(migrations) - 1; i >= 0 && steps > 0; i-- {
+		m := migrations[i]
+		if _, ok := applied[m.Version]; !ok {
+			continue
+		}
+		if err := runMigration(ctx, db, m, m.down, func(tx *sqlx.Tx) error {
+			_, err := tx.ExecContext(ctx, tx.Rebind(
+				"DELETE FROM "+migrationsTable+" WHERE version=?",
+			), m.Version)
+			return err
+		}); err != nil {
+			return err
+		}
+		steps--
+	}
+	return nil
+}
+
+func runMigration(ctx context.Context, db *sqlx.DB, m Migration, filename string, record func(*sqlx.Tx) error) error {
+	f, err := SQL.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	tx, err := db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if err := ExecCommands(ctx, tx, filename, f); err != nil {
+		return err
+	}
+	if err := record(tx); err != nil {
+		return errors.Wrapf(err, "recording migration %d (%s)", m.Version, m.Name)
+	}
+	return tx.Commit()
+}
+
+// appliedMigrations returns the versions of the applied migrations,
+// and the times at which they were applied, creating the migrations
+// table if it does not exist.
+func appliedMigrations(ctx context.Context, db *sqlx.DB) (map[int]time.Time, error) {
+	if _, err := db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS `+migrationsTable+` (
+  version int NOT NULL PRIMARY KEY,
+  name varchar(255) NOT NULL,
+  applied_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+)`); err != nil {
+		return nil, errors.Wrap(err, "creating migrations table")
+	}
+	rows, err := db.QueryContext(ctx, "SELECT version, applied_at FROM "+migrationsTable)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying migrations")
+	}
+	defer rows.Close()
+
+	applied := make(map[int]time.Time)
+	for rows.Next() {
+		var version int
+		var appliedAt time.Time
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, err
+		}
+		applied[version] = appliedAt
+	}
+	return applied, rows.Err()
+}
+
+// baselineLegacySchema records the initial migration as applied if the
+// database has no migrations table, but does have an orders table.
+func baselineLegacySchema(ctx context.Context, db *sqlx.DB, migrations []Migration) error {
+	if len(migrations) == 0 || tableExists(ctx, db, migrationsTable) || !tableExists(ctx, db, "orders") {
+		return nil
+	}
+	if _, err := appliedMigrations(ctx, db); err != nil {
+		return err
+	}
+	initial := migrations[0]
+	_, err := db.ExecContext(ctx, db.Rebind(
+		"INSERT INTO "+migrationsTable+" (version, name) VALUES (?, ?)",
+	), initial.Version, initial.Name)
+	return errors.Wrap(err, "recording initial migration for existing schema")
+}
+
+func tableExists(ctx context.Context, db *sqlx.DB, table string) bool {
+	var n int
+	err := db.QueryRowContext(ctx, fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE 1=0", table)).Scan(&n)
+	return err == nil
+}
@@ -0,0 +1,122 @@
+package opbeansdb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrationsSQLite3(t *testing.T) {
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+	ctx := context.Background()
+
+	migrations, err := Migrations("sqlite3")
+	require.NoError(t, err)
+	require.NotEmpty(t, migrations)
+	assert.Equal(t, 1, migrations[0].Version)
+	assert.Equal(t, "initial", migrations[0].Name)
+
+	// Migrations requiring unavailable features, such as
+	// FTS5 without the sqlite_fts5 tag, remain pending.
+	supported := supportedMigrations(t, db, migrations)
+
+	require.NoError(t, MigrateUp(ctx, db, "sqlite3", 0))
+	assert.True(t, tableExists(ctx, db, "orders"))
+	assertMigrationsApplied(t, db, supported)
+
+	// Applying migrations again is a no-op.
+	require.NoError(t, MigrateUp(ctx, db, "sqlite3", 0))
+	assertMigrationsApplied(t, db, supported)
+
+	require.NoError(t, MigrateDown(ctx, db, "sqlite3", len(migrations)))
+	assert.False(t, tableExists(ctx, db, "orders"))
+	assertMigrationsApplied(t, db, 0)
+
+	require.NoError(t, MigrateUp(ctx, db, "sqlite3", 0))
+	assertMigrationsApplied(t, db, supported)
+}
+
+func TestMigrationsBaselineLegacySchema(t *testing.T) {
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+	ctx := context.Background()
+
+	// Simulate a database created before migrations were introduced,
+	// whose schema is that of the initial migration.
+	require.NoError(t, MigrateUp(ctx, db, "sqlite3", 1))
+	_, err = db.Exec("DROP TABLE " + migrationsTable)
+	require.NoError(t, err)
+	for _, stmt := range []string{
+		`INSERT INTO product_types (id, name) VALUES (1, 'Beans')`,
+		`INSERT INTO products (id, sku, name, description, type_id, stock, cost, selling_price) VALUES (1, 'OP-1', 'Espresso', '', 1, 10, 300, 500)`,
+		`INSERT INTO customers (id, full_name, company_name, email, address, postal_code, city, country) VALUES (1, 'A', 'B', 'a@b', 'C', '1', 'D', 'E')`,
+		`INSERT INTO orders (id, customer_id) VALUES (1, 1)`,
+		`INSERT INTO order_lines (order_id, product_id, amount) VALUES (1, 1, 2)`,
+	} {
+		_, err := db.Exec(stmt)
+		require.NoError(t, err, stmt)
+	}
+
+	require.NoError(t, MigrateUp(ctx, db, "sqlite3", 0))
+	migrations, err := MigrationStatus(ctx, db, "sqlite3")
+	require.NoError(t, err)
+	assertMigrationsApplied(t, db, supportedMigrations(t, db, migrations))
+
+	// Later migrations upgrade the legacy schema and its data.
+	var line struct {
+		UnitPrice int `db:"unit_price"`
+		UnitCost  int `db:"unit_cost"`
+	}
+	require.NoError(t, db.Get(&line, "SELECT unit_price, unit_cost FROM order_lines"))
+	assert.Equal(t, 500, line.UnitPrice)
+	assert.Equal(t, 300, line.UnitCost)
+	var statuses []string
+	require.NoError(t, db.Select(&statuses, "SELECT status FROM order_status_history WHERE order_id=1"))
+	assert.Equal(t, []string{"pending"}, statuses)
+
+	// Products and customers are assigned ids.
+	result, err := db.Exec(`INSERT INTO products (sku, name, description, type_id, stock, cost, selling_price) VALUES ('OP-2', 'Latte', '', 1, 1, 1, 1)`)
+	require.NoError(t, err)
+	id, err := result.LastInsertId()
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), id)
+	var customerID int64
+	require.NoError(t, db.Get(&customerID, `INSERT INTO customers (full_name, company_name, email, address, postal_code, city, country) VALUES ('F', 'G', 'f@g', 'H', '2', 'I', 'J') RETURNING id`))
+	assert.Equal(t, int64(2), customerID)
+}
+
+// supportedMigrations returns the number of migrations
+// whose requirements are met by db.
+func supportedMigrations(t *testing.T, db *sqlx.DB, migrations []Migration) int {
+	var n int
+	for _, m := range migrations {
+		supported := true
+		if m.requires != "" {
+			require.NoError(t, db.Get(&supported, m.requires))
+		}
+		if supported {
+			n++
+		}
+	}
+	return n
+}
+
+func assertMigrationsApplied(t *testing.T, db *sqlx.DB, expected int) {
+	migrations, err := MigrationStatus(context.Background(), db, "sqlite3")
+	require.NoError(t, err)
+	var applied int
+	for _, m := range migrations {
+		if m.AppliedAt != nil {
+			applied++
+		}
+	}
+	assert.Equal(t, expected, applied)
+}
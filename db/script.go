@@ -1,37 +1,227 @@
 package opbeansdb
 
 import (
-	"bufio"
-	"bytes"
 	"context"
 	"io"
+	"path"
+	"strings"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
 )
 
-func ExecCommands(ctx context.Context, db *sqlx.DB, r io.Reader) error {
-	scanner := bufio.NewScanner(r)
-	scanner.Split(scanStatements)
-	for scanner.Scan() {
-		stmt := scanner.Text()
-		if _, err := db.ExecContext(ctx, stmt); err != nil {
-			return errors.Wrapf(err, "executing statement failed:\n%s", stmt)
+// Statement is an SQL statement read from a script.
+type Statement struct {
+	// Text holds the text of the statement,
+	// without the terminating semicolon.
+	Text string
+
+	// Line is the line of the script on which the statement starts.
+	Line int
+}
+
+// ExecScript executes the SQL script read from r in a single transaction.
+// The script's filename is used for reporting errors.
+func ExecScript(ctx context.Context, db *sqlx.DB, filename string, r io.Reader) error {
+	tx, err := db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if err := ExecCommands(ctx, tx, filename, r); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// ExecCommands executes the statements of the SQL script read from r,
+// which is typically a transaction. The script's filename is used for
+// reporting errors.
+func ExecCommands(ctx context.Context, db sqlx.ExecerContext, filename string, r io.Reader) error {
+	stmts, err := ReadStatements(r)
+	if err != nil {
+		return errors.Wrapf(err, "reading %s", filename)
+	}
+	for _, stmt := range stmts {
+		if _, err := db.ExecContext(ctx, stmt.Text); err != nil {
+			return errors.Wrapf(err, "%s:%d: executing statement failed:\n%s", path.Base(filename), stmt.Line, stmt.Text)
 		}
 	}
 	return nil
 }
 
-func scanStatements(data []byte, atEOF bool) (advance int, token []byte, err error) {
-	if atEOF && len(data) == 0 {
-		return 0, nil, nil
+// ReadStatements reads the semicolon-separated SQL statements from r.
+//
+// Semicolons are ignored within string literals, quoted identifiers,
+// comments, Postgres dollar-quoted strings, and the BEGIN...END blocks
+// of CREATE statements such as SQLite and MySQL triggers. Comments
+// preceding a statement are omitted from its text.
+func ReadStatements(r io.Reader) ([]Statement, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return splitStatements(string(data))
+}
+
+func splitStatements(src string) ([]Statement, error) {
+	var (
+		stmts     []Statement
+		start     = -1 // offset of the current statement
+		startLine int
+		firstWord string // first word of the current statement
+		prevWord  string
+		depth     int // nesting of BEGIN...END blocks
+	)
+	line := 1
+	for i := 0; i < len(src); {
+		c := src[i]
+		tokenStart, tokenLine := i, line
+		switch {
+		case c == '\n':
+			line++
+			i++
+			continue
+		case c == ' ' || c == '\t' || c == '\r' || c == '\f':
+			i++
+			continue
+		case strings.HasPrefix(src[i:], "--"):
+			if end := strings.IndexByte(src[i:], '\n'); end >= 0 {
+				i += end
+			} else {
+				i = len(src)
+			}
+			continue
+		case strings.HasPrefix(src[i:], "/*"):
+			end := strings.Index(src[i+2:], "*/")
+			if end < 0 {
+				return nil, errors.Errorf("line %d: unterminated comment", line)
+			}
+			line += strings.Count(src[i:i+2+end], "\n")
+			i += end + 4
+			continue
+		case c == ';' && depth == 0:
+			if start >= 0 {
+				stmts = append(stmts, Statement{Text: strings.TrimSpace(src[start:i]), Line: startLine})
+			}
+			start, firstWord, prevWord = -1, "", ""
+			i++
+			continue
+		case c == '\'' || c == '"' || c == '`':
+			// Postgres escape strings, E'...', may contain backslash escapes.
+			backslash := c == '\'' && i > 0 && (src[i-1] == 'E' || src[i-1] == 'e') &&
+				(i == 1 || !isIdentByte(src[i-2]))
+			n := quotedLen(src[i:], backslash)
+			if n < 0 {
+				return nil, errors.Errorf("line %d: unterminated quoted string", line)
+			}
+			line += strings.Count(src[i:i+n], "\n")
+			i += n
+		case c == '$' && (i == 0 || !isIdentByte(src[i-1])):
+			tag := dollarTag(src[i:])
+			if tag == "" {
+				i++
+				break
+			}
+			end := strings.Index(src[i+len(tag):], tag)
+			if end < 0 {
+				return nil, errors.Errorf("line %d: unterminated dollar-quoted string", line)
+			}
+			n := 2*len(tag) + end
+			line += strings.Count(src[i:i+n], "\n")
+			i += n
+		case isIdentStart(c):
+			j := i + 1
+			for j < len(src) && isIdentByte(src[j]) {
+				j++
+			}
+			word := strings.ToUpper(src[i:j])
+			if firstWord == "" {
+				firstWord = word
+			}
+			if firstWord == "CREATE" {
+				switch word {
+				case "BEGIN":
+					depth++
+				case "CASE":
+					if depth > 0 {
+						depth++
+					}
+				case "END":
+					if depth > 0 {
+						depth--
+					}
+				case "IF", "LOOP", "WHILE", "REPEAT":
+					// END IF etc. close blocks which were not counted.
+					if prevWord == "END" {
+						depth++
+					}
+				}
+			}
+			prevWord = word
+			i = j
+		default:
+			prevWord = ""
+			i++
+		}
+		if start < 0 {
+			start, startLine = tokenStart, tokenLine
+		}
+	}
+	if depth > 0 {
+		return nil, errors.Errorf("line %d: unterminated BEGIN block", startLine)
+	}
+	if start >= 0 {
+		stmts = append(stmts, Statement{Text: strings.TrimSpace(src[start:]), Line: startLine})
+	}
+	return stmts, nil
+}
+
+// quotedLen returns the length of the string literal or quoted identifier
+// at the start of s, including its quotes, or -1 if it is unterminated.
+// Quotes are escaped by doubling them, or with a backslash if backslash
+// is true.
+func quotedLen(s string, backslash bool) int {
+	quote := s[0]
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if backslash {
+				i++
+			}
+		case quote:
+			if i+1 < len(s) && s[i+1] == quote {
+				i++
+				continue
+			}
+			return i + 1
+		}
 	}
-	if i := bytes.IndexByte(data, ';'); i >= 0 {
-		return i + 1, data[:i], nil
+	return -1
+}
+
+// dollarTag returns the tag ($$ or $name$) of the dollar-quoted string
+// at the start of s, or "" if s does not start with a tag, for example
+// if it starts with a parameter such as $1.
+func dollarTag(s string) string {
+	if len(s) < 2 || (s[1] != '$' && !isIdentStart(s[1])) {
+		return ""
 	}
-	if atEOF {
-		return 0, nil, errors.New("unterminated statement")
+	for i := 1; i < len(s); i++ {
+		switch {
+		case s[i] == '$':
+			return s[:i+1]
+		case !isIdentByte(s[i]):
+			return ""
+		}
 	}
-	// Request more data.
-	return 0, nil, nil
+	return ""
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || c >= 0x80 || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentByte(c byte) bool {
+	return isIdentStart(c) || (c >= '0' && c <= '9') || c == '$'
 }
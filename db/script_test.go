@@ -0,0 +1,110 @@
+package opbeansdb
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadStatements(t *testing.T) {
+	stmts, err := ReadStatements(strings.NewReader(`-- Leading comment; with a semicolon.
+INSERT INTO t (a, b) VALUES ('x;y', 'it''s');
+/* Block
+   comment; */ SELECT "odd;name", ` + "`back;tick`" + ` FROM t;
+
+CREATE FUNCTION f() RETURNS trigger AS $body$
+BEGIN
+  RAISE NOTICE 'f;';
+  RETURN NEW;
+END;
+$body$ LANGUAGE plpgsql;
+SELECT $$a;b$$, $1, E'\';';
+CREATE TRIGGER trg AFTER INSERT ON t
+BEGIN
+  UPDATE t SET b = CASE WHEN a = 'x' THEN 'y' ELSE b END;
+  DELETE FROM u;
+END;
+BEGIN;
+SELECT 1 -- no trailing semicolon
+`))
+	require.NoError(t, err)
+
+	var lines []int
+	var texts []string
+	for _, stmt := range stmts {
+		lines = append(lines, stmt.Line)
+		texts = append(texts, stmt.Text)
+	}
+	assert.Equal(t, []int{2, 4, 6, 12, 13, 18, 19}, lines)
+	assert.Equal(t, "INSERT INTO t (a, b) VALUES ('x;y', 'it''s')", texts[0])
+	assert.Equal(t, "SELECT \"odd;name\", `back;tick` FROM t", texts[1])
+	assert.True(t, strings.HasSuffix(texts[2], "$body$ LANGUAGE plpgsql"), texts[2])
+	assert.Equal(t, `SELECT $$a;b$$, $1, E'\';'`, texts[3])
+	assert.True(t, strings.HasSuffix(texts[4], "DELETE FROM u;\nEND"), texts[4])
+	assert.Equal(t, "BEGIN", texts[5])
+	assert.Equal(t, "SELECT 1 -- no trailing semicolon", texts[6])
+}
+
+func TestReadStatementsMySQLProcedure(t *testing.T) {
+	stmts, err := ReadStatements(strings.NewReader(`CREATE PROCEDURE p()
+BEGIN
+  IF 1 THEN
+    SELECT 1;
+  END IF;
+  SELECT 2;
+END;
+SELECT 3;`))
+	require.NoError(t, err)
+	require.Len(t, stmts, 2)
+	assert.Equal(t, "SELECT 3", stmts[1].Text)
+}
+
+func TestReadStatementsErrors(t *testing.T) {
+	for src, expected := range map[string]string{
+		"SELECT 1;\nSELECT 'x;":          "line 2: unterminated quoted string",
+		"SELECT 1;\n/* comment":          "line 2: unterminated comment",
+		"\nSELECT $a$ x; $b$;":           "line 2: unterminated dollar-quoted string",
+		"CREATE TRIGGER t BEGIN SELECT;": "line 1: unterminated BEGIN block",
+	} {
+		_, err := ReadStatements(strings.NewReader(src))
+		assert.EqualError(t, err, expected, src)
+	}
+}
+
+func TestExecScript(t *testing.T) {
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	ctx := context.Background()
+	require.NoError(t, ExecScript(ctx, db, "create.sql", strings.NewReader(`
+CREATE TABLE t (a TEXT);
+CREATE TABLE log (a TEXT);
+CREATE TRIGGER t_insert AFTER INSERT ON t
+BEGIN
+  INSERT INTO log (a) VALUES (NEW.a || ';');
+END;
+INSERT INTO t (a) VALUES ('a;b');
+`)))
+	var logged string
+	require.NoError(t, db.Get(&logged, "SELECT a FROM log"))
+	assert.Equal(t, "a;b;", logged)
+
+	// The script is executed in a transaction,
+	// so a failure rolls back earlier statements.
+	err = ExecScript(ctx, db, "sql/insert.sql", strings.NewReader(`
+INSERT INTO t (a) VALUES ('c');
+-- This table does not exist.
+INSERT INTO missing (a) VALUES ('d');
+`))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "insert.sql:4: executing statement failed:\nINSERT INTO missing (a) VALUES ('d')")
+	var count int
+	require.NoError(t, db.Get(&count, "SELECT COUNT(*) FROM t"))
+	assert.Equal(t, 1, count)
+}
@@ -0,0 +1,86 @@
+package opbeansdb
+
+import (
+	"context"
+	"math/rand"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+// Seed populates an empty database with the dataset described by cfg,
+// and cfg.Orders generated orders, in a single transaction.
+func Seed(ctx context.Context, db *sqlx.DB, driver string, cfg SeedConfig) error {
+	rng := rand.New(rand.NewSource(cfg.Seed))
+	ds, err := NewDataset(cfg, rng)
+	if err != nil {
+		return err
+	}
+
+	tx, err := db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	productTypes := make([][]interface{}, len(ds.ProductTypes))
+	for i, pt := range ds.ProductTypes {
+		productTypes[i] = []interface{}{pt.ID, pt.Name}
+	}
+	if err := loadRows(ctx, tx, driver, "product_types", []string{"id", "name"}, productTypes); err != nil {
+		return err
+	}
+	products := make([][]interface{}, len(ds.Products))
+	for i, p := range ds.Products {
+		products[i] = []interface{}{
+			p.ID, p.SKU, p.Name, p.Description, p.TypeID, p.Stock, p.Cost, p.SellingPrice,
+		}
+	}
+	if err := loadRows(ctx, tx, driver, "products", []string{
+		"id", "sku", "name", "description", "type_id", "stock", "cost", "selling_price",
+	}, products); err != nil {
+		return err
+	}
+	customers := make([][]interface{}, len(ds.Customers))
+	for i, c := range ds.Customers {
+		customers[i] = []interface{}{
+			c.ID, c.FullName, c.CompanyName, c.Email, c.Address, c.PostalCode, c.City, c.Country,
+		}
+	}
+	if err := loadRows(ctx, tx, driver, "customers", []string{
+		"id", "full_name", "company_name", "email", "address", "postal_code", "city", "country",
+	}, customers); err != nil {
+		return err
+	}
+	if driver == "postgres" {
+		// IDs were inserted explicitly, so the serial
+		// sequences must be advanced past them.
+		f, err := SQL.Open("sequences_postgres.sql")
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		if err := ExecCommands(ctx, tx, "sequences_postgres.sql", f); err != nil {
+			return errors.Wrap(err, "advancing sequences")
+		}
+	}
+
+	if err := insertOrders(ctx, tx, driver, cfg.orderGenerator(ds, rng)); err != nil {
+		return errors.Wrap(err, "inserting orders")
+	}
+	return tx.Commit()
+}
+
+// CustomerIDs returns the IDs of the dataset's customers.
+func (ds *Dataset) CustomerIDs() []int {
+	ids := make([]int, len(ds.Customers))
+	for i, c := range ds.Customers {
+		ids[i] = c.ID
+	}
+	return ids
+}
+
+// orderGenerator returns an OrderGenerator for the orders described by cfg.
+func (cfg SeedConfig) orderGenerator(ds *Dataset, rng *rand.Rand) *OrderGenerator {
+	return NewOrderGenerator(ds.Products, ds.CustomerIDs(), rng, cfg.Time.Add(-cfg.Window), cfg.Time, cfg.Orders)
+}
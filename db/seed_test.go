@@ -0,0 +1,90 @@
+package opbeansdb
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeedDeterministic(t *testing.T) {
+	cfg := SeedConfig{
+		Seed:      42,
+		Time:      time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Orders:    200,
+		Customers: 1500,
+	}
+	dump1 := seedAndDump(t, cfg)
+	dump2 := seedAndDump(t, cfg)
+	assert.Equal(t, dump1, dump2)
+	assert.Contains(t, dump1, "customers 1500\n")
+
+	cfg.Seed++
+	assert.NotEqual(t, dump1, seedAndDump(t, cfg))
+}
+
+func TestSeedFiles(t *testing.T) {
+	dir := t.TempDir()
+	productsFile := filepath.Join(dir, "products.json")
+	customersFile := filepath.Join(dir, "customers.csv")
+	require.NoError(t, os.WriteFile(productsFile, []byte(`[
+  {"sku": "OP-1", "name": "Bean", "type": "Beans", "stock": 10, "cost": 100, "selling_price": 200},
+  {"sku": "OP-2", "name": "Grinder", "type": "Grinders", "stock": "5", "cost": 1000, "selling_price": 2000},
+  {"sku": "OP-3", "name": "Other bean", "type": "Beans", "stock": 1, "cost": 50, "selling_price": 75}
+]`), 0644))
+	require.NoError(t, os.WriteFile(customersFile, []byte(`full_name,email,city,country
+Jane Doe,jane@example.com,Amsterdam,Netherlands
+John Roe,john@example.com,Berlin,Germany
+`), 0644))
+
+	ds, err := NewDataset(SeedConfig{ProductsFile: productsFile, CustomersFile: customersFile}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []ProductType{{ID: 1, Name: "Beans"}, {ID: 2, Name: "Grinders"}}, ds.ProductTypes)
+	require.Len(t, ds.Products, 3)
+	assert.Equal(t, Product{ID: 2, SKU: "OP-2", Name: "Grinder", TypeID: 2, Stock: 5, Cost: 1000, SellingPrice: 2000}, ds.Products[1])
+	assert.Equal(t, 1, ds.Products[2].TypeID)
+	assert.Equal(t, []Customer{
+		{ID: 1, FullName: "Jane Doe", Email: "jane@example.com", City: "Amsterdam", Country: "Netherlands"},
+		{ID: 2, FullName: "John Roe", Email: "john@example.com", City: "Berlin", Country: "Germany"},
+	}, ds.Customers)
+
+	require.NoError(t, os.WriteFile(productsFile, []byte(`[{"sku": "OP-1", "name": "Bean", "type": "Beans", "stock": "many"}]`), 0644))
+	_, err = NewDataset(SeedConfig{ProductsFile: productsFile}, nil)
+	assert.EqualError(t, err, fmt.Sprintf(`loading products from %q: product 1: invalid stock "many"`, productsFile))
+}
+
+// seedAndDump seeds a new in-memory SQLite database,
+// and returns a textual dump of its contents.
+func seedAndDump(t *testing.T, cfg SeedConfig) string {
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	ctx := context.Background()
+	require.NoError(t, MigrateUp(ctx, db, "sqlite3", 0))
+	require.NoError(t, Seed(ctx, db, "sqlite3", cfg))
+
+	var dump strings.Builder
+	for _, table := range []string{"product_types", "products", "customers", "orders", "order_lines", "order_status_history"} {
+		rows, err := db.Queryx("SELECT * FROM " + table + " ORDER BY 1, 2")
+		require.NoError(t, err)
+		n := 0
+		for rows.Next() {
+			values, err := rows.SliceScan()
+			require.NoError(t, err)
+			fmt.Fprintln(&dump, values...)
+			n++
+		}
+		require.NoError(t, rows.Err())
+		fmt.Fprintln(&dump, table, n)
+	}
+	return dump.String()
+}
@@ -0,0 +1,18 @@
+package opbeansdb
+
+import (
+	"embed"
+	"io/fs"
+)
+
+//go:embed sql
+var sqlFiles embed.FS
+
+// SQL holds the SQL scripts and migrations embedded from the sql directory.
+var SQL = func() fs.FS {
+	fsys, err := fs.Sub(sqlFiles, "sql")
+	if err != nil {
+		panic(err)
+	}
+	return fsys
+}()
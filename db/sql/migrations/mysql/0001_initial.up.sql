@@ -0,0 +1,50 @@
+CREATE TABLE product_types (
+	id int NOT NULL AUTO_INCREMENT,
+	name varchar(255) NOT NULL UNIQUE,
+	PRIMARY KEY (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+
+CREATE TABLE products (
+	id int NOT NULL AUTO_INCREMENT,
+	sku varchar(255) NOT NULL UNIQUE,
+	name varchar(255) NOT NULL,
+	description TEXT NOT NULL,
+	type_id int NOT NULL,
+	stock int NOT NULL,
+	cost int NOT NULL,
+	selling_price int NOT NULL,
+	PRIMARY KEY (id),
+	CONSTRAINT products_fk0 FOREIGN KEY (type_id) REFERENCES product_types(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+
+CREATE TABLE customers (
+	id int NOT NULL AUTO_INCREMENT,
+	full_name varchar(255) NOT NULL,
+	company_name varchar(255) NOT NULL,
+	email varchar(255) NOT NULL,
+	address varchar(255) NOT NULL,
+	postal_code varchar(255) NOT NULL,
+	city varchar(255) NOT NULL,
+	country varchar(255) NOT NULL,
+	PRIMARY KEY (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+
+CREATE TABLE orders (
+	id int NOT NULL AUTO_INCREMENT,
+	customer_id int NOT NULL,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY (id),
+	CONSTRAINT orders_fk0 FOREIGN KEY (customer_id) REFERENCES customers(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+
+CREATE TABLE order_lines (
+	order_id int NOT NULL,
+	product_id int NOT NULL,
+	amount int NOT NULL,
+	CONSTRAINT order_lines_fk0 FOREIGN KEY (order_id) REFERENCES orders(id),
+	CONSTRAINT order_lines_fk1 FOREIGN KEY (product_id) REFERENCES products(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS order_status_history;
+ALTER TABLE order_lines DROP COLUMN unit_cost, DROP COLUMN unit_price;
+ALTER TABLE orders DROP COLUMN status;
@@ -0,0 +1,20 @@
+ALTER TABLE orders ADD COLUMN status varchar(255) NOT NULL DEFAULT 'pending';
+
+
+-- Existing order lines are priced at the current product prices.
+ALTER TABLE order_lines ADD COLUMN unit_price int NOT NULL DEFAULT 0, ADD COLUMN unit_cost int NOT NULL DEFAULT 0;
+UPDATE order_lines JOIN products ON products.id = order_lines.product_id
+SET order_lines.unit_price = products.selling_price, order_lines.unit_cost = products.cost;
+ALTER TABLE order_lines ALTER COLUMN unit_price DROP DEFAULT, ALTER COLUMN unit_cost DROP DEFAULT;
+
+
+CREATE TABLE order_status_history (
+	id int NOT NULL AUTO_INCREMENT,
+	order_id int NOT NULL,
+	status varchar(255) NOT NULL,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY (id),
+	CONSTRAINT order_status_history_fk0 FOREIGN KEY (order_id) REFERENCES orders(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+INSERT INTO order_status_history (order_id, status, created_at)
+SELECT id, status, created_at FROM orders ORDER BY id;
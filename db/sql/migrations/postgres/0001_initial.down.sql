@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "order_lines" CASCADE;
+DROP TABLE IF EXISTS "orders" CASCADE;
+DROP TABLE IF EXISTS "customers" CASCADE;
+DROP TABLE IF EXISTS "products" CASCADE;
+DROP TABLE IF EXISTS "product_types" CASCADE;
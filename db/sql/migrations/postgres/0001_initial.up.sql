@@ -1,12 +1,3 @@
--- Drop everything
-DROP TABLE IF EXISTS "products" CASCADE;
-DROP TABLE IF EXISTS "product_types" CASCADE;
-DROP TABLE IF EXISTS "customers" CASCADE;
-DROP TABLE IF EXISTS "orders" CASCADE;
-DROP TABLE IF EXISTS "order_lines" CASCADE;
-
-
--- Create everything
 CREATE TABLE "products" (
 	"id" serial NOT NULL,
 	"sku" varchar NOT NULL UNIQUE,
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "order_status_history" CASCADE;
+ALTER TABLE "order_lines" DROP COLUMN IF EXISTS "unit_cost", DROP COLUMN IF EXISTS "unit_price";
+ALTER TABLE "orders" DROP COLUMN IF EXISTS "status";
@@ -0,0 +1,29 @@
+ALTER TABLE "orders" ADD COLUMN "status" varchar NOT NULL DEFAULT 'pending';
+
+
+-- Existing order lines are priced at the current product prices.
+ALTER TABLE "order_lines" ADD COLUMN "unit_price" int, ADD COLUMN "unit_cost" int;
+UPDATE "order_lines" SET "unit_price" = "products"."selling_price", "unit_cost" = "products"."cost"
+FROM "products" WHERE "products"."id" = "order_lines"."product_id";
+ALTER TABLE "order_lines" ALTER COLUMN "unit_price" SET NOT NULL, ALTER COLUMN "unit_cost" SET NOT NULL;
+
+
+CREATE TABLE "order_status_history" (
+	"id" serial NOT NULL,
+	"order_id" int NOT NULL,
+	"status" varchar NOT NULL,
+	"created_at" TIMESTAMP NOT NULL DEFAULT NOW(),
+	CONSTRAINT order_status_history_pk PRIMARY KEY ("id")
+) WITH (
+  OIDS=FALSE
+);
+ALTER TABLE "order_status_history" ADD CONSTRAINT "order_status_history_fk0" FOREIGN KEY ("order_id") REFERENCES "orders"("id");
+INSERT INTO "order_status_history" ("order_id", "status", "created_at")
+SELECT "id", "status", "created_at" FROM "orders" ORDER BY "id";
+
+
+-- Products and customers were loaded with explicit ids, so advance
+-- their sequences past them for rows created through the API.
+SELECT setval(pg_get_serial_sequence('product_types', 'id'), COALESCE(MAX("id"), 0) + 1, false) FROM "product_types";
+SELECT setval(pg_get_serial_sequence('products', 'id'), COALESCE(MAX("id"), 0) + 1, false) FROM "products";
+SELECT setval(pg_get_serial_sequence('customers', 'id'), COALESCE(MAX("id"), 0) + 1, false) FROM "customers";
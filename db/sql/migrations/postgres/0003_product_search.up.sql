@@ -0,0 +1,6 @@
+-- A generated tsvector column indexing product names and descriptions,
+-- recomputed by Postgres whenever a product is modified.
+ALTER TABLE "products" ADD COLUMN IF NOT EXISTS "search" tsvector GENERATED ALWAYS AS (
+  setweight(to_tsvector('english', "name"), 'A') || setweight(to_tsvector('english', "description"), 'B')
+) STORED;
+CREATE INDEX IF NOT EXISTS products_search_idx ON "products" USING GIN ("search");
@@ -1,12 +1,3 @@
--- Drop everything
-DROP TABLE IF EXISTS "products";
-DROP TABLE IF EXISTS "product_types";
-DROP TABLE IF EXISTS "customers";
-DROP TABLE IF EXISTS "orders";
-DROP TABLE IF EXISTS "order_lines";
-
-
--- Create everything
 CREATE TABLE "products" (
 	"id" serial NOT NULL,
 	"sku" varchar NOT NULL UNIQUE,
@@ -0,0 +1,37 @@
+DROP TABLE IF EXISTS "order_status_history";
+ALTER TABLE "order_lines" DROP COLUMN "unit_cost";
+ALTER TABLE "order_lines" DROP COLUMN "unit_price";
+ALTER TABLE "orders" DROP COLUMN "status";
+
+
+CREATE TABLE "customers_old" (
+	"id" serial NOT NULL,
+	"full_name" varchar NOT NULL,
+	"company_name" varchar NOT NULL,
+	"email" varchar NOT NULL,
+	"address" varchar NOT NULL,
+	"postal_code" varchar NOT NULL,
+	"city" varchar NOT NULL,
+	"country" varchar NOT NULL,
+	PRIMARY KEY ("id")
+);
+INSERT INTO "customers_old" SELECT "id", "full_name", "company_name", "email", "address", "postal_code", "city", "country" FROM "customers";
+DROP TABLE "customers";
+ALTER TABLE "customers_old" RENAME TO "customers";
+
+
+CREATE TABLE "products_old" (
+	"id" serial NOT NULL,
+	"sku" varchar NOT NULL UNIQUE,
+	"name" varchar NOT NULL,
+	"description" TEXT NOT NULL,
+	"type_id" int NOT NULL,
+	"stock" int NOT NULL,
+	"cost" int NOT NULL,
+	"selling_price" int NOT NULL,
+	PRIMARY KEY ("id"),
+	FOREIGN KEY ("type_id") REFERENCES product_types("id")
+);
+INSERT INTO "products_old" SELECT "id", "sku", "name", "description", "type_id", "stock", "cost", "selling_price" FROM "products";
+DROP TABLE "products";
+ALTER TABLE "products_old" RENAME TO "products";
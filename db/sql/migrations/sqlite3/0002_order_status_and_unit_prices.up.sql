@@ -0,0 +1,54 @@
+-- SQLite cannot change the type of a column, so products and customers
+-- are recreated with INTEGER PRIMARY KEY ids, which are assigned to
+-- new rows.
+CREATE TABLE "products_new" (
+	"id" INTEGER PRIMARY KEY AUTOINCREMENT,
+	"sku" varchar NOT NULL UNIQUE,
+	"name" varchar NOT NULL,
+	"description" TEXT NOT NULL,
+	"type_id" int NOT NULL,
+	"stock" int NOT NULL,
+	"cost" int NOT NULL,
+	"selling_price" int NOT NULL,
+	FOREIGN KEY ("type_id") REFERENCES product_types("id")
+);
+INSERT INTO "products_new" SELECT "id", "sku", "name", "description", "type_id", "stock", "cost", "selling_price" FROM "products";
+DROP TABLE "products";
+ALTER TABLE "products_new" RENAME TO "products";
+
+
+CREATE TABLE "customers_new" (
+	"id" INTEGER PRIMARY KEY AUTOINCREMENT,
+	"full_name" varchar NOT NULL,
+	"company_name" varchar NOT NULL,
+	"email" varchar NOT NULL,
+	"address" varchar NOT NULL,
+	"postal_code" varchar NOT NULL,
+	"city" varchar NOT NULL,
+	"country" varchar NOT NULL
+);
+INSERT INTO "customers_new" SELECT "id", "full_name", "company_name", "email", "address", "postal_code", "city", "country" FROM "customers";
+DROP TABLE "customers";
+ALTER TABLE "customers_new" RENAME TO "customers";
+
+
+ALTER TABLE "orders" ADD COLUMN "status" varchar NOT NULL DEFAULT 'pending';
+
+
+-- Existing order lines are priced at the current product prices.
+ALTER TABLE "order_lines" ADD COLUMN "unit_price" int NOT NULL DEFAULT 0;
+ALTER TABLE "order_lines" ADD COLUMN "unit_cost" int NOT NULL DEFAULT 0;
+UPDATE "order_lines" SET
+	"unit_price" = (SELECT "selling_price" FROM "products" WHERE "products"."id" = "order_lines"."product_id"),
+	"unit_cost" = (SELECT "cost" FROM "products" WHERE "products"."id" = "order_lines"."product_id");
+
+
+CREATE TABLE "order_status_history" (
+	"id" INTEGER PRIMARY KEY AUTOINCREMENT,
+	"order_id" int NOT NULL,
+	"status" varchar NOT NULL,
+	"created_at" TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	FOREIGN KEY ("order_id") REFERENCES orders("id")
+);
+INSERT INTO "order_status_history" ("order_id", "status", "created_at")
+SELECT "id", "status", "created_at" FROM "orders" ORDER BY "id";
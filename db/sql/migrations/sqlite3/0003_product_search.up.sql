@@ -0,0 +1,19 @@
+-- requires: SELECT sqlite_compileoption_used('ENABLE_FTS5')
+-- An FTS5 external-content table indexing product names and
+-- descriptions, maintained by triggers. FTS5 is only available
+-- when go-sqlite3 is built with the "sqlite_fts5" build tag.
+CREATE VIRTUAL TABLE IF NOT EXISTS products_fts USING fts5(
+  name, description, content='products', content_rowid='id'
+);
+CREATE TRIGGER IF NOT EXISTS products_fts_insert AFTER INSERT ON products BEGIN
+  INSERT INTO products_fts(rowid, name, description) VALUES (new.id, new.name, new.description);
+END;
+CREATE TRIGGER IF NOT EXISTS products_fts_delete AFTER DELETE ON products BEGIN
+  INSERT INTO products_fts(products_fts, rowid, name, description) VALUES ('delete', old.id, old.name, old.description);
+END;
+CREATE TRIGGER IF NOT EXISTS products_fts_update AFTER UPDATE ON products BEGIN
+  INSERT INTO products_fts(products_fts, rowid, name, description) VALUES ('delete', old.id, old.name, old.description);
+  INSERT INTO products_fts(rowid, name, description) VALUES (new.id, new.name, new.description);
+END;
+-- Index the existing products.
+INSERT INTO products_fts(products_fts) VALUES ('rebuild');
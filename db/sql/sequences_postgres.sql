@@ -0,0 +1,3 @@
+SELECT setval(pg_get_serial_sequence('product_types', 'id'), (SELECT MAX(id) FROM product_types));
+SELECT setval(pg_get_serial_sequence('products', 'id'), (SELECT MAX(id) FROM products));
+SELECT setval(pg_get_serial_sequence('customers', 'id'), (SELECT MAX(id) FROM customers));
@@ -1,208 +0,0 @@
-// Code generated by vfsgen; DO NOT EDIT.
-
-package opbeansdb
-
-import (
-	"bytes"
-	"compress/gzip"
-	"fmt"
-	"io"
-	"io/ioutil"
-	"net/http"
-	"os"
-	pathpkg "path"
-	"time"
-)
-
-// SQL statically implements the virtual filesystem provided to vfsgen.
-var SQL = func() http.FileSystem {
-	fs := vfsgen۰FS{
-		"/": &vfsgen۰DirInfo{
-			name:    "/",
-			modTime: time.Date(2018, 6, 26, 7, 6, 36, 879400687, time.UTC),
-		},
-		"/customers.sql": &vfsgen۰CompressedFileInfo{
-			name:             "customers.sql",
-			modTime:          time.Date(2018, 6, 26, 3, 14, 38, 223738517, time.UTC),
-			uncompressedSize: 216769,
-
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\xfd\x4b\x8f\xdc\xca\xb6\x27\x86\xcf\xff\x9f\x22\x66\xea\x06\x8e\x04\xbe\x1f\xf8\x4f\x58\x0f\xa9\xb4\xa5\x2a\xa9\x76\x55\x1d\xa9\xf7\x99\x34\x16\xc9\x48\x32\x2a\x83\x11\x54\x90\xcc\x14\x6b\x74\xda\xf6\xc0\x93\x86\xa7\x3e\x6d\xc0\xe8\x8b\x76\xe3\xe2\xf8\xe2\xda\x83\x6b\x37\x1a\x86\x7d\x3d\xd0\xd9\xf3\x7b\x3f\x42\x9f\x4f\x62\x70\x45\xf0\x51\x95\xb9\x3d\x4c\x7a\xb2\x1f\x2b\xf5\xc8\xa8\x88\x58\xb1\x1e\xbf\xf5\xfb\x31\xd1\x50\xd5\x12\x26\x5a\x49\xb2\xae\x69\x65\x45\x55\x43\xfe\x05\xcb\x7f\x47\x36\x1d\xe7\xff\x5a\x40\x45\x7f\x47\x32\x59\xd5\x20\x7a\xf3\x7f\xb4\x02\xc6\x7f\x47\x20\xcf\x15\x6d\x9a\xdf\x91\x5a\x36\x2d\xf0\x7f\x9d\xc9\x7c\xf8\xa5\xac\xed\x87\xdf\xd0\x89\x56\xf5\xff\x92\xec\x80\x77\xb4\x21\xff\xc2\xfe\x1d\x79\x75\x0e\x2a\x05\x05\xe4\x4e\x16\x54\x35\xaf\x7e\x47\x5e\xdd\x6f\xfb\x14\x86\xff\x48\x15\xda\xac\xa4\x7d\x2d\x05\x67\x82\xbe\xc9\xe9\x60\x8f\x6c\x72\xdf\xb5\x2d\x55\x2c\x2f\x28\xb9\x06\x81\x56\xdb\x71\xbd\xe1\xdf\x17\x6c\xcb\x54\x57\x92\xaf\xb4\x05\x31\x18\x7e\x12\xb9\x14\xb4\x61\xf0\xea\x5f\xfe\xff\xff\x7f\xa7\x5a\x9a\xf3\x3b\xf2\xea\x16\x3a\x0e\xe4\x3d\xe3\x7c\xf8\x1a\x97\xb4\x62\x3b\x8a\x5f\xb5\x2e\x19\xe7\x76\x92\x03\xe3\xfd\xf0\x67\xbf\xc9\xe4\x9b\x6e\x3b\x7c\x12\x04\xe4\x9a\x71\xce\x40\x90\x5b\x50\xdb\x3d\xf4\xcb\xa5\x5d\x75\x32\xef\x70\x8d\x25\x13\x27\x5d\x8e\xfb\x3b\xf2\xea\x4c\xe4\x8a\x02\xf9\x4a\x39\xc7\x7d\xfa\xb9\x63\x02\xbf\x0d\xec\x07\x93\x93\x08\x09\xf0\xa6\x90\xbb\xc1\x16\xc6\x16\x79\x0b\x4d\x4b\x6e\x39\x3c\xc1\x72\x11\xb7\x9d\x90\x1c\x7f\xdf\x6d\xc9\x38\xab\x6b\x26\x68\x73\xca\xb5\x78\xbf\x23\xaf\x3e\x48\x01\x6d\x09\x82\xbc\x07\xd1\x48\x3c\x26\x1f\xa1\x7d\x1a\xfe\xfd\x58\xa2\xc9\x4d\xf6\x8c\xd3\xfe\x4d\x26\x2b\xdc\x18\x72\x21\x55\x4a\x05\xb9\x50\xb2\x69\x98\x28\x96\x4b\x7a\x90\xa2\x68\x4a\x10\xdf\x19\xe8\x0f\x4e\xbe\x3f\xfe\x70\x6f\x5a\x5a\x97\x20\x18\x25\xe7\xb0\xa5\x0a\xbf\xd8\x9e\x0d\xf7\x64\xcf\x14\x9e\xbb\x26\x1d\x3e\xf0\x92\x42\xca\x82\xd3\x37\x99\xde\x97\xd8\x8d\xc8\x2d\x13\x94\x7c\x61\x74\x4f\xee\x5b\x45\x69\xbb\x5c\xdd\x39\x7b\x64\xb0\xca\xaa\x82\x61\xa7\x58\x55\xf5\xe4\xa1\x53\x42\x2f\xe9\x23\xf4\x3b\x2a\x71\xa3\x5a\x34\xfa\x49\x5d\xca\x0c\xde\x64\xb8\x7b\x16\x79\x4f\x37\x1b\xaa\x04\x08\x72\xb6\xa3\xa2\x7b\xe6\x1b\x6e\x80\xc3\x1e\x56\x3c\x7b\xa1\x3e\x68\x25\xa7\x54\x90\x8f\x94\x73\xbc\xdf\x1f\xe0\xbb\x80\x96\xe9\x73\xb8\xdd\x0e\xe6\x20\x29\xe5\xbe\x69\xbb\xcd\x66\x2f\xd5\xb6\x19\x8f\xa1\xef\xf9\xf6\xe0\x22\x8a\xb2\x2d\x65\xd7\x0c\x5b\xdd\x0f\x57\x2c\x7b\xb6\xcc\x5f\xff\x47\xa6\xd4\x5f\xfe\x4e\x10\x68\x4a\x72\x5f\x42\xf5\x97\x7f\xc3\xff\xf2\x77\x3d\x94\xe8\x5e\x7b\x75\x5a\x57\x18\xa1\xef\x28\x28\x07\x72\x01\xaa\xd5\xdb\x78\x57\xf6\xa2\xff\x8e\xde\x23\x43\x63\x98\x6c\x68\x35\xb9\x8f\x38\xb4\x6d\x72\xae\xe4\x5e\x30\x51\x1c\xb8\xf8\xcf\x5b\x28\x41\xe4\x8f\xb8\x93\x9f\xa0\x62\xe9\x69\x57\x14\xa3\x07\xe9\x33\x4a\x6e\x40\xb5\x4c\x50\x3c\x7a\xbf\xc8\x9c\xa2\xa7\x7f\xac\x8c\x35\x4a\x9a\xaa\x1c\xb6\xee\x0d\x74\x7a\xf7\x42\xc7\x22\x97\x6c\xc7\x1a\x26\x05\xb9\xa0\xc2\xfc\x34\xc6\x85\xfd\xbe\xaa\xc8\x5d\xb7\x07\xfd\xfe\xdd\x77\x39\x88\x53\xae\xcb\xb6\x86\x47\x86\x4a\x55\x50\x72\xc7\xb2\x12\x54\x6e\x5e\x64\xa6\xbf\x51\xa1\x8c\x35\x4e\xf6\x14\xda\x92\xaa\xe9\x60\xc6\x96\x3d\xf8\xc6\x3d\xa7\x3d\xf9\xd0\x89\x6c\x3c\xce\xe3\xd2\xee\xcb\x0e\x44\xc1\xe5\x4a\x0e\xd2\x1e\x62\x8d\xf7\x54\xa8\x9e\xbc\x87\x8a\xf1\x56\x7f\xbb\x1b\x4a\xcd\x9b\x5c\x96\xc6\x0c\x49\x5b\x52\x68\x39\x88\x96\x65\xe3\xf2\xc2\x28\x74\x49\xd4\x96\xe4\x82\xa9\x8c\x3f\x3b\x8c\xe7\x00\x69\x8e\x3f\x9d\x6b\x9a\x82\x90\xa7\xdd\x31\x07\xbd\x5a\xdb\x96\x74\x4f\xbe\x40\xf3\xad\xd3\x67\xf1\x92\xee\x6a\xc9\x04\xfa\xf1\x6a\xa7\xed\x69\x22\xca\xc6\xc4\x19\xa1\x4d\xde\x81\x52\x50\x74\x2d\xf9\xfa\x3c\xc8\xf8\xc0\x6a\xf6\x08\x35\xae\xe8\x6d\xd6\x41\x2e\xd5\x49\x57\x84\xa1\x46\x53\x0e\xe7\xe8\x9c\x2a\x85\xdf\xed\x96\x43\x95\xea\x7d\x82\x74\x30\x66\xc9\xb7\x6f\xe3\xde\x58\xa1\x13\x0e\x17\x31\x3b\xe2\x09\xcf\x81\x35\x65\xc7\xfa\xb5\xde\x65\xdb\xc3\xcd\x48\x87\x00\x57\xff\x98\xa7\xe8\x36\x57\xd0\xe7\x09\xab\xa0\xa0\x4d\x09\xd9\xf6\x4d\x87\x77\xcd\x75\x1c\xf2\x01\xb2\x6d\x23\x87\x10\xb0\x69\x9e\xaf\x86\xc3\x96\xb3\x1e\x7f\xff\xef\xb7\x0a\x98\xa0\x27\x5d\xcd\x10\x66\x7c\x1d\xe2\x53\x4a\xee\x5b\x10\x9c\xe2\x92\xce\x76\x60\x2e\xd1\xbe\xd1\x56\x9a\x74\xcd\xe4\xcb\x5d\x27\x20\xf7\xb2\x6b\x4b\xf2\x40\x95\x7a\xb1\x41\x5f\x61\x08\x9d\xd8\x3a\x9b\x13\xcc\x51\xed\x3b\xa9\x72\xfc\x11\x77\x69\xca\x69\xc5\xf4\xd3\xb4\x91\x2a\xdf\x24\xdf\x15\x85\xf1\xb0\x45\xbf\x11\xd5\x5e\xfd\xe5\x6f\xe1\x3b\x7c\x33\x41\x46\x7b\xda\x75\x0c\x51\xc5\x87\x2e\xef\xc9\x3b\x46\xb9\x76\xd9\x5f\xd9\x96\xe5\x3a\x4c\xda\xa0\xb1\x48\x4a\xfa\xbd\x13\x53\x3c\x6b\x93\x07\xe0\xac\xa9\x86\x4c\x63\xf4\x14\x53\x0a\x35\xf8\xea\x7a\x9d\x4c\xc3\x1e\xc2\x85\x3b\xc8\x4a\xca\xc9\x1d\x54\x4c\x69\x87\x76\x56\xb0\x1d\x7e\x21\xa5\xb4\xb1\x4c\xa0\xae\x87\x20\xd6\xac\xc7\x25\x37\x52\xb4\x20\x00\x13\xa7\xe5\x6a\xce\x5a\x26\xf0\x67\x72\xae\xe0\x89\xf1\x93\x2e\x66\x88\x14\xde\xe6\x7b\x50\x39\x39\xa7\x42\xd0\x16\x7f\xce\x9f\xb9\xc4\xad\xa1\xa9\xb6\xb1\x31\x22\x17\x18\x3f\x58\x81\x45\xde\xb1\x66\x7b\xb0\x92\x7b\xd8\x49\xa1\x40\xb4\x78\xf4\xde\x31\xc1\x41\xe4\x27\xcd\x6a\x31\x40\x00\xd5\x93\xaf\x25\x6b\xa9\xf6\x49\xc3\x85\x69\x5a\xa9\x70\x1f\x8a\xfd\xf0\xc1\x63\x52\xc8\x71\x67\x5c\x9f\x5c\x49\x99\x0f\x5f\x75\xca\x85\xc7\x05\x5d\x77\xfb\x75\x4e\x99\x33\x84\x03\x67\xa0\xa4\x20\x37\xbd\xa9\x3b\x3c\xd0\xed\x46\xc7\xe3\x50\x0d\xb6\x6d\xd2\xc8\xd7\x82\xb6\x6f\x04\x7d\xf3\x58\x0f\x76\x8f\xbc\xdd\x51\x55\xa8\x21\x78\x3f\xb8\x34\x77\xac\xd3\x8f\xcc\x3a\xf5\x86\x21\x10\x78\xcb\xd9\x13\xa4\xb4\x2d\xc9\x2d\x0c\x79\x9f\xc9\x6c\xbf\x4a\x95\xd7\x34\xd7\x49\x1c\xad\xc7\x8f\x78\x52\xc8\x9d\x89\x07\x7c\xe2\xb7\x25\xb9\x54\x6c\xf7\xcc\x3b\x7f\x94\x95\x54\x52\xa7\x28\xf5\x69\x63\x51\x67\x88\x03\xae\x14\x2d\xa4\xea\xc9\x8d\x54\x54\xe0\xf7\xf8\xc2\x74\x96\x5e\x54\x68\xaa\x12\x37\xb0\xde\x64\x42\xa7\x7e\x77\xfd\xf1\xba\xc9\x3b\x28\x15\x3c\xe2\xde\xa8\x13\xaf\xc2\xc3\x27\xa6\x2d\xa5\xe8\xc9\x0d\x98\x0d\xb9\xdf\x32\x61\x5e\x98\x6a\xb0\x89\x24\x65\x05\xa6\x41\x7c\x8a\xa7\x03\x72\x49\xd3\x66\x72\x01\xae\xeb\x58\x31\x3e\x34\xec\xd1\xfc\x21\x35\xb0\xd3\xae\x65\xf1\xfa\xdf\xb0\x76\xf0\xd0\x78\x99\xaf\x20\x6d\x3b\x9d\xa0\xed\x2b\x63\x97\x49\xc3\xa1\x29\x73\xd9\xbe\x91\x0a\x6f\x85\x17\x04\x21\x79\x2f\x37\x9b\xe1\xc5\x79\x11\x72\x5e\x4b\x51\x54\x54\xac\xe3\x09\x86\x20\xe0\x9d\xa2\x39\x39\x13\xf9\x74\x65\x3e\x80\xc9\x4e\x37\x60\xac\xf5\xf1\x84\xdc\x76\xad\x98\xdc\x00\xe7\xc3\x31\x3d\x4c\x0e\xce\xda\x14\x1a\x30\x85\x8b\x27\xd8\x96\x43\x80\x74\xd2\xe5\x85\x7a\x35\xb4\x5d\x54\x58\x1f\xf6\xac\xc2\x6f\xff\xa8\x2b\xac\xdf\x9e\x95\x50\x7c\xdb\xf5\x7c\xf2\x0e\x98\xc2\xc8\xe1\x48\x45\xe8\x9e\xe5\x8c\xdc\xd1\x5c\x76\x26\x31\xbf\x91\x4a\x66\x99\x3c\xe9\xc2\x22\xf4\x06\x59\x2b\x15\xb9\xa4\xba\xd2\xfb\x00\x85\xac\xb5\x9b\xdb\xe5\x14\x84\x4a\xf2\x4a\x3e\x8d\x27\xd0\x71\xbc\xd8\x26\x0f\x72\x2f\xe8\x91\x9d\xfa\x65\xcd\xec\xd4\x89\xe7\x1a\xc9\x0d\x15\xb9\xd4\xc1\xe5\x05\x15\x2d\x33\x45\x05\xa8\xb4\xbd\x49\x76\x4c\x80\x54\x53\x44\x6a\xfb\x81\xe7\x61\xf0\x43\x15\xed\x8f\xb9\xbf\x5f\xff\x3d\x53\xbb\x61\x0d\xb8\xf9\xd7\xac\x1d\xc2\xbb\xd3\x3e\x4f\xee\x10\x38\x5c\x80\xe2\xb2\x19\xb3\x1b\xbc\x13\x7b\xc6\x59\x8b\x65\xa9\xec\x51\x9b\xdb\x64\x5f\xca\x37\xe6\x6d\x75\x5d\x3f\x26\x1f\x19\xe7\x39\xa5\xea\x68\xe9\xf5\x9f\xfe\xdd\x3f\xff\xf1\x9f\xff\xeb\x7f\xfe\x6f\xff\xe9\x1f\xff\xe9\xff\xfe\xa7\xff\xfd\x9f\xfe\x0f\x1d\x80\x67\x34\x97\xa7\x5e\xa1\x8d\x7f\xb3\x6a\x4b\x20\xef\x14\x88\x2d\x67\x62\x0c\xc4\x9f\xf0\x5e\x55\x1b\x63\xee\x92\x3d\x53\x34\x1f\x37\xd0\xb1\x02\x97\x7c\xa5\x3c\x3b\x56\x5d\xf8\x94\xe9\x0a\xe6\xdb\x6f\x1d\xb4\x52\x31\xe0\xe4\xaa\x63\x82\x9e\x76\x6d\x0e\x3a\xe9\x8e\x35\x94\x9c\x2b\x29\xb7\x78\x90\xde\x42\x2a\xd1\x47\xf2\x14\x6d\xbb\xa4\x69\xa1\xa5\x63\xda\x17\x91\x1b\xda\xb4\x70\x98\x93\xdf\xc8\xa6\xa4\xa2\xd9\xca\x1e\xcd\x77\x5d\x73\xe2\x58\xc9\x75\xf1\x8e\x2b\xd6\x90\x73\x50\x82\xe2\x6a\x6e\x28\x7d\xc4\x08\x23\x4b\xd1\xb6\x4f\x38\xb4\xac\xa2\x93\xa7\x8f\x6d\x72\xc9\xc8\xb5\x54\xb4\x95\x2f\x1f\xb0\xf7\x00\x75\x03\xba\xab\xf1\xb6\x69\x4f\x7e\xf6\x86\x28\xe3\x3e\x93\x6d\x4b\x2e\xa4\xc4\x78\xe1\x56\xe6\x19\xe0\x1d\x6a\x32\x29\xb7\xdf\x93\x4c\x2a\x41\x39\x7f\x43\x75\x07\xc9\x89\xc8\x7d\x2d\x4b\x71\x90\x63\xdc\xc8\xad\xae\x90\x7f\x62\x05\x3d\x71\xa5\xd8\x1d\x22\x8c\x0f\x54\xd1\xaa\x27\x9f\x58\x56\x4a\xdd\x68\x1a\xd3\xa5\x47\xa1\x6d\x7d\x32\x04\x21\x42\xb2\x66\x5c\x8d\x17\x78\xe6\x06\x1d\x84\xe5\x37\x50\xe8\xbd\x5a\xa9\xe2\xef\x06\x58\xa3\x16\x59\x4f\xce\x55\x0f\xfa\xcb\x7d\xee\xa5\x2e\x02\x89\x14\x6d\x4f\x49\x2b\x6b\xce\x9a\xd6\x3c\xc1\xa1\xed\x79\xe4\x42\x56\x20\xb2\x92\x92\x3b\x09\xf9\x72\x49\x97\x9d\x28\xb4\xff\x5c\x6b\x4d\xd8\xc6\xa0\xac\x2d\xc9\x7b\xb9\x37\x61\xe0\x19\x33\x21\xd3\xb6\x44\x9b\x6d\x25\x19\xa8\x42\x66\x92\x73\x9a\xb5\x6c\x47\x17\x19\xe1\x05\xa8\x1a\xab\xdf\x07\x8b\x3b\xdb\xc8\x02\x72\xd9\x90\x1c\xc8\x4f\xa2\x80\x27\xca\x14\xac\x93\xbd\xbb\xd8\xb9\x50\x6d\xd9\xa9\xb9\xa5\xf6\x8e\xb3\x7a\x0c\x32\x00\xfb\x69\xb6\x9d\xc8\x9a\xaa\x45\x89\xe8\x16\x0a\xda\x1e\x7b\x89\xbf\xd0\x96\x77\x05\xac\xe4\xf6\x62\x0c\x91\xaa\xaa\x27\x97\x0c\x74\x99\x98\x15\xac\xed\x52\xf4\xc3\x6d\xce\xe0\xc9\x76\x92\x8d\xa2\xf4\xcd\x46\xe9\x95\x9c\x4b\x21\xa8\x3a\x56\xb8\xbb\xea\x40\x3c\x32\x48\x41\xae\x12\x31\x79\x58\x89\xe0\xc3\x8b\x48\xde\x29\x4a\x2b\x18\x33\xab\x4a\xef\x4d\xb1\xd1\x56\xdb\x4d\x9a\x4c\xb1\x74\x7a\x6e\x6d\x72\x25\x79\x4e\x05\xb9\xa6\xb0\x39\x4c\x79\xcf\xa5\x92\x5b\xb9\x5a\x1e\xef\x8d\x61\x04\x13\xe4\x42\xea\x58\xe0\x5c\xb1\xa2\x6c\x73\x89\x81\x4f\x95\x49\x4e\x6d\x2f\xd9\x48\x95\xce\x8f\x93\xe5\x93\xfb\x56\x0e\x81\xed\xe0\xe6\xd5\xb1\xb3\xf7\xb5\x6f\x9e\xb6\x4c\xbf\x0d\xa7\xae\x1b\x79\x0e\x9e\xf8\xb6\x24\xb7\x63\x49\xff\x86\x52\x9d\xc9\xab\x7a\x30\xd9\x7e\xd2\x43\x29\xe5\x9b\x4c\x9a\x62\x8b\x63\x91\xfb\x4e\xe8\x14\xe4\x48\x62\xd5\x98\x0d\xc7\xc2\xf2\x47\xa9\x4e\x1b\x11\x79\x43\x08\x81\x09\x15\xb9\x65\x54\xe9\xab\xf1\x73\x07\xe3\x92\xd0\x66\x07\x49\x59\x4f\x5e\xc1\x8e\x63\x72\xce\x3b\x4a\xce\x19\xe7\xb8\x41\x47\x32\xab\x77\xdd\x23\x93\x5b\x58\xa5\xe2\xe2\x69\x60\x44\x29\x44\x4f\x6e\x98\x01\x79\xcc\x0d\xb2\xc7\x6a\xb0\xd9\x61\x92\x4d\x95\x7d\xc7\xb1\xad\x90\xdc\x40\x56\x97\x98\x23\x1f\x89\xf5\x40\x41\xad\xe3\x90\x75\xae\xd3\x10\x51\x5c\xca\xae\xe0\x30\x04\x7b\xcc\x74\x2c\x0c\x2e\x62\x78\x71\x87\xff\xcd\x53\xfc\xc4\x8e\x9e\x07\xe6\x51\x48\x7e\x92\x7b\x38\xf4\x11\xef\x3b\x30\xd8\xa2\xd3\xfb\x3d\x5d\xae\x00\xb1\x9d\x42\xbd\xe9\xe1\xdd\x0c\x91\x9e\x1d\x27\xb4\x9e\x61\x38\x5e\x10\x06\xe4\x56\xaa\x16\x0a\x7a\x50\x7e\xfd\x24\x77\xb2\x60\x8a\xf6\x74\x27\xd7\x79\x93\xbc\x50\x03\x57\x76\x94\xbc\xa3\xaa\xe8\x4c\x5e\x78\x09\xe9\x1f\x30\x76\xdd\x18\xa3\x0d\x49\x4e\x39\xcb\x98\xec\x26\x9f\xe7\xfd\x56\x04\x0b\xa2\x00\x05\xad\xe9\x59\x9f\x3e\x6e\xf0\x22\xed\xb4\x41\x90\xcf\x7b\x6a\x7a\x0f\x58\x26\x4f\x39\xa4\xc3\xff\xa5\x72\xb0\xdb\xe9\xb2\x4d\xe6\x90\x4f\x52\xb5\xe5\x9e\x36\x2d\x55\xe2\x00\xf3\x70\x0e\xf2\x71\x9d\x36\x99\x17\xeb\x8b\xdc\x93\xcf\xaa\x65\x4f\x8b\xfb\x93\x52\x7d\xb5\x2b\x39\x7c\x60\x67\x49\x05\x53\xc7\x3c\x08\x87\xb7\x48\xf5\x87\xb7\xe7\x8e\xa5\x94\xa9\x1f\xff\x41\xae\xb3\x3b\xfe\x10\x39\x3c\x30\x01\x04\x33\xa6\x57\xba\x81\xa9\xeb\x61\x6d\x33\x98\xec\x3c\xe9\xc4\x58\x35\x72\xc9\x27\xba\x83\x1c\x0e\x33\x8b\x5f\xff\xdd\x9e\x09\x96\x51\xf2\x15\x54\xb6\x65\x74\x9d\xa7\xd5\xb7\xc7\xdd\x99\x5a\x32\xe3\xed\xa9\xb0\x17\x63\xd3\x84\xcb\x6c\x3c\x65\x96\x67\x91\x8f\x4a\xd6\x1b\xf2\xa0\x80\x3d\xf3\x06\xf7\x20\xc8\x4f\x0d\xcb\x75\xc1\x7f\xa5\xd4\xc2\xc7\x3e\x46\xc5\x78\x4f\xde\x71\xa9\xe8\xd8\xb8\x63\xc2\x14\x1b\xe8\x06\xcd\xf6\x66\xf1\xb6\xc6\x31\xf9\xc2\x72\x29\x8e\xc5\xa9\xef\x40\x41\xc7\x29\x86\x14\x37\x2c\x53\xa7\x7f\x83\x7c\x57\xef\x10\xe3\xbd\x20\xef\x25\xaf\xf4\xa2\x7e\x91\x55\x8a\xc9\x6b\x55\xa2\xcd\x2e\x92\xc1\xbf\x19\x18\x40\xe4\x0c\x01\x5d\x57\x94\xad\x7e\x56\x9f\x77\x9b\xbf\x30\x21\xfa\xb6\x59\x0d\x08\xe0\x0f\xa1\xc2\x39\x15\x8f\x50\x31\x41\xee\xc6\x67\xf5\x53\x0b\x05\x7e\xfb\x54\xe1\x7b\x5a\x26\xa9\x82\x1d\x6d\x58\xbb\x88\x54\x6d\x2f\x0a\x89\x4e\x88\x5f\x7a\xee\x5b\xe0\xc0\x61\xc5\x2e\x9a\xef\x63\x1d\x56\xc0\xf0\x1a\x09\x10\xb9\x6e\x3e\xbf\xcd\x0b\x9a\xf1\x0e\x5d\x37\x6c\xc6\x4f\x6c\x36\xf8\x3b\x25\x2b\x9a\xb3\x29\xf9\xf3\xdd\x19\xdf\x75\x18\xde\x3d\xb0\xc7\x2e\x83\x95\x9a\xd1\x3e\xc2\x1d\xaa\x9e\x7c\x34\x35\xd4\xb9\x34\x09\x5b\x26\x0a\xfb\x31\xa9\x3b\x45\x77\x92\x77\x15\x9d\xd7\x63\xb9\x1e\x79\xdf\x7d\xe7\xb4\x3f\x02\xa8\xbc\x05\x5e\x99\xfc\xfc\xa2\x4b\x4f\xbb\x57\x23\x96\x52\xf5\x82\x9c\x83\xd8\x8e\xa0\x64\xed\xca\xb7\xe9\x60\xb2\xb7\x49\xdd\x74\x63\x99\x28\xf0\x5d\x93\x9b\xbf\xa8\xdf\xdd\x2a\x99\x95\x42\xca\xad\xac\x9b\x2d\xf4\x2b\x25\xe8\xbe\x86\x3e\x88\xbc\x27\x77\x32\x57\xac\x30\x60\xae\x39\x55\x52\xa3\xd9\xe6\x49\xf1\x26\x93\x7a\x51\xb1\x4d\xbe\x42\x97\xd2\x06\x9e\x21\xef\xe2\xd0\x0b\x6d\x72\xf1\xf6\xf2\xed\xbf\xd2\xee\x9d\x89\xf6\xf5\x25\x15\x0c\x7f\x50\x77\xb4\x13\xec\xb4\x60\x35\x3f\x9e\x73\xdb\x1b\xda\xeb\x7a\xca\x25\xdd\xd5\x1d\x6f\xf0\x54\x55\xd5\x60\xb5\xab\x44\xd0\x7d\x2f\xd5\x76\x86\x17\x5a\x56\x14\x46\xe4\x02\x14\xa2\xbf\x8e\x1c\xc4\x6b\xf8\xf1\xb7\xf8\x94\xf3\xf4\xd4\xbd\x8d\xc0\x32\x07\xb1\x27\x57\x5d\xcb\xa8\x52\x23\x1e\xd4\x00\x95\xb7\xc5\x68\xb6\x45\xb2\x81\x8c\xa6\x52\x6e\xa7\xb7\x2b\x5a\xf4\x6e\xa6\x10\x23\xb6\xc3\x57\xa6\x2f\x49\x15\xdb\x36\x29\xd5\xd1\xc8\x25\x15\xd5\xe0\x34\x4f\xb9\xbc\x21\xc0\x78\xab\x58\x36\xc7\x4b\x37\x63\xc6\x41\x75\xbc\x24\x93\x3d\xdb\x32\xed\x07\x4d\xd8\x64\x7b\x0e\x39\xa7\x8c\x6f\xba\xa6\x21\x67\xdc\x3c\x10\x33\xce\x15\xc4\x5f\xff\xf8\xa7\x95\x5e\xaf\xc0\x59\x3c\xc8\x0f\x52\x99\x28\xe3\xb6\x94\xad\xdc\x50\x8a\xe5\xc8\xaa\x45\xbb\x5d\x27\x9b\x7c\x0a\xd1\xa3\xd0\xb5\x63\x72\x0d\x5b\xba\x97\x32\x3f\x72\x0a\x3f\x4a\xc9\xc9\x03\x15\x85\x86\x5a\xaf\xf2\x86\x05\x2e\x06\x08\xaa\x60\x82\x2d\x62\xdc\x0f\x60\xaa\xe8\x3b\xbd\x67\xdf\x92\x94\x3d\x3d\xca\x4e\x09\xe0\x73\x4a\x65\x39\xe4\x12\x4a\x4e\x0f\xa3\xf6\x33\x91\x1b\x50\xe8\x0d\xe4\x50\x40\x93\xc1\x49\x71\xa1\xc1\x10\x72\x3c\x94\xb2\x82\x86\x7c\xa5\x29\xbe\xc5\x0f\x20\xa4\xcc\x75\x61\xa8\xdd\xd3\x34\xb5\x55\xb2\x67\x4d\x36\xfa\xfb\x38\x0c\x63\x72\x5f\x52\xc5\x72\x38\x52\x9c\xb8\x97\xdd\x86\xff\xf8\xfb\x57\x1a\x68\x42\xb3\xd3\x1e\x42\x5f\x37\xe6\x87\x04\xe2\x4e\xa6\x6c\x1c\x43\x79\x80\x62\x44\x53\x3c\x2a\x63\xb7\x9b\xa4\xe8\x40\xe5\x0c\xc4\x62\x54\xc8\x21\x17\x1c\x14\x1d\xce\xd8\x61\x72\xf2\x05\xf6\x5b\xd8\xf5\x0d\xfe\xd2\x73\xca\x41\x75\x27\x8d\xe3\x03\x9c\xdd\xe8\x38\xa3\xe4\xbc\x53\x5b\x93\x1c\x8d\x3d\xa9\xc7\x74\xb0\xd9\x6d\xb2\x9b\xee\xd6\xe0\x2f\xe4\xbe\x82\x63\x61\xd3\x0d\xb4\xd0\x77\x15\xfe\x63\xc5\xdc\x24\x08\xe7\x26\xf6\x7b\x96\xe9\x80\xe3\x0a\xd2\xa6\x96\xf8\x75\xb3\x72\x30\xda\x5d\x22\xd2\x29\x1e\xb4\xc8\x75\x27\x72\xaa\xc8\x85\xec\xd4\xb3\x45\x7d\x16\xac\x6d\xca\xb5\x5a\x6d\x41\x34\x82\x79\xa0\x32\x53\x0c\x78\xfa\x54\x97\xeb\x47\x6b\xaf\x87\x18\xec\x5d\x02\x15\x3c\x49\x61\x66\xef\x1c\x74\x7f\x08\xe6\x3b\x58\xd1\x10\xbe\x33\x99\x95\x3f\xfe\x41\xb1\x95\x2e\x15\xe2\x2d\x77\x74\x70\xec\x67\x4a\x48\x8e\xbe\xfc\x13\x97\x9d\x28\xf0\xeb\x53\x40\xab\xbd\x4f\xba\x4c\x3e\x4d\x41\xae\xe5\x90\x07\x29\xfa\x43\xc0\x75\x57\x80\x82\x0a\x8c\xb3\x79\x3a\x75\x68\x11\x5a\x8b\x5d\xba\x92\x95\x0e\x2c\xbe\x48\x39\x1c\x29\xdc\xa5\x62\x30\xda\xdf\x93\x8a\xb1\x36\xa5\x83\x83\x28\xe4\xce\x00\xe2\x42\xdb\x9d\x4b\xe8\x07\x63\x92\xb2\x52\xfa\x32\xde\x48\x9e\xcb\xdd\x69\xd7\x65\xeb\x0c\x08\x7d\xd7\x12\x77\x75\x35\x56\x9b\xd3\x11\x77\x65\xf7\x49\xb3\xed\x95\xcc\xe6\x88\xc9\xb7\xc9\x1d\x1b\x02\xa3\x43\x97\xfe\x05\x9b\x8a\x4d\x2e\x55\xfd\x6a\xea\x0e\x9c\x6d\x14\xcb\x4e\xbb\xbe\xa9\xdf\xf1\xbe\xd3\x7e\xd9\xc0\x64\xb5\x5f\x53\x65\x27\x5a\xfb\x29\x91\xbb\xf2\x8d\xd0\x26\x8b\x7c\x82\x8a\x6e\xa1\x90\xe2\xf0\x62\xdd\x4b\x51\x6c\x41\x91\xf3\xd5\x82\x8a\xd0\x5d\xb4\xdb\x3a\xce\xf5\xe9\x1b\xf2\xe2\x16\x30\xde\x2b\x36\x68\x75\xac\x64\xc7\x2a\x3a\x81\x7f\x23\x8f\x5c\x77\xdb\x2d\x5d\xbe\xbe\x6e\x18\x3b\x3a\x2d\x21\x2e\x46\x16\xec\xfb\x6b\x2a\x5e\xdf\x2a\xb9\xa3\x42\xff\x9a\x77\x0a\xc4\x69\x3d\x47\xe8\x8d\xb3\x00\x7b\x72\x93\x65\xf2\xc5\x60\x03\x54\x83\xcd\xb1\x13\x9a\x49\x21\x2b\xec\xd4\x8f\x9d\xb7\xd0\x1f\x6e\x99\xe8\x1b\x96\xd3\x23\xf8\x03\x29\xda\xd7\x1f\x24\xd7\xb5\x5b\x10\x90\x9f\x76\xe3\x7c\x9d\x35\x08\xd6\x90\x2b\xc6\x53\xaa\x4f\xd6\x4f\x4f\x0c\xbd\x7c\x5e\x68\x9b\xe3\x24\x95\x94\x62\xa3\x3a\xd6\xce\x7d\xec\x6b\x39\x2c\x75\x31\xa7\xe6\xc7\x6e\x18\x98\xbd\xb3\x5f\x69\x28\xc2\x96\xaa\x6f\xdd\x5a\xbb\x16\x4c\xd5\xc2\xf9\x0d\xfb\xb8\x67\xe6\x0d\xa3\xfa\x0d\x73\xdc\xa4\x91\x65\x37\xc1\x0f\x02\xff\x59\x0b\xf8\xa0\xba\x76\x29\x45\xb1\x01\x51\x94\x6b\xc1\x01\xc3\x70\x7e\xc6\xae\x40\x29\x33\x36\x70\x09\xe9\x98\x9e\xd0\x42\x9b\x1d\x2f\xe9\x24\x82\x86\xdf\xa4\xb8\x43\x71\x3c\x44\xbc\xb2\xde\x1e\x60\x94\xae\x40\xb1\x14\x78\x8e\x07\xf1\xf4\xd5\xa7\x30\x42\x88\xfd\x90\xbd\x9b\x07\x0d\x5f\xda\xb3\xd4\xf4\x4c\xdb\xbd\xb1\x3a\x7e\xd2\x94\xb2\x7e\x5d\xab\xb1\x13\xec\x92\x3b\x96\x17\x74\xaf\x47\x5d\x9f\xef\xd5\x35\x1b\x8e\x2f\xe4\x52\x91\x2b\x2a\xa8\x02\x4e\xee\x41\xe0\x69\xf8\xf1\xf7\x78\x1c\xce\x54\x41\x45\x7b\xea\x1d\x8c\x11\x5d\x01\x02\xf4\x6a\x4d\xc9\x4f\xee\x1b\x9a\x8f\x99\x55\x8e\x2b\x6e\x9c\x20\xc9\x64\x95\x41\xd3\x8e\x6f\x41\x64\x87\xb6\x37\x5d\xbe\x05\xe8\x31\x70\x5c\xeb\xb5\x63\xe9\xd2\x40\x27\x72\xd3\x43\xb9\x95\xaa\xed\x0a\x38\xe9\x76\x46\x16\xfe\xf0\x1b\x20\x1f\x05\x2b\x4a\xfc\xde\x0f\xb2\x9e\x16\xc7\xb7\x68\x76\xc2\x64\xdf\x3c\x2e\x1a\xaa\xf7\x35\x1d\xbe\xe1\x12\xa0\x80\xab\x0a\xdc\x00\x5f\x3a\xc6\x77\x30\xe6\xe3\x6b\x2c\xcb\x1e\xfb\x91\x82\x7c\x85\x42\x8c\x98\x1f\xc4\xb2\xe3\x1e\x34\x7b\x34\x3b\x51\xd2\x89\x94\xcb\x62\x84\xca\x44\xe4\x9a\x89\x3c\xa5\xaa\x28\x0f\xea\xda\x1f\x59\x4d\xc7\x7a\xc7\xe9\xcb\x8a\x11\xb6\x54\x94\xa0\x4d\x4b\x6e\x27\xb8\xd6\x2f\x23\x7a\x93\xd6\x68\x73\xe2\x04\x14\x7b\x92\x02\xc6\xec\xd9\x75\x7d\xf2\x91\xe6\x4f\xec\x10\x81\xf6\xcb\xaf\xff\x9d\x28\x8a\x3d\x88\xe2\xf5\xaf\x7f\x8f\x77\x14\x7b\x97\xa7\xc7\x61\x44\x63\x6f\x05\xc8\xbd\xe8\x4d\x50\xfc\x85\xed\xd0\x47\x54\x0d\x9a\x1c\x48\x2a\xa8\xbf\x75\x74\x7e\xb4\xbd\xd0\x21\xf7\x9d\xc2\x9a\xdb\x41\x6f\xe5\xd7\x3f\x8b\x82\x3c\xfc\xf8\xf7\x9d\xfe\xb3\x68\x2b\xa0\x3a\xe9\x9a\xb0\xd2\xc1\x2a\xd9\x96\xfd\x5c\xd8\xbe\x06\x5d\xaa\x6f\xb1\xae\xed\xa4\x89\x00\x25\xf3\x37\x4a\x17\xb6\x43\xdf\x26\x37\xd0\x63\x59\xea\xd9\xf4\xb5\xe7\x58\xbe\xce\x64\x36\x1b\xe0\xe8\x2e\x7e\x2f\x58\x4b\x73\x72\xdf\x42\x7b\xda\xfc\x39\xd2\x60\x0c\x85\x0b\x9b\xb2\x99\xb7\x4c\xb4\x4c\xc7\x22\x83\xc9\xc9\x92\x66\x1e\x53\x8c\xe3\x30\x72\xc8\x39\xb2\xbd\x18\x28\xd0\xc1\xd0\xfc\x03\xa8\x6f\xbf\xfe\x5d\xd5\xff\xe5\xdf\x98\x24\x94\x36\x2d\x13\x0c\x74\x3b\x90\xb5\x52\xf5\x27\x5d\x65\x80\x9c\x19\x9c\xf7\xcb\x81\xec\xa5\x6f\x4c\xcd\x40\xb6\x93\xbf\xc4\x18\xdb\xd6\x34\xc5\x78\xa4\x95\x79\xdf\x62\x8e\xca\x41\xc7\x39\xa7\x0f\x26\x23\x44\x6b\x80\x82\x92\xdc\xc9\xe1\xdd\x9d\xeb\xa6\xa9\x19\xc1\x68\x94\xfe\xc0\xa1\x09\xa4\xb2\x6b\xe7\x3e\x52\x14\x91\x0b\xc9\xbb\x2a\xed\x9a\xa3\x48\xfe\x2f\x6c\x78\xa6\x4d\x96\x7a\x0d\xf2\xb4\x47\x33\x9a\xde\xeb\x65\x31\x64\x9a\x4e\xc8\x4d\x24\xb9\x49\x6a\xc5\x44\xbb\x51\x8c\x8a\x9c\x4f\xec\x30\x9e\xe7\x07\x0e\x39\xe3\x85\xac\x8e\xcc\x9f\x2a\xc8\x56\x0a\xba\xa2\x18\x47\xac\x79\x4b\x15\xb9\x66\x6d\x6b\xf0\x84\xdd\xd3\x53\x53\x82\xe6\x84\xd9\x73\xb4\x3b\x45\xd2\xcb\xae\xed\xde\x68\x30\xa8\x6f\x45\xb1\x8b\xb8\xa1\xe1\xad\x3e\x9c\x0f\x56\xa0\x91\x53\x17\x4f\x34\x2b\xc9\x1d\xad\xbb\x94\xb3\xec\xa4\x1c\x1c\x96\x61\x58\xfa\x8d\x79\x92\xda\xcc\x93\x38\x65\x92\x95\x4a\x0a\x49\x45\xc1\xc4\x3c\x2f\x1c\xba\x61\x44\x7e\xe2\x54\xd0\x23\x2e\xe5\x96\xe6\x54\x09\xca\xd4\x5a\xbd\xda\xd8\x9e\xa7\xa0\xa7\x01\xd5\x4b\xba\x4b\x3b\xbc\x33\x0a\x07\x54\x1d\x96\x54\xd0\x94\x90\xf2\x45\xbb\xd6\x23\x9f\x28\x6f\x8e\x55\x11\x3e\xd1\xee\x5b\xf7\xe3\xcf\xeb\x05\xc5\xf1\x62\x8c\xe4\x2b\xf0\xb1\x20\x30\x12\x2f\xf1\xbd\xb6\x39\x8f\xcf\x43\x2b\x27\xf4\xc9\x7b\x56\x94\x93\xd7\x38\xd6\x58\x97\xa2\xf8\xd6\xad\x84\xc9\x8b\x5d\xdd\x78\x1c\xfe\xb2\x0f\xd2\xcc\x93\x2c\x1d\x3e\x3c\x0e\x56\x67\x9b\x40\x96\x75\x2f\x88\x53\x2c\xcf\x0f\x0d\x0b\xd5\xe1\x45\x3b\x87\xfe\xbf\xfc\xe7\x3f\xff\xe5\xdf\xe0\xba\x7e\xa1\x15\x3d\x69\xbf\x36\xf6\x26\x98\xff\x19\xe7\x66\x3e\x13\x2a\x56\xa5\xba\xad\x09\x83\xd1\xe1\x09\x4d\x61\xf2\x84\x51\x6c\xdb\xe4\xbe\xa4\x9c\xd3\xfe\xf0\x08\xfe\xcc\x44\x61\xe6\xa2\x4e\xbf\x4b\xfe\x94\x80\x4e\xa8\xbc\x2f\x52\x0a\x8d\x99\x6a\x11\x91\xe7\x54\x13\x37\x96\xac\xde\x94\x9a\x2a\x25\xb6\x62\x72\x26\x04\xdb\x51\xd5\x80\x3a\x44\xad\x5c\x00\x37\x90\x81\x95\xfa\x12\x71\x30\x56\x1b\xe7\x09\x93\x73\x2e\x8b\x99\x53\x4a\xe9\x31\x13\x47\x24\x08\x09\x43\xea\xa8\x19\x77\x78\x56\x51\xc5\x32\x10\x87\x7d\xda\x9f\x14\x7e\x00\xeb\x84\x1b\x71\x38\x76\x9e\x17\x08\x7f\x8c\x36\x1e\x01\x0f\x1b\x35\x18\x7f\x47\x26\xcc\x61\xa6\x5a\x10\xda\xb1\x47\xee\xbb\xaa\x62\xed\xe1\xe4\x0c\x5d\x6d\xc4\x33\x8e\x26\x02\xa2\x67\xc3\xf9\x0f\x74\x3b\xc2\x90\xcb\x69\x34\xdf\xa9\xe7\x73\x68\xda\x7d\xae\x17\x92\x73\xd0\x45\xc6\x63\xf0\x87\xba\x7b\x3d\xfc\x83\x5c\xb0\x76\xcd\x71\xa7\x38\x9e\x38\xdb\xe6\x3a\xf1\x15\x2b\x60\xc4\x4f\x3d\x9a\x42\xf1\xb7\xe7\x2c\x74\x91\x47\x3e\x0c\xd1\xd7\x8c\xf4\x77\x5c\x9b\xc4\xb6\x8e\xc3\x06\x77\x92\x1a\x64\xc1\xfd\x9e\xe6\xa7\xf5\x83\xb6\x65\x4d\x7b\x77\xcf\xaa\x4a\x6a\xe7\x31\xcd\x88\x97\x8d\x36\x3a\x2a\xe9\x1a\x41\xf7\x53\x38\x1f\x92\xf7\x92\x73\xb9\xd7\xf5\xab\x63\xdb\xd6\xc3\xb6\x94\xbb\xb6\xc1\x2d\x5b\x83\xd1\xc7\xb2\x17\x39\xd9\x43\x29\xab\xba\x99\x98\xb1\x9e\x9e\xf0\x6b\xe5\xad\x31\x3b\xcd\xd8\x0b\x9c\x86\x34\x82\xd0\x26\x77\x32\xdb\xd2\xcd\xb0\x45\xea\xa0\xcb\x74\x05\x62\x2d\x7f\x6f\x5b\xd8\x84\xa1\x29\xcd\x32\x20\x57\x52\x3c\x01\xd7\xb9\xd8\x35\xdb\x51\x04\xc8\xaa\xc2\x58\x9d\xf6\x65\xcd\x31\x8c\x1c\xcb\x23\xef\x87\xb7\xfa\xa0\x2b\xf8\x20\x85\x6c\x34\x6e\xe0\x16\x04\x54\x27\x5e\x96\x8b\xaf\xf2\x90\x5f\x8e\xa4\x45\xf7\x5b\x18\xc3\xde\xed\x46\xaa\xdc\xe9\x92\xac\x4f\xa9\xca\x4a\x56\xd5\xd3\x69\x8c\x5c\xcb\x27\x9f\xbf\x0f\xbf\xe0\x10\x79\xd9\x71\xe8\x6a\x10\x45\xb1\x26\x89\x89\x6d\x79\x1a\x87\x9c\x2b\x58\x76\x2b\x96\x3e\xbf\x19\x3b\x16\xbb\xe4\x89\x55\x29\x9b\x5b\x4d\xe4\x2b\x6d\xda\xa3\xa9\xe5\x15\x88\xa2\xa2\x2b\x91\x4c\x59\x3e\x3e\xa4\x43\xa2\x71\x4d\xf7\x6c\x8a\x3b\x34\x01\x08\xe3\x83\xcd\xd9\x27\x5b\x96\x6d\x9b\x16\xa9\x10\xe7\xe2\x87\xe5\x5b\x13\x67\xc6\x91\x82\xc0\x25\xf0\xb5\x16\x15\x4c\x95\x80\x1b\xa9\x80\xeb\x98\xf7\x0f\xa0\x0b\x6f\x4d\xa5\x6d\xce\xf7\xa4\x86\xbe\x86\x99\xd5\xc4\xb7\xf5\xc8\x75\xdb\x1e\x22\x8c\x2e\xa1\x5e\x67\x96\xd0\xb6\x42\x4c\x93\x7b\x9c\x9f\xe3\x63\xa4\x71\xc9\x76\x50\xeb\x1c\x39\xd3\x56\xa7\x4f\x32\xa8\xde\x40\x36\xbe\xc9\xe4\x26\x2b\x3a\xa6\xe8\xcb\x5e\xcb\x99\x28\xb6\xac\x5a\x13\xc9\x6c\x5b\x11\x92\x2e\xc2\x5e\x2c\x69\xb4\xfe\x20\x65\x35\xa3\x21\x1a\xc3\xa5\xe5\x3c\x25\x29\x88\x3c\x83\x6a\x82\xd4\x07\x71\xec\x04\xe4\xbe\x13\x38\xdb\x7f\xf4\x56\x7d\xc6\x11\x90\x4e\xcd\x13\x38\xa7\x0f\x11\x6d\x0b\xe3\x8d\x0e\xc4\xb2\x22\x75\x09\x4f\x4f\x9c\x36\x75\x49\xd5\x04\xa4\x1a\x3e\x73\xad\xc5\xd0\x80\x47\xce\x54\xc5\x9a\x96\x65\xf4\x28\x61\xe5\x4d\xdd\x55\x88\x46\xd7\x5c\x9c\x2b\x21\x09\x6c\xe4\xe4\xbc\x86\x4e\xc1\x3c\x7e\xf3\x01\xd2\xc1\xbb\x9b\xc1\x5d\x8e\xd3\x37\xae\x9d\xd4\xd0\x66\xe5\x14\x7b\x58\x91\xa5\x3d\xe2\xcb\x20\xf8\x1a\xc8\x43\x67\x5a\xb6\x2b\xec\x18\x32\x71\x5e\x83\x32\x99\x94\xe9\xb0\x50\x9a\xcf\xe9\x0a\xaf\xf1\x03\xd7\x49\x28\xaf\x81\xcd\x2f\x98\xe5\x3a\xe1\xcc\x26\x76\x6c\xd7\x3e\x76\x4f\xa5\x54\x2b\x82\xb8\x6d\xa4\xe4\x3c\x97\x42\x30\x4a\xce\x38\xfd\x0e\x23\x56\x67\xe2\xcb\x4e\x61\x34\xbb\x6e\x02\x35\x64\x25\x9d\x58\x92\x9c\xd0\x9d\xf3\xe7\x97\xaf\xf4\x39\xad\x35\x2b\xdf\xc9\x67\x43\x6d\xa4\xe5\xd4\x14\x13\x8b\x8b\xa6\x51\x2d\xe3\x38\xfc\x58\xfb\x75\xbd\x64\x8f\xa0\xb7\x42\xc9\x4e\x4c\xe3\x94\xa1\x4f\x2e\x4a\x50\x4c\x14\xda\x9d\x1c\x61\xeb\x94\x85\x54\x32\x5f\x91\x1a\xc4\x46\xc2\x4e\x43\x2b\xf4\x1e\x54\x3d\x81\xd4\xd9\x06\x3d\xfc\xae\x44\xa3\xeb\x27\x4d\x2d\x5b\xb6\xe9\x17\x8f\xb5\x83\xa3\xe5\x47\x66\xca\xaf\x40\x72\xb6\x1a\xf1\xad\x3f\x71\xf6\xa9\xb1\x53\x7b\xd9\x8b\xb1\xfd\x57\xec\xd1\xea\x06\x49\x93\xee\x99\x9a\x2a\x89\x51\x44\x3e\xc3\x56\xbd\x48\x5c\x7c\xdf\x8b\xac\x57\x1a\x02\x2e\x28\xd4\xd2\xb4\xb6\x57\xeb\x29\xd9\x48\xe2\xf9\x11\x4b\x6a\x4c\xd0\x61\xcf\x70\x85\x1f\x17\xd0\xe0\x6d\x09\xaa\x75\x43\x0d\xf9\xd3\x0d\xb3\x90\xdc\x40\xcd\x29\xf9\x2a\xe5\x61\x30\xfc\x53\x0b\x35\x6b\x9a\xae\x5a\x69\x7e\xd4\x46\x42\xcf\x73\x99\xa6\xfd\x98\xb7\x3c\x27\xdc\x4f\x4d\xde\xd2\xb8\x51\x22\xea\x66\x9a\xed\x8b\xc3\x88\x5c\x70\xb9\xa3\x73\xe5\x2d\x0a\xac\xc8\x35\x88\x1d\xe4\x8f\xbb\x95\xac\x65\xa6\xa0\x7c\x7a\xc0\x8e\x8d\xf4\x9e\x67\x15\x52\xfa\x29\x36\xf1\xe1\xf5\x29\x37\x38\x2b\x63\x76\xe3\x64\x0f\xbc\x02\x35\x75\x90\x42\xd7\x77\x23\x82\x34\xa7\x07\x17\xec\x0f\x65\xa7\x23\x9a\x15\xae\x57\xfc\x4c\x4e\xc0\x4c\x58\x0f\x71\x96\xb9\x60\x8f\x25\xe3\xdc\x85\xa4\x56\x6c\x07\x59\x8f\xf8\x4c\x4d\x07\x6e\xd9\x51\xec\x3e\x2f\x11\x1c\x65\xce\x86\xfd\x7a\x7c\x14\x36\x52\x7e\xe2\x4f\x1d\x0b\x20\xaf\x0e\xa7\xe7\x1f\xcb\xe1\x03\x37\x4d\x9a\xb6\xab\x52\x4e\xbb\x5a\x4e\xfd\x5b\x37\x0e\xa2\x78\x81\xd6\x7c\xde\x6a\x3f\xef\x38\x6c\x53\x10\x8f\x9a\x21\x6f\xa5\x05\x0e\xf1\xc8\x45\x2f\xda\x92\xc1\x4c\x4f\xf1\x20\x6b\x96\xcd\x91\x72\x86\x24\x15\x6e\x96\xd4\x6a\x4f\xd3\x29\x9d\x71\x62\x72\xab\x80\x29\x46\x77\x8c\xee\x0f\xcf\xe5\x82\x24\xf9\x1d\xf0\x2d\x46\x2d\x3f\x35\xc3\xbf\x4e\xeb\x24\x91\x1d\xf4\x03\x15\x82\x6d\xa8\x7a\xd6\x96\x2e\x71\xc6\x74\x9c\x4b\x7f\x34\x9d\x69\x37\x4f\x64\x25\x58\xdb\xcd\xef\x81\x13\xb9\x8e\x4b\xce\x5a\x84\x18\x1c\x36\x60\x2e\xd9\x4e\xc8\x6a\x88\xbc\xd6\x7b\xbb\x91\x32\xf4\x12\x04\xa3\x9c\xdc\x0e\xb7\x8e\xd5\xcd\x62\x91\x73\x3e\x90\xd7\xe6\x53\x97\x26\xa0\xb2\x92\xed\xa6\x00\x2c\x22\xd7\xbd\x14\xcd\x51\xed\x88\x6d\x97\xd2\xb6\x59\x29\x00\x73\xbc\x71\x6c\x7d\x81\x08\x7c\xb7\x1c\x80\x31\x90\x40\x77\x93\x08\xda\xee\xa5\xda\x42\xbe\xa3\xaa\x65\x43\xde\x36\x2d\xcf\xb2\x02\x72\x21\xdb\x56\x0a\xdc\xc8\x29\x04\x8b\xbd\xd0\x19\xb3\x83\x3b\xba\x91\x4a\x3f\x7e\x37\xf4\x3b\x3b\x2d\xaf\xa3\x8d\x34\xa3\xa3\x08\xce\xf5\x84\x07\x30\xc5\x48\xce\x41\x50\xb7\x58\xd4\xfb\xdd\x00\xdf\x12\xb9\x7f\x99\x84\xdf\xb0\x5f\xff\x44\x87\xf4\xee\xd5\xaa\x8d\x6a\x1b\x19\x46\x67\x71\x96\x5b\x3a\x17\xff\xef\xb7\xa0\x49\xe7\x9a\xda\x58\xdd\xf2\x37\x84\x3f\xc8\x5b\xc5\x0e\xc5\x8e\xee\x77\xb4\xe5\x7d\xbf\xd6\x85\x1b\xc9\x45\xc9\xa5\xfe\xd1\x23\x50\xce\x80\xdc\x1f\x73\xe8\x5d\x96\xd8\x8e\xfb\x5a\xd1\x62\x6e\x64\xf8\x6e\xe8\x90\xf7\xd0\x6d\x8f\xf4\xdd\xcf\xb6\x25\xf0\xb6\x61\xdb\x12\x57\x89\x52\x14\xa7\x5e\x53\x64\x9a\x27\x9c\xd5\xa8\xd0\x42\x4d\x3b\x45\xb6\x72\x7c\xf1\xea\x2d\xda\xdd\xc7\x04\x33\xb9\x59\x03\x83\x0c\x21\x5a\x76\x64\x8e\x84\xb2\x62\x35\x09\x02\x24\x17\x1d\x1f\xb8\x3b\xda\xeb\x60\xf2\xae\xec\xcd\x40\x60\xa6\x06\x9b\xbb\x4d\x10\x13\x02\x0d\xcc\x70\x02\x2f\x20\x0f\xb2\x6a\x32\x79\xa4\x5c\x77\x0f\x7c\x07\x19\x5b\x95\x88\xce\x46\x5e\xd1\x8f\xc8\xa2\x5e\x3e\x8b\x95\x31\x00\x33\xf4\x24\xdb\x29\x5c\xe6\x49\x57\xb1\xac\x9c\x48\x10\xbd\x38\x26\x6f\x9b\xac\x3c\x4c\x45\xdf\xc9\x27\x92\x4b\xf2\x53\xd1\xc1\x8f\xbf\xed\x56\xca\x03\x90\x53\xf4\x4e\x36\xd3\xfd\xc2\x81\x04\xdc\x34\x35\xdc\xae\x2a\xd9\xb1\xa6\x05\x84\x24\xcd\x08\x10\x97\x9c\x73\x18\x72\xa1\x97\xce\xe2\xad\xa2\x54\x64\x80\xb9\xc2\x8d\x14\x85\xe4\x27\xbe\x5b\xc8\x23\xaa\x85\x73\xf4\xa8\xc5\xb4\x55\x06\x5c\xfb\x08\xda\xec\x8a\x24\xef\x45\x2e\x9a\xf1\xdd\x8a\xfd\xc8\xb2\xc8\x0d\xa8\x1e\x63\xa8\x63\xb5\xf0\x4e\x3e\x95\x2b\x49\x16\xcc\x84\xa2\xad\x1c\x82\x8c\xe1\x8b\x36\x25\xe8\x54\xe0\x45\xb4\x9c\x55\xe6\x33\x57\x26\x90\x7d\xeb\x98\xa2\x6d\xc9\x9a\xe1\x6f\x9f\x2b\x93\x9f\xe4\x8e\x65\x4b\x7e\xb0\xd0\xb7\x89\x87\xcd\xd0\xdf\xd7\x75\x03\x5c\xd7\x24\x4f\xdf\x09\x75\x97\xd8\xd4\x99\xfb\xf1\xa2\xc4\xce\x75\x8a\xe5\x02\x0c\x46\x34\x07\xa4\x5b\xbf\xec\xab\x59\xb6\xf6\xfd\xe6\xad\x76\x7d\x9b\x44\xd1\xf0\x5f\x5f\x7e\xfc\x4f\xdf\x1f\x7f\xfc\xa7\xb5\xd6\x85\xd0\x54\xd8\xb1\x7c\xbc\x6a\x1a\xd5\xa8\xe1\x56\xf9\x70\xd9\xbe\xbd\xe8\x37\xb9\xe4\x86\x65\xe5\xe0\xd7\x27\x77\x1f\x3b\x81\x87\x8b\x79\xdf\x89\x96\x89\xa2\x95\x82\x9c\x53\xc8\xca\x75\x4b\x24\x48\x41\xaa\xc9\xea\x2e\xa0\xaa\x53\xb3\x69\x4b\x54\xbb\xca\xcc\x07\xae\x4a\xca\xb2\x99\xb1\x21\xa6\x5f\x38\x79\xff\xc0\x71\x1c\x67\x26\x95\x38\x97\x1d\x97\x85\xa0\xaf\x9b\x4e\xbd\xbe\x31\xb5\xdc\xd3\x57\x15\x90\x90\xf4\x61\x08\xef\x1b\x8c\x8f\x75\x9a\x79\xce\x65\x51\xeb\xda\xf7\x10\x1c\x3f\x81\xdb\x24\x32\x13\xb3\x9e\x43\xe8\xb9\x66\xdc\xfd\x65\x09\xe8\x93\xdc\xfd\xf8\xf3\x70\xff\xe0\xd7\xbf\xa1\x99\xee\xf5\xae\x18\x42\x22\x13\xe9\x15\x55\xc0\x73\xf2\x41\x96\xa2\x31\xf2\x55\x3f\x77\xac\x32\xca\x5c\x8f\xc6\xec\xb6\x48\xc6\x50\x2f\xc9\x18\x42\x2b\x76\xb0\x59\x4c\xab\x43\xda\xa7\x33\xd4\x88\x63\xff\xe5\x3f\xff\x19\x77\xef\xe4\x28\x33\x7b\xc1\x4b\x7a\x23\xa5\xce\xd0\x1e\xf6\xac\xc7\x77\xae\xad\x06\x93\xdb\x1d\x36\xa8\xa2\xd0\x8a\xc8\x39\xe4\x14\xba\xb9\xdc\x15\x44\xb6\xeb\xcd\xc7\xf3\x8c\xb7\x5b\xa6\xf4\xfd\x5b\xe1\x58\x22\x49\xa9\xe1\x2c\xc0\x6d\x9b\x50\xaa\xa3\x9a\x55\xfb\xa8\xcd\xee\x2e\xe1\x1d\xef\xe6\x52\xb9\xe3\x90\x2b\x30\x07\xf3\x05\x91\xe7\x3d\x70\xa8\x40\x64\xa3\x26\x23\x3f\xf1\xa2\x70\x44\xa6\x6b\x50\xc6\x61\x02\x71\x2e\xf8\xa6\x0d\x8c\xd3\xdd\x6b\x44\x71\x4a\xe7\x55\xf9\xf1\x28\xa3\x79\x00\x35\xbb\xee\xb6\xbc\xdb\xc2\xaa\xd1\x24\xd2\x94\xea\xbf\x7c\x31\x4e\x32\x8a\x68\x0e\xe9\x18\x46\xff\x7a\xac\xc4\xfd\xfe\x3c\xfa\x77\x63\x97\x7c\x19\x9c\x89\x38\x42\x57\x7a\x0f\xa2\x05\x72\xa1\x3a\xac\xba\xbc\x97\x22\xef\x14\x9c\x78\x71\x2e\xd6\x80\x36\x1b\x45\x7b\x2d\xad\xf8\x6a\x9a\x49\x6b\x7b\xdd\xe8\x7e\x4c\x07\xbb\xdb\x27\x9d\x98\x49\x34\xc8\x35\x74\x8a\xf2\x03\x1f\x79\xa5\xfe\xf2\xa7\xf4\xc7\x3f\xec\x5f\xad\xc2\x7b\x67\x23\x5d\xe9\x0d\xa8\x02\x14\x92\x2d\x2f\x30\x75\x77\x65\x3f\xd7\x45\xaa\x09\x55\xe7\x3e\xcd\xf8\x25\x33\xf7\x1f\x38\x73\x3b\xf1\x70\x98\xfc\xc7\xdf\x14\xba\xb3\x75\x7a\x26\x03\x1b\x99\x4b\x87\x08\x63\x48\x35\x67\xc7\x38\x51\xef\x54\xe8\x18\x3d\x2b\x49\x99\x28\x66\x9a\xa4\x69\xac\xe9\xc5\x66\x7d\x18\xb6\x07\x01\x4b\x5b\x76\x6a\x0d\x12\xdb\x0b\x46\x5a\x35\x3d\x51\x80\x0b\xe9\x8d\xbf\x80\x1a\x6d\x9e\x9d\xb4\x86\xd1\x2a\xb4\x82\xc8\x22\x5f\x10\x78\x3c\xe6\x30\x81\x6d\x5b\x0b\xc7\xfe\x8e\xaf\xd7\xc2\x40\xce\xd2\xb7\x5d\x41\x05\x25\x98\x7a\xe6\xe8\xc8\x16\xfa\xce\x74\xab\xcd\x9e\xf3\x3c\xdf\x74\xad\x98\xdc\x43\xd7\x96\x72\xb3\x99\x56\xe6\x59\x4e\x8c\x41\x23\x22\xe3\x77\x8c\xeb\x63\xbb\x5e\xb8\x88\xf4\xa5\x3a\x5c\x7c\x0e\xe1\x6c\x4a\xa9\xdb\xbe\x4a\x63\x38\x3d\xf7\xb9\x07\x0c\x1d\xad\x59\x74\x24\x3b\xfb\x54\x74\xca\x9c\xe0\x15\xc8\x4e\x6c\x4f\x37\x69\x1a\x5a\x97\x93\x13\xbe\x90\x05\xcb\x8d\xb0\x40\xa6\xba\x27\xcf\x4b\x4a\x59\xd1\x37\x35\x46\x47\x71\x1c\x79\x91\x96\x2d\xd4\x3d\xd0\xc5\xa2\xe2\x30\x0a\xbc\x97\xc4\x6a\xb7\xd0\xe1\x6f\x5c\x81\x57\xcd\x46\x4a\xd3\x0f\x12\xc4\xa4\xda\x3a\xe9\x4c\xd5\x93\x32\xdb\xa3\x9a\x3e\xf3\xfc\x24\x65\x05\x97\x29\x9d\x63\xe1\xc8\x9a\x89\xad\x0e\x3c\xe1\x7b\x90\x59\xb7\x12\x8c\xd3\x9f\xf8\xc7\x96\x18\xce\x8f\x60\x02\x5f\x3a\x62\x38\xbd\xe0\x37\xf5\x06\xe2\x05\x73\xdc\x18\x77\x38\x71\x10\x45\xf3\x26\xde\xc9\x26\x93\x9b\xcd\x4a\x2e\x05\x59\x4f\xb1\x30\x4c\xbe\x42\x53\xea\xd4\xf1\x99\xbb\xaf\xf7\x93\xdd\x0b\x93\xae\x81\x56\xe6\xb0\x04\x1f\x4c\xba\xf1\x2f\x49\x9e\xef\x69\xba\xa6\xda\xba\xed\xbb\xb3\xa8\xc7\xd4\x5e\xfb\x05\xb6\xd3\xe5\xc3\xd6\x9a\x17\x2d\xd4\xe8\xdc\xd8\x27\x5f\x01\x89\xec\x0f\x3b\x4d\x17\x92\xcb\x4a\xcf\xd3\x49\xce\x76\x27\xf6\x24\xc8\x7c\xaa\x11\x30\xe4\x3d\xcc\x2d\xec\x19\x21\xa2\x4a\x63\xf6\xe2\x24\x4d\xb3\xb9\xf0\x6d\xd9\x0e\xb9\xef\x33\xa8\xa4\xa2\x07\x15\xe2\x2f\xac\x95\x15\x28\x3d\x28\x78\xcf\xe5\x8e\x9e\x98\x6a\xc8\x46\xee\x53\xa3\x55\x39\xeb\x74\x7f\xaa\x41\x03\x8b\xa9\x96\xe9\xf6\x20\x91\xfb\x37\x5a\x26\x31\x08\x67\x7a\xa1\x83\xf2\xf0\xdb\x12\xc8\x59\xd5\x6d\xba\xb5\x7c\x3e\xd2\x9d\x9a\xe4\xeb\x59\x74\xf8\x0b\x18\x49\xad\x76\x8a\x0c\xbd\x14\x65\x2f\xf6\x34\x6d\x16\x8f\xd9\x0b\xb6\xfe\x83\xcc\xf9\x4a\x41\x55\x41\xcb\xb6\x3f\xfe\x61\xa5\x20\x11\x39\x50\x47\xb1\x64\x64\x4f\xd3\x4f\x10\xf0\x51\xc1\x36\x45\xab\x97\x2d\x04\x39\x7d\x72\x03\xbd\xde\xb5\xa9\x23\xef\xba\x9e\xa5\xab\x01\x3b\x66\x2a\xe8\x27\xd7\x17\xb4\x91\xff\x54\x8b\x25\x3f\x23\xd2\x44\xfa\xe7\xb9\x17\x9f\x4f\x74\x9a\x5e\x9e\x14\xac\x2d\xbb\xf4\x8d\x66\xb3\x89\x03\x3f\x1a\xc1\x06\x73\x0e\xe6\x79\xb6\xff\xda\xd2\x63\xf0\xb7\x54\xc9\x86\x89\x72\x35\xde\x0c\x1b\x49\x50\xc7\xa9\x04\xdd\xac\x1e\x35\xd6\x47\x0c\xbf\xca\xb5\xd9\xa3\xcf\x79\x6b\xc8\xc3\x10\x56\x36\x0d\xa5\x87\x2f\xf4\x45\x09\xa2\x28\x57\x12\x63\xb1\x91\x02\xd5\xd0\x9d\x50\xa3\x1c\x71\x96\x82\xd6\xa9\xcd\xf7\x83\xc9\xdb\x24\x4c\x34\x2d\x14\x0a\xaa\x59\x84\xd8\x8d\xc8\x7d\x57\x53\xc5\xa4\x3a\x14\x87\xed\x9b\xb5\xf4\x4a\x6c\x24\x3d\xfd\x40\x41\xcc\x4f\xd6\x42\x83\xdc\x3c\x59\x45\x92\xd3\x26\xa7\x23\xdd\x9a\x37\x2b\x2a\x1f\x54\x37\x6e\xa1\xa1\xbd\xbe\x9d\xeb\xa0\x5c\x90\xf3\x54\xb7\x28\xd8\xdc\xaf\x9d\xeb\x87\x59\x0e\xbd\x57\x26\x85\xcc\x21\xcf\xe7\xb8\xc2\x8e\x9e\xab\x12\xcc\xb0\x88\xd0\xd7\x78\x81\xb7\xa2\xc9\x4a\xaa\xc9\x01\x3f\xd1\xb6\xa4\xea\xf4\xf0\x96\xc0\x1d\xfb\x64\x13\x2d\xa3\x86\x48\xcf\x90\x0f\x85\xdc\x8c\x1e\x4b\x68\x3e\x69\x63\xf8\x96\xe5\x93\x6b\x29\x0a\x04\xef\xcc\x4b\x73\x3c\xcb\x0e\xe6\xd0\xf0\xf6\xc7\x9f\x91\x74\xb9\xfb\xbe\x52\x74\x18\x78\xe3\xf2\x70\xec\x47\x1f\x22\x21\xc7\xa2\x8d\xda\x0e\x56\xef\x31\x29\xa8\xcc\x58\xcb\x66\x3a\x89\xd0\xb5\x42\xf2\x89\xee\xc9\x05\x34\xed\x91\x2a\xf7\x7d\xb7\x85\xaa\xe3\x1a\x2a\xbd\xd2\xb9\x5c\x48\xbe\xcd\xcc\x0b\xd8\x9a\xa8\xc7\x69\xe8\x47\x4d\xbe\xe0\x6d\x5f\xdc\x37\x2b\x20\xb7\xb0\xe7\x4c\x14\x07\xe1\xd4\x35\xa4\x0a\x72\xa9\xd6\x0c\x7c\x35\x05\xaa\x66\xbc\x02\xa5\xf4\xfc\xf4\x4d\x6f\x54\xd9\xf7\x68\xf2\xf8\x41\x61\x7b\x2a\x91\x1e\x99\x72\x87\x0a\x84\xc6\xca\xad\xa0\xb8\x6a\x23\xff\xa9\xf6\xf2\xcb\x49\xc1\xfb\x2d\x33\x50\xc7\x69\x52\xd0\xab\x12\x50\x55\xff\xa6\xd2\x01\x53\x6c\xdb\x01\xb9\x61\x7c\x0f\xdd\x96\x1e\xc6\xbe\xb7\xac\x2d\x7f\xfc\xcd\x5a\x9c\xa1\x36\x52\xa1\x9a\xc4\x79\xd6\x68\xbe\x66\x62\x3b\xf7\x33\x15\xea\x34\x7b\x22\x69\x6a\xc5\x44\xb1\x18\xdf\x0f\x47\x72\xfc\x23\x45\xdf\x1f\xff\x41\x92\x3b\x69\x46\x2d\x56\xc0\x0f\x20\x1d\xaa\x3e\x81\xcf\x02\xaa\x39\x59\x79\x9c\x63\x29\x99\xf4\x94\x73\xb9\xaf\xa1\x58\xa8\x35\x78\x56\x14\x4e\x8c\x12\x07\x2b\xbc\x61\x6d\xd3\x6d\x81\xe5\xbf\xfe\xdb\x57\xab\xa0\xe2\x42\xcd\x02\xd2\x2a\x96\x6d\x97\x53\xe2\x13\xad\x5c\x6d\x46\xc4\xbd\xfa\xf9\x78\x8c\x13\x44\x38\x9d\x5b\xa5\x54\x15\xf3\x7b\xed\x99\x38\xf1\xbe\x85\x1d\x0c\xfb\xfc\x4a\x73\x5b\xed\xe1\xa4\x1c\x42\x36\x12\xa4\x9a\x31\x84\x5b\x65\x30\x6c\x0b\x3e\x9a\x5d\x3d\x18\xbd\x6f\x2f\xc6\x47\x62\xfb\x19\x1e\x75\xbc\x68\x7e\x60\x2d\x8b\xa7\x5f\x40\x18\x92\x8a\x15\x1e\x33\xa4\x46\xfd\x30\xdc\xa6\x99\x2b\xe3\x42\x16\xd3\xca\x1e\x91\x2b\xc3\x53\x8b\x34\x33\xb6\x88\xe9\x7d\x1e\xd4\x36\x4a\xd6\x94\x2b\xb1\x7e\xd8\xc8\x89\xaa\x1b\x61\x13\xa1\xc4\x87\x11\xa7\xd8\x20\x9f\x84\xd7\x24\x99\x02\x56\x34\xa8\xed\x39\x2a\x0a\x79\xae\xe7\x93\x9b\x21\x90\x57\x4d\x56\x56\x2c\x6f\x8f\x5c\xad\x5b\xda\x2a\x04\xe8\xac\x33\xa5\x15\x6a\xee\x8f\x1d\x13\xe4\x4e\xea\x53\xb4\x68\xa7\x6f\x95\x6c\x1a\xaf\x4d\x76\x5b\x29\x5a\xd8\xb6\xd4\x8c\x55\x38\x31\xb9\x50\xb4\xc9\xa8\x68\xc9\x67\xd8\x1e\x27\x7a\xfa\x99\x81\x28\xba\x95\x32\x95\x70\x6c\xab\x00\xe5\x4b\x24\xad\x89\x17\xc7\x62\x69\x65\xc0\xb4\x5e\x97\x34\x25\x13\x7d\xd3\xc2\x82\x3c\x4e\xb3\xea\x1f\xbc\x63\x3f\x33\x81\xc1\xc8\x07\x46\xd3\xd5\x0e\x65\x80\x54\x05\x9c\x8a\x65\xc7\xf9\x6d\x5e\xd0\x49\x65\xa3\x34\x3d\x67\x6f\xb7\x94\xda\xf6\x5c\x2b\x0c\xc9\x4f\x22\xa7\x35\x15\x39\x15\x19\x3d\xc4\xeb\xff\x42\x57\x83\x58\x85\xe1\x2c\xb2\xb1\x80\xf9\x4d\xf7\x6d\xc2\xf8\x79\xfb\xe7\x51\x62\x14\x91\x73\x68\x18\x3f\x72\xc5\xae\xd7\xd3\xe2\xb1\x91\x0e\xf5\x3d\xe8\xe0\x70\x59\xf1\x5d\x4c\x8e\x94\x8b\x9a\xef\xf7\x24\xe3\x2c\x43\x51\x9b\x91\x26\x54\xb3\x9c\x50\x85\xae\xfe\x25\xd6\xef\x01\xb8\xce\x0e\xee\x15\x1b\x3e\xdc\x9e\x78\xbb\x96\xc4\x63\xbd\xd0\x08\x8c\xc1\x81\x18\xba\xe8\x3d\x1f\x8c\x5e\x9f\x54\x7c\x9a\xa6\x08\x63\x72\x4d\x95\xec\xe7\xce\x58\x1c\x59\xaf\x6d\xc7\xd7\xf1\x6e\xd3\x52\xa6\xd6\xab\xdb\x20\xe1\xa9\x79\x91\xaf\xa4\xca\x0d\x7b\x8b\x19\x2d\x18\xfd\xc6\xae\xc0\x8f\xbc\xa7\x64\x30\x2d\xc6\x03\x03\x7f\x70\x1b\x2d\xa5\x02\xc7\x3f\x0e\x2b\x04\xc8\x5a\x3b\xa4\x99\x7c\xcd\x9c\x05\xf9\x4f\xcf\x2a\x10\x39\x42\x85\x85\xe4\xb9\x26\x2c\x30\x94\x9a\xa0\x8c\xd1\xb7\x92\x32\x73\x03\x6b\x2a\x4e\x85\x8e\x47\x6e\xa8\xc2\x40\xec\x00\xfe\x5c\x42\x25\x75\xf6\x03\xa2\x3a\xad\x6e\x88\x1d\x39\x06\xa4\xde\xce\x4c\x6a\x7f\x98\x19\xf4\x1f\x91\x4a\xcd\xb7\x93\x86\xd2\x06\x60\xbc\x5c\x8e\xe5\x5a\xba\xf4\xa1\xb7\xeb\x60\x1c\xe4\x82\x71\xda\x89\x9e\xad\x97\x3a\x23\xf7\xa9\x96\x8b\x5a\x0e\xd3\x3d\x40\x31\xb2\x44\xab\x71\x9a\xce\x77\x34\x1b\x23\xe4\xcd\x1b\x26\x36\xb8\x17\x91\x67\x3b\xd1\x5c\xb2\x3a\x06\xa9\x65\xa2\x48\x57\xf2\xf7\x48\x82\xfa\x61\x88\x91\x16\x3c\xbc\x13\x71\xcb\xa3\x26\xe1\xf5\xdd\xe7\xfd\xf5\xc0\x1f\xc3\x5f\x82\xe5\x92\x83\x7a\x47\x09\xa2\x20\xe7\x92\x89\x42\x97\x06\x56\x58\x97\x3f\x29\x03\x80\xda\x69\x17\xf0\x85\x19\x79\xaf\x12\x4d\xbe\x97\x34\x15\x26\x2a\x66\x4e\xd0\x0e\xdc\xd0\xc7\x59\x7e\xdd\x8a\x1e\xa3\x60\x27\x0c\xad\xa9\xb0\x0d\x3f\xfe\x56\x92\x9c\x92\x33\x95\xc9\xd5\xe8\x93\xed\x68\x9a\x75\xa1\x62\x29\xe1\xbf\x14\x1a\x6f\x8c\x8c\xbf\xef\x27\x5d\xd6\xe4\xe3\xe6\x59\x0e\xf9\xcc\x73\x72\x05\xed\x21\xdf\xf0\x45\x49\x45\x91\xaf\x45\xdd\x65\x23\xef\xe9\x34\x3e\x37\xea\xee\xea\x61\x97\x5a\x47\x7a\x8f\x99\x94\x5b\x3f\x48\x1e\x59\x95\x4f\x9d\x16\xdb\x71\x83\x90\x9c\x61\x8a\xf9\xd2\x27\x22\xc9\xfc\x5a\x97\x2b\x1a\xe1\x26\x33\xb0\x68\x52\x2b\xe3\x08\x2c\xf2\xc3\x71\x8a\xac\x91\xbc\x6b\x99\x14\xcd\x5c\xa0\x42\x65\xce\x21\x42\x3c\x9c\xe0\xf9\x28\x45\xd3\x82\x68\x99\x90\x6b\x69\x0a\xdb\xc8\x7a\x6a\x32\xc6\x8b\x4e\x08\x26\x8a\x52\x93\x07\x2d\x32\xe8\x22\x9b\x3e\xf1\xa3\x84\x89\x9c\xd1\x42\xce\x4d\x32\x77\x71\x1a\x17\xae\xd1\x0a\x6d\xdb\xc1\x9a\x00\xdd\x1b\xe7\xb2\x1e\x50\x27\x7e\xa6\x0e\xa3\xc0\x2c\xf2\x1d\xa5\xf9\x86\x19\x76\xd7\x02\xcd\x7e\x9c\xa4\x5c\x16\x7c\x88\x27\x66\xbe\xa4\x73\x10\xf9\x90\xaf\xbc\x98\x9b\xfb\xda\x7d\x5b\xe9\x5c\xc6\x46\xcb\x96\x51\x72\xaf\xf5\x5f\xf0\x04\x61\x44\x8c\x59\x59\xa3\xad\x3e\x24\xf4\xb5\xa2\x59\xd9\x3a\xde\xa8\x4b\xe4\x90\x77\x1d\x6f\xa5\x38\x9a\x72\x5e\x83\x28\xb6\x72\xad\x55\x39\xd3\x6c\xc1\x9d\x79\x98\xdf\x99\xea\x54\xae\x28\xcd\xfd\x34\xc9\xe9\xae\xec\xd2\xb9\x36\xe5\x47\xd6\x08\x80\x38\x94\x4f\x96\x0d\x87\x1d\x5f\x8d\x4e\xde\x46\xba\xd3\xbb\x1e\x04\x39\x97\x3d\x2e\xe7\x73\x2f\xcd\x2c\x99\x4a\x65\x9f\xfb\x59\x52\x31\xf6\x52\x8d\xc8\x77\x7c\x77\xdc\xa3\x17\x27\xee\x1c\x7a\xd8\xaf\x0b\xfd\x8d\x97\x83\x2d\x0b\x92\xbf\x9f\x3b\x73\x93\x5a\x43\xf2\xe7\xe7\x49\x3f\x9c\xc2\xef\xa6\xe4\x61\x59\xb6\x43\xbe\x30\xce\xa1\xa0\xe4\x4a\x51\x7a\x44\xae\xf7\x41\x8a\xe2\x51\x77\x36\x34\x8f\xd0\xc9\x79\xf2\x6d\xcd\x7e\x6a\x26\x24\xee\x75\xd5\xe9\x85\x47\x6c\x4d\x31\xca\xa7\xc9\x9e\xa6\x42\xe6\x74\xe1\x0c\x2f\x29\xe7\x90\x4b\xc4\x70\xbe\x78\xc9\xbe\x42\xa1\x7b\x12\xf7\x5d\x7e\xe2\xf2\x6f\xac\x55\xe6\x72\xd6\x96\xe4\xbe\x84\xbd\x7e\xc8\xba\x91\x90\xe6\xb1\x29\x61\xef\x6f\x92\x9a\x0a\x83\x10\xb0\x03\x6f\xe2\xc9\x3f\x60\xdb\xbd\xa6\x7b\xd9\x4a\xbe\x62\x37\x2c\x9e\xab\x1c\x5f\x4b\x4a\x0d\xf6\xf2\xb3\x34\x50\xa9\xed\x5e\x1b\xfd\x22\xe9\x29\x9f\x3a\x46\xee\x10\x1e\x7e\xa1\xaa\x92\xa2\x3d\xca\x20\xf1\x89\xca\x74\xc5\x45\x45\x13\xe1\xd3\xbb\x21\x8b\x57\xf3\x23\xd5\xe0\xc1\xe3\x1b\x34\xfb\x65\xf2\x5d\x51\x58\x62\xda\x6e\xa8\xc8\x65\x0b\x07\x75\xdf\x0f\x4c\x14\xfd\x6a\x03\xb5\xc8\x71\xfa\x91\x55\x29\x55\xbc\x1f\x5b\xe9\xf7\x13\xa0\x6d\x9b\x43\xef\xb3\xe3\x14\x48\xb6\x63\x79\xe4\x3e\x93\x1a\x7e\x73\x28\xc1\xde\xaa\x1f\x7f\xf3\xeb\x3f\x12\x01\x39\xf9\xc4\x1a\xf3\x62\xad\x36\x77\xe4\x58\xd6\x8c\x9b\x9d\x64\x1c\x2f\x7b\x31\x56\xdd\xb4\x8e\xa3\xff\x98\x64\x79\x0a\xe9\x4c\x87\x1f\xdb\x56\x40\xee\xa0\x6a\xb4\xc0\x46\xf6\x82\x48\x81\x41\xf6\x6a\x1d\xe1\x39\x07\xf9\x4e\xdf\x6b\x0a\x6e\x72\x3e\x62\x3b\xfe\x20\xc7\xe8\xb7\x44\x98\xa8\xbf\x5d\x10\x0c\x58\x8e\x45\x6e\x41\x6c\x15\xb4\x4f\x87\x5c\xa0\xc0\x75\x0d\xf1\x63\xaf\x8a\xfe\xe9\xd4\xd0\x7a\x07\x49\x4e\xcf\x44\x5b\x4a\xd1\xeb\xeb\x62\x66\xdf\x90\x99\x6b\xe4\xf5\x80\x4a\x7f\xe2\x73\x33\x01\x01\x33\x49\x6d\x6c\x87\x4e\x4c\x1e\x64\x0a\xc7\x3a\x7c\xe7\xbc\x7f\x12\x7d\xb7\x5d\x8b\xa0\xd6\x41\xba\x53\xa4\xaf\xd2\x5d\xc8\x57\xcf\xf9\x4e\x0b\x81\x56\xbf\x4a\x72\xd5\xd5\xc0\xc7\x26\x8b\x6f\x91\x8f\xd0\xb2\x63\xa4\xbb\xf7\xb4\xd2\x2c\x6a\xab\xb8\x43\x07\x39\x4e\x47\xc6\xdd\x79\x4d\x0f\x30\x36\x57\x72\xb3\x24\x91\x34\xdb\xbe\x9e\x09\xb9\xc8\x05\xef\x73\x4a\xae\x60\x88\x35\xca\x63\x7c\x3a\x67\x55\x0a\xad\x24\xe7\x92\x8a\xfe\xd5\x6a\x4a\xbd\x8e\xe5\x8f\x5a\x7a\xb0\x7c\xc6\x7e\x12\x62\x24\x71\x85\xf1\x21\x93\x09\x34\x93\xb0\xa3\x67\x93\xb7\xd0\x68\x86\x99\x83\xfa\xe8\xb9\x2c\xba\xac\xdc\xc3\xaf\xff\x95\xa6\x5a\x3f\x39\x26\xcc\x41\xce\x53\x43\xec\x67\x38\xf0\xe6\xee\xca\xde\x84\x89\xa9\x1a\x3f\xf1\xeb\xa4\x01\x3e\x0f\x1b\xd9\xa1\x17\xf8\x43\xe4\x8b\xeb\x33\x11\xb0\xe7\xd9\x24\xd0\x2a\x88\x9c\x89\xa2\xf9\xf1\x1f\x35\x18\xf0\xe4\x13\xd0\x0e\x72\xa0\x22\xcf\xd3\x57\xd0\xac\xc2\x0b\xaa\xff\xc7\x3d\xa8\xdc\xff\x96\xcc\x0d\x89\xa9\xe4\x66\x59\xbe\x45\x06\x07\x44\x8f\x0e\x7e\xdc\x42\xc3\x54\xdd\xb5\x6c\x3d\x41\x6c\x07\xa9\x50\xcf\x69\xdb\xf6\xe4\x3d\x8c\xf4\x17\x6c\xb3\x19\xa1\xcd\x69\x39\x58\x7d\x95\x68\x59\x97\x0d\xa5\xf9\x52\x77\x9e\xfc\xbe\x85\xf2\x98\x1f\x19\xa2\xe9\x82\xea\x18\xe6\xf4\x79\x98\x83\xd4\xa7\x23\xf7\xc5\xbc\xb0\xb2\x37\xda\x8e\x5b\xbd\xac\x26\xc9\x65\x3b\x62\xde\xec\xc8\xc6\xe8\x9f\xe6\xb4\x82\x63\x4a\xa3\x13\xfb\xed\x3a\x5b\x65\x8f\x5a\xfa\xaa\x17\xcf\x98\x72\x6e\xe8\xa8\x0c\xbb\x1d\xa9\x72\xfc\x76\x44\xff\x4e\x62\xa3\x6e\x18\x92\x4b\xb9\xa3\x2d\x30\x7e\x64\xc7\xfe\xfa\xc7\xff\xfe\xac\x17\x04\x04\xf9\x04\x8d\x4e\x59\xfa\x13\xc3\xd2\x1d\xa4\x3f\x3d\x53\x6d\xd9\x29\x72\xc3\x5a\x9c\xef\x7b\xf5\x5c\xa4\x18\x2a\x63\xf7\xbb\x97\x23\xb4\x51\x10\x47\xde\x0c\x99\x9d\x8a\x03\xa1\xe5\x59\xaf\x43\xcb\x74\x92\xd2\xae\xe8\xd6\x6c\x96\x39\xc8\x81\x7a\xcd\x44\x3e\x3c\x00\x4c\x2b\x6c\x9c\xed\xa0\xc2\x35\x70\xd4\xa0\xf0\x77\x49\xd5\x74\xd3\x90\xa9\xe7\x58\xe4\x3d\x14\x70\x24\x7b\xc6\xaa\x3d\x08\xec\xb4\xfc\x81\x55\x29\xa4\xfb\xd3\x86\x20\xc8\x7d\xaa\xb3\x32\x3d\x1f\xa1\x59\xc5\x26\xa9\xec\x2d\xd5\x56\x7f\x9f\xe4\x4a\xd6\xa9\xfc\x3e\xc5\x56\x8e\x29\x09\x68\x76\xbf\xc3\xeb\xc6\x68\xce\xc7\x01\xea\x93\x3f\x68\xb6\xd6\xbc\x15\x74\xd1\xd0\x9c\x38\x26\x41\x77\x32\xfd\xef\xcf\x06\x4c\x5d\x27\x72\x83\x09\xfd\x77\x0c\xb6\x02\x9c\x8a\xcc\x4c\xcd\x76\xd0\xd2\x0a\xf8\x89\x2f\x98\x6f\x84\xa1\x60\x16\xf2\x9d\xbd\x47\x8d\x42\xbe\x7e\x9f\x64\x02\x67\x73\xcc\x44\xc4\x05\xa8\x9c\x09\x38\x1c\x72\x3e\xa7\x9c\x0a\xa3\x9a\xc5\x0b\x38\xb9\xb7\x08\x34\xfe\xac\x82\x45\xbf\xe8\x97\x09\x88\x2e\x4c\xc7\xe8\x29\xd9\x53\x96\xce\x13\x1e\x51\x44\xde\xd3\xcd\x86\x2a\x81\xc4\xd8\x2f\xf9\x9f\xe5\x23\x03\x51\x3c\x76\xe2\x91\xad\xc3\x9a\xe9\x20\xc3\xe9\x84\xdb\xe3\xd2\x48\x7e\x7e\xee\xa5\xd0\x0e\xa1\xde\xa0\x31\xb0\xb0\x70\xdd\x02\xdf\x2a\xc8\x67\xf2\x15\x3f\x8e\x17\x0d\xb1\x03\xbd\x4d\xd9\x42\x0a\x6a\xc5\x20\xdf\xd0\x9c\x62\x1f\xfd\x03\x64\x32\xd5\x2e\x43\x33\x09\x8c\xbc\x54\xf0\x88\x9f\x04\x76\x92\xb3\xe6\x5b\x37\xab\x00\xc6\x9e\x6f\xff\xf6\x00\xdc\x1f\x70\xd4\x83\xae\x55\xcf\x76\x0c\xe3\x29\x88\x85\xb4\xed\xc3\x9e\x4d\x0d\x75\x2d\x6b\x1b\x38\x2f\x8b\x02\x56\xec\xd8\xe4\x96\x69\x94\xd5\x66\x73\xb0\x6b\xd7\x5d\xbd\x2a\x99\x98\x83\x54\xa7\x53\x2e\x2d\x55\x01\x63\x66\xd6\x76\x62\xa2\xa7\x2d\x40\x04\x6e\xd2\x96\x14\x07\x68\x41\xe4\xc3\x5f\x35\xbd\xcf\xb6\x45\xde\x4b\x50\xc7\xc9\xc9\x7f\x61\x02\x6f\xde\x3a\xfb\xe6\x68\x4d\xb9\xbe\x92\x22\x27\x37\x74\xf0\x77\xf8\x2e\x17\x6c\x87\x27\x49\x55\x68\x0b\xbc\xa4\x4e\x27\xe2\x2d\xcf\xb2\x96\x53\xd0\xd3\xe3\x1c\xfa\x8e\x8d\x8a\xbd\x97\x43\x3a\xba\x2e\x07\xb4\x83\xf4\xa6\x17\xa0\xb8\x6c\xc8\x39\x06\xc4\xad\x76\x74\x9a\x5c\x31\x4b\xb5\x2d\xf0\x93\xe5\x84\x9f\xe5\x78\x26\xe4\x38\x6c\x63\x9e\x43\xca\x56\x7b\x97\x9d\x19\x58\xfa\x7c\x9e\x1b\x93\x16\x9a\x8f\x6c\xeb\xd3\x67\x41\x80\xa3\xeb\x4d\x4b\x61\xca\x5c\x3c\x37\x8a\x1d\xad\xa9\x74\x50\x66\x7c\xe8\x04\x68\x98\x11\x55\xdd\x69\x97\xa6\x43\x8e\x82\x0e\xaf\x33\xed\x0d\xbb\x3a\xa4\x13\xb5\x00\x54\x83\x35\x08\x93\x94\x3d\x3d\xca\x4e\x09\xe0\xd3\x86\x39\xa1\x1b\x05\xe4\x52\xb6\x2d\x3b\x50\xdc\xb8\x07\x81\x9c\x72\x3f\xfe\xdc\xac\x17\x7a\x20\x75\xe9\x38\xdb\x37\xc2\x04\x6e\xb9\x39\x86\x2a\x93\x72\x1b\x44\xc3\x19\xac\xe6\x04\xd3\x25\x17\x52\xe5\x94\x33\x38\x22\x44\x0f\x15\xec\xa1\x95\xab\x3a\xc5\x39\x02\xb9\x28\x61\xa7\x07\x08\x7e\x31\xa3\x7d\x22\x43\x53\x10\x27\x7b\xa6\xe6\xac\x19\x15\xf6\x81\xd3\xc3\xe6\xe5\xe7\x6d\x09\xa2\xd9\xae\x94\x31\x23\x5f\xe9\x25\x36\xb1\xe6\x88\xf7\xac\x18\x67\xe0\x72\x1d\xf2\x06\xb0\xa4\x40\x8b\xc2\xc8\x21\x97\x7b\x56\x94\x47\x25\x6b\xda\xb5\x40\x29\x8e\x33\x0b\xbc\xdc\x30\x33\x73\xb9\x98\xfc\x6d\xaa\xc1\x18\xa4\x49\xd3\x82\xd8\x48\x95\xcf\x24\x24\xe4\x46\xfe\x16\x8b\xe2\x79\xd7\x6a\x00\xe2\x0d\x64\x34\x97\x27\x9e\x41\x77\x90\xaa\x74\x44\x34\xdc\x31\x53\x02\x7c\x3e\x8a\xb3\x57\x83\x3d\xc8\x92\x86\x55\x35\xa7\x15\x64\x25\xc2\x1a\xc6\xc2\xef\xc8\x80\x7f\x04\x06\xf0\x95\x65\x25\xd3\x6d\xcf\x12\xd8\xc9\xfd\x3a\x92\x95\x6a\xec\xd7\x48\xa5\x3e\x2a\x26\x29\xe4\x50\x0f\xf2\x97\xa4\xf7\x91\x1b\xc5\x36\xb9\x06\xf2\x4e\x72\x4e\xdb\x96\x1e\x1b\x2d\x2d\xa5\x78\xa4\x6b\x56\x6d\x5c\x83\xdb\x68\x4b\x40\x01\xa5\xe5\xe3\x5b\x65\x92\xd3\x80\x2e\xe6\xcf\x03\x27\x70\x2d\x23\xad\x36\xe6\x26\x96\x17\xc7\xd6\x52\xa6\xe0\x92\x15\x82\xbe\xe6\xb4\x79\x7d\x0e\x4c\xac\x34\xac\xe2\x20\x61\xe9\x83\x6e\x9a\xcf\x33\x7c\x93\x14\xbb\x9e\xe1\x0b\x36\x89\xa2\x79\xce\xda\xc5\xd0\x14\xf9\xbd\x60\x47\xf3\xe3\x87\xa6\xef\x54\x57\xf7\xa2\xf9\xeb\x1f\xff\xa4\xf1\x43\x2b\x34\x55\x90\xb0\x74\x8c\x74\xe7\xc4\xf2\xab\x54\xf9\xde\xe8\x42\x83\x4e\x2d\x83\x62\xa9\x88\xaa\xf1\x88\x6e\xe8\x07\xc4\x6f\x8f\xd5\x44\x1f\x80\xcb\x35\x0f\xa2\x37\xf3\x21\xcc\xad\x95\xb9\xbb\x07\xba\xb5\x12\x94\x49\x4e\x73\x9a\x55\xf3\xa4\xac\xef\x7a\xe4\x0b\xa0\xea\xce\xdb\x17\x74\x3f\xd3\x45\x93\x6a\x5b\xea\x47\x61\x85\xb7\x0b\x19\x4a\x87\x18\x97\xbc\xef\x34\x6c\xe1\x86\x52\x13\xdf\x96\x9d\x68\x03\x96\xbc\x50\x16\xf6\x6d\x6f\x6a\x37\x1c\xcb\x46\x6e\xa5\x6a\x25\xf9\x42\xb9\x66\x43\x38\xfd\x10\x84\xe3\x06\x73\x34\x78\xa5\x74\x40\xf7\x16\x34\xf1\x23\x14\x0a\xfa\xe0\x71\x3c\x7d\xda\x6d\x47\xe4\x02\x54\x8d\xd1\xfa\x11\x55\x72\x50\x54\x80\x28\xc8\xb5\x5c\x4f\x4e\xc2\x71\x47\x8d\x7c\xdc\x27\x1d\xe0\xbe\x9d\xf8\x1e\x9b\x12\x8d\xc1\x36\xa9\x20\x9b\xfb\x5e\x46\xbb\xf0\x00\x16\x35\x3c\xdc\xba\x9d\xdb\xa9\x2d\x13\x40\xde\x41\x73\xd2\x99\x5f\x07\x99\x47\xef\x80\xd7\xe5\x02\x61\x88\xe5\x34\x1d\xd3\x6a\x7c\x61\xc0\x9f\x47\xb5\xb1\x13\x86\x2e\xb9\x96\xb4\x1e\x92\x94\xa3\x6f\xf1\x0d\xf0\x4e\x48\x72\xdf\xad\x39\xa3\xed\x68\xee\xd1\xe1\x27\xbb\xa4\x55\x9d\x95\xff\xda\x91\x56\x35\xa8\x8e\x09\xb8\xb8\x01\x39\xe3\xc3\xb7\x3e\xe2\x0a\xdf\xf3\x3e\x95\x5b\x58\xcb\xc5\x7b\x0b\x4e\xb0\x29\x8e\x1a\xe1\xf3\x3a\x84\x12\x09\x6d\x9b\x7e\xce\x44\xec\xc8\x7d\x16\x6a\x1c\x20\x37\x7e\x01\x7d\x1a\x2f\xba\xf4\xb4\x97\x0a\x39\x47\x3f\xc8\x86\x92\x87\x2e\xdb\x4e\x59\xe3\xa4\x40\xd3\xa2\x35\x90\x5a\xe2\x69\x4a\x17\x27\xe1\x99\x17\xa0\x8d\x3f\x94\xb4\x29\x8d\x50\xd4\x0a\xae\x1c\x69\x46\xf5\x8c\xde\x9d\xcc\x91\x5b\x02\xe3\x89\x9b\xde\x34\x14\x4a\x35\x9a\x83\x7a\x39\xa3\xe7\x07\x0e\x39\xd7\xd4\xd2\x47\x18\x0f\xc4\x6a\x1a\x63\x8e\x37\x11\xa0\xcf\x0e\xfa\xd5\xf3\x89\xaf\x2c\x1b\x3f\x08\xbe\x25\x99\xe4\x5d\x95\x32\x18\x73\x92\xc8\x27\x77\xf2\x7b\xda\xa9\xfe\xd0\xad\x0f\x99\xfd\x07\xd9\xfc\xf8\x33\xc9\x29\xb9\x61\x0a\x44\xae\x0f\xa1\xe4\x72\xf8\x33\x4e\xbb\x50\x6f\xa2\x28\x9a\xc0\x43\x9f\x4d\xf6\x88\xc8\xa1\x40\x25\x45\x07\x2a\x67\x20\x66\xe6\xaf\x88\xdc\x52\x50\x8d\x3c\xc6\xe9\x70\xdf\x89\x1c\xf6\xf8\x70\xad\xf7\x68\x21\xd9\xa8\x56\xed\x5e\x4e\x7d\x3d\x57\x65\x7c\x1c\x67\xbf\x82\x26\x69\x99\xe8\x6b\x96\xcd\x23\x01\x1f\x95\xac\x37\x47\x36\x4f\x8a\x22\xeb\xf8\xaa\xd8\x5e\xc7\xd0\x8f\x22\x1e\x65\xe9\xe6\xe7\xae\x10\x4c\x6e\xbe\x4d\xca\x6e\x62\x88\x89\x7c\xe2\xaa\x05\x4c\x2f\x0e\x22\x3d\x6d\xf3\x5e\x0a\xc9\x3b\x8e\x67\x77\xbd\xb2\x27\x12\x91\xea\x2e\xab\x19\xb9\x19\xf1\xf2\x86\xe0\x8c\x37\xc6\x1c\x74\x47\xc6\x47\x6d\x77\xe6\xbe\x3d\x42\x5e\xd1\x35\xab\xd6\x9d\x90\x85\x54\x17\x41\xdf\xee\x40\x4c\xa0\x8d\x11\x68\xd3\xd0\xc1\x1a\xec\x12\x59\x53\x35\x81\x46\x03\xd7\x64\x5e\x2f\x9b\x0b\x0f\xb4\x6b\x7f\xfc\x6f\x82\xad\x24\xe9\xe7\x20\x03\xa9\x16\xbd\x20\xe7\xaa\x07\x7d\x4d\x34\xfb\xf2\x48\x84\x9b\xa2\x3d\xd8\x27\xfb\x6e\x23\xe7\x74\x79\xc1\x19\xfb\x12\x07\xbb\x7e\xb7\x15\xa9\x47\x6f\x40\x6d\xc9\x4d\xa7\xea\xb2\xd7\xab\x62\x45\xd9\xa6\xa6\x24\x51\x55\xf8\x41\xf0\x3d\xe9\x44\xca\x65\xf1\x66\xa3\xf4\x5b\x16\x04\xe4\x8e\x0e\xa7\xb7\xe4\xc7\xba\x5c\x53\x43\x09\xc9\x03\x4f\xbb\x28\x94\x84\x9b\x34\x25\xaf\x40\xe5\x66\x02\x71\x12\x6c\xca\x0a\x6d\x0c\xfa\xa4\x92\x52\x6c\x54\xb7\xc8\xff\xa3\x00\x1f\xea\xb6\xa5\x4a\x03\x7d\x0f\xa3\xc3\x73\x54\xbd\x23\xd7\xa8\x30\x5c\x43\x43\xb7\xeb\x79\x7f\x64\x1f\xbd\x81\xb6\x2d\xe9\x7e\xc1\x7b\xfe\x95\x6d\x59\x6e\xe6\x6e\xd0\x18\x3c\x25\x55\xc7\x5b\x56\xf3\x79\xac\xd9\x8e\xc9\xf5\xb0\xdb\xa5\xec\x1a\x4a\xce\xa1\x3f\x9a\x6a\x5e\x82\x02\xd6\xae\xfb\x0a\x20\x0f\xe9\x25\x4d\xa5\x82\x92\x5c\xcb\xda\x70\x0b\xce\x11\x4a\xce\x07\x63\x68\x25\x99\xa0\xd3\x5e\xc6\x6e\xe4\x92\x1b\xda\x20\x7b\xfb\x61\x16\x73\x01\x19\xa0\x03\xc2\x34\x8f\x13\x14\x45\xca\xe5\x89\x8f\xeb\x72\x76\x65\xbe\x86\x5f\xa4\x9c\x46\x3b\xf4\x1d\x0c\xed\x24\xa5\x25\x88\x8c\x8e\x8f\x40\x1c\x45\x23\x69\xfd\xb1\x7d\x3b\xef\x4c\xb0\xf5\x00\xe2\x09\x4e\x5d\x0d\xf6\x35\x92\xa3\xa2\x1c\xc8\x19\xdf\x81\x61\x2f\xfa\xfc\xa4\x11\x02\xa0\x4d\xa1\x83\xd1\x48\xa7\xe6\x26\xac\x6f\xbb\xe1\xa8\xe4\x7e\x84\x2a\x11\xd2\xb5\x0a\x1e\xc8\x49\x7a\x2d\x15\x9b\x69\x12\x5f\x08\x4d\x72\x24\x4b\x0c\xdd\x64\xb0\xe5\x1b\x0e\xb3\x02\x63\xe0\xc7\x2e\x39\x13\x25\xf0\x76\x5e\x94\x17\xb8\xd6\x6b\x4b\x73\x24\x5c\x74\x4a\x01\x5b\x6d\xf8\xd7\x41\x36\x52\x1c\x0c\x9b\x78\xea\xa6\xd1\x15\x4d\x52\x17\x7a\x49\xd9\xa5\xcf\x18\xa6\x82\xc8\x09\x17\x4a\xec\x07\x10\xa9\xaf\x52\xc8\x96\xa9\x76\x3d\x09\x54\xc7\x9f\x07\x58\xa6\x50\x64\x08\x8d\x8d\x3a\x10\xc7\x48\x24\xf4\x93\xaa\x6f\x6a\xc8\x16\x8a\x99\xbe\xe5\x2d\x0b\x56\x26\x24\x09\xad\x30\x70\x5e\x1d\x88\x42\x9c\x5e\x6a\xd1\x41\x2e\xd2\x0b\x50\x92\x3f\xc3\x5a\x2e\xb3\xb6\x11\x6c\x19\x06\xc9\x23\x83\xb6\x64\x0b\x45\xc2\x31\xa9\x99\x8a\xf8\x76\xac\xc3\xe3\xeb\x5e\x88\x75\x43\xe3\xc0\x84\x25\x8c\xf7\x62\xe9\x3b\x3e\x42\x3f\xce\x92\x8e\xee\x23\x4c\x2a\xa8\xfb\x37\xd9\x93\x76\xf7\x21\xb9\xe2\x90\x31\xaa\xf9\x48\x0f\xdb\x2d\x3f\xd5\x5d\xca\x56\x72\x1f\x81\xe9\xb3\xf4\xe4\x03\x54\x2f\xe1\xbe\xd5\xe3\x60\x0b\xa3\x24\xab\x41\x50\x3e\x7a\x78\xdf\xb7\x9c\x25\x51\xcc\x91\xc2\xf6\xbf\x62\x20\x8a\xd5\x78\xb4\x1c\xa4\x26\xbd\x86\xbd\x1e\x29\xbf\x62\x3c\xa5\xda\x05\x3c\x2c\xd4\x4d\x79\xa1\xed\x61\x9c\xb0\xe6\x4d\x81\xe7\x2d\xb2\xe2\x88\xdc\x50\xc8\x27\xf9\xe4\x03\x5f\xff\xb5\x2b\xba\xf5\x28\xc2\x1c\x24\x26\x1d\x61\x6e\x9a\x8b\x5a\x3f\xab\x38\x9c\xa3\x59\x7e\xea\x16\xed\x21\x24\x59\xa9\xa4\x90\x54\x14\x4c\xcc\x4e\xff\xb9\x3e\xf4\xb1\x18\xe4\x17\xdc\xbd\x95\x08\xb5\x1c\x24\x27\xbd\x96\xac\x59\xe0\xdc\x66\x46\x48\xae\x21\x6e\x61\xfa\xd2\x39\x7a\x7e\xec\x47\xe4\x0e\x76\xf4\x18\xfc\xe6\x13\x6c\x4b\x29\xc8\x45\x09\x8c\xdc\xe3\x6d\x5b\xa5\x61\xab\xc9\x49\x91\x3a\xe6\xce\xc0\x81\x3e\x9a\xf6\xd8\xa3\x62\x19\x0d\x33\x24\x0e\x80\x96\x55\x8b\x07\x8d\xdc\x74\x4c\x1d\xdd\xa9\x73\xe0\xd0\x52\xb5\x6a\x5e\x8d\xac\xa4\xef\x25\xd2\xbc\xdf\xb7\x74\x6f\xca\xa0\x3f\x77\xf0\x5d\xd7\x1e\x1b\x6d\x0c\xf3\x67\x14\x9e\x91\x4d\xee\x21\x2b\xdb\x47\x7a\x7c\xc2\xfe\x01\x38\x93\x1d\xd3\x30\xb9\x15\xc8\x49\x9d\x40\xf7\x5d\xaa\x8e\x72\xf2\x95\xa6\x38\xf6\x76\xcd\xc4\x96\x33\xa1\x19\x81\x9b\x3d\x4d\xd3\x90\x26\x74\x47\x45\x9b\x2a\xd6\xce\x67\xd1\xf2\xc9\x8d\x1c\xce\x20\xed\x0f\xd0\xb2\x57\x14\xeb\xad\xeb\x45\x1f\x48\x4f\x3a\xd2\x07\xcc\xb5\xb9\xa9\xfb\x97\x8f\x65\xb9\x10\x85\x9f\x77\x2c\x9d\xcf\xa2\x17\x87\x73\xf1\xe0\x88\xe7\xbf\x62\x8a\x3d\xc2\x8a\xfc\xbf\x4e\xb0\x14\xa7\x9d\xc2\xc6\x4b\xba\xcb\xc0\xe8\x65\xea\xc0\xb1\x48\x50\x5c\x7e\x6a\x6e\x3a\x56\x18\xff\x66\x2d\x1c\x11\xb2\xdf\xd9\x6a\xd0\x9c\x50\x6b\xe6\x73\x06\x2f\xc8\xdd\x0c\x00\x78\x51\x53\x9d\x27\xaa\xc2\x32\x81\x3c\x6f\x25\x88\x7e\x66\x7c\xd7\x45\x04\xd1\xb5\x87\xa5\x1f\xec\xd1\x08\xe4\x96\x39\x37\x23\xc8\xc8\x2d\x70\xb6\x51\x2c\x3b\xf1\x7a\xed\x45\xb4\x75\x25\xab\xb1\x9d\x21\xf4\xe4\x47\x55\x0c\xa6\x90\xcd\x94\x9e\xe1\x82\x5a\xeb\x00\x9d\x78\x2d\x85\x21\x14\x38\x39\x3c\x11\xc9\x49\x91\x18\x87\x92\xaf\xd0\x6e\x0d\xf2\xe4\x8c\x55\x9a\x07\x8b\xef\xb5\x31\x7c\x3c\x90\x60\x09\x97\x73\x55\xc7\x72\xe9\x8f\xbf\xfe\x89\xfd\xfa\x8f\x20\x74\x69\x79\xc5\xa1\x6e\xe4\x2d\x3d\xe3\x43\xb6\x35\x95\x41\xae\x0d\x21\x09\xe8\x1a\xc8\xf6\x40\xfe\xdf\x1d\xa2\x78\x85\xf4\x96\xa3\x87\xf4\x7c\xc7\x41\xe1\xaa\x0b\x26\x32\x26\x86\x43\xbd\x6e\xd0\x1f\x7a\xa3\xcc\x98\x7e\xdf\x5e\x3d\x93\x19\x2b\xd1\x16\xf2\x65\xe7\xdd\x0e\x6c\xcb\x27\xd7\x5d\xd3\xd2\x59\x7f\xc0\x09\x6c\x12\xdb\xfa\xa7\x22\xf2\x66\xab\xa4\x58\x49\x6e\xd7\x41\xb6\xd2\x29\x34\x36\x4c\x03\x0f\x06\x7e\xc4\x73\xe8\xc3\x2a\xa1\x4f\x4c\x50\x50\x2d\xcb\xf8\xc2\xf1\x07\xcb\xf1\x88\x83\x39\x61\x5e\x41\xdb\xeb\x70\xe6\x09\xb6\xe5\xc9\xa7\xd6\x91\xa5\x54\x93\xa8\x9e\x71\xfa\x1d\xc6\x52\xef\x25\xad\x98\x5e\x5c\x03\xa3\x3d\x14\x09\xa4\xd9\x90\xce\x98\xae\x7b\x14\x90\xfb\x6e\x14\x1a\x38\x46\x2f\xa0\xb6\xf0\x44\x80\x93\x1b\x50\x7f\xf9\xbb\x75\x44\x4d\x1d\xe4\x2b\xc5\xd9\xaa\x6b\xf3\x0c\x4d\x14\x8a\x5c\x8a\x22\x94\xcf\x59\x4a\x03\x84\x97\x31\x79\x04\xd6\x72\x09\x62\x35\xaa\x0b\x07\x69\x4a\xb1\x42\xd0\x8b\x25\x27\xd3\x62\x5a\x31\x33\xa4\x4c\x61\x9d\x14\x0a\x76\xd0\xc2\xf4\x48\xc7\x5e\xb4\x78\xa3\x5f\x70\xf1\xd1\x1a\xc4\xe3\xaa\x5d\xcf\x30\x9e\x38\x06\xaf\x40\x99\x36\xca\x4b\xb5\xcf\xc7\x02\x3f\x0a\xbf\xbd\x20\x18\x88\x62\x9f\x7c\x56\xec\x98\x92\x29\x90\x3b\xf9\x5d\x67\x7a\x6b\x45\xf9\xc8\x56\x8a\x85\xc6\xf7\xb0\x1f\x9f\xb1\x0f\x60\x58\x9a\x78\xa9\x8d\xa1\x7a\x36\xdc\x17\x5a\x01\xb9\x00\xde\xd7\x8d\x3c\x7c\x95\x7f\x9f\x42\xdb\xa5\x6b\xb5\xcf\x90\x97\x14\xc3\x43\xf2\x05\x9a\x6f\x06\x30\xb1\xec\x07\x6e\x77\xda\x1e\x36\x0b\x89\x8f\xc8\xfe\x4d\xd9\xe3\x5b\x6c\xb9\x17\x50\xaf\x09\x9c\x8d\x26\x55\x16\x72\xc5\x52\x53\x7e\x9b\xa2\x8d\xac\x40\x5b\xd8\x8e\x20\x38\xc3\x38\xe5\x1d\x32\x41\xbc\xec\x76\x82\x28\x9e\xd8\x3a\x2e\x03\x69\x49\xef\x5b\xba\x5b\xde\xaa\x6b\xb6\xa3\x23\xdd\x4f\x63\x6e\x54\x97\x34\x9d\xda\xd1\xbe\x92\x62\x4b\x67\xc9\x19\x2b\x98\x0a\x8b\x07\xbe\xf0\x2c\x27\x97\xac\x12\x50\xae\xe3\xd4\x35\x29\xa9\x2c\xc5\xa2\x4b\xf6\x59\xa6\x86\x6b\x0a\x4d\xe1\xee\x10\x2f\x1b\x04\x16\xf9\x42\xd5\x91\x01\x8f\xc1\xa3\x82\x2c\xd6\x9a\xe4\x43\x32\x52\xdd\x63\xbf\xa5\x6a\x74\x13\xe7\xdd\xd3\x93\x91\x85\x6f\x6a\x6d\x0e\xf7\x49\xcd\x81\xc3\x1b\xa9\x46\xe9\x74\x87\xbc\x87\xdd\x11\xa1\xd9\xcb\x12\x4a\x4d\x3a\xf3\xb6\xe8\xeb\xf6\xb4\xeb\xd1\xe4\xe7\x42\xf5\xcf\xea\xd9\x0b\x69\xad\x72\xaa\x67\x7f\x4f\x68\xa7\x64\x0d\x6f\xa8\x0e\x2a\x22\xd7\x23\xef\x58\xb3\x3d\xd2\xab\xbd\xe9\x8a\xb5\x0a\x88\xc8\x3c\xaa\x75\x71\xc8\xfb\xae\x28\xe9\xd4\x7c\x18\x19\x1e\xab\x12\xcd\x61\x9f\x34\xf2\xf5\x10\x24\x4d\x8a\x9e\x8e\x63\x40\xf6\x53\x88\xe4\xc6\x66\x68\xef\x03\x64\x5b\xf3\xc3\x59\x2f\x5c\x47\x16\xd2\x73\xc5\x40\x90\x2b\x65\x00\x11\x5f\xd9\xd6\x04\x80\x69\x31\xd8\xc2\xa7\x84\xb3\x1d\x65\x62\xc8\x82\x69\x6b\x3c\x60\x3c\xe4\x5a\x9d\x7a\x3a\x9a\x67\xdd\x40\xbb\xda\x7d\xc2\x62\x06\x07\x53\xc9\x56\x6c\xb3\xd1\xac\x7f\x1f\x0c\xa8\x39\x2b\xb4\x2d\xb2\x96\x23\x46\x96\x4b\x3e\xd2\xfc\x89\x1d\x55\x7b\xff\x00\xfc\x1b\x54\xbf\xfe\x9d\x0e\x27\x80\xd3\xa6\x65\x82\x19\xc2\x66\xd6\x4a\x75\x52\x61\x12\x07\x39\x47\x47\x82\xdf\xe7\xc5\x8d\xb1\x34\xda\xcc\x35\x8d\xc8\x4e\xa0\xcb\x07\xb7\x51\x18\x5f\x18\x46\xb6\x87\x8f\x32\x6d\xda\x23\x24\x3f\xb0\x96\xc0\x80\x83\xdc\xa3\x98\x3c\x8e\x48\xf4\x5f\x64\x37\x4f\x1d\x21\x1c\x3d\x72\x26\xe7\x8e\x2b\xb6\x1c\x2b\x20\x6f\x9b\xac\x3c\xf4\xec\x37\x54\x65\x90\x53\x35\x0a\x9c\xac\x80\x55\x44\xde\x51\x1d\xde\xce\xd3\xbe\x8b\xca\xda\xa3\x9e\xf7\x8d\xdc\xdf\x98\xa4\x72\x87\xf8\x56\xf2\xc5\xc8\x51\xe4\x06\xfe\x6b\xcb\x8f\x75\x0e\x59\x48\xbc\xa8\xab\xb4\x9a\x91\x83\xf4\x92\x81\xa0\x4b\x86\xa9\xa5\x8e\x4c\x6e\x28\xa6\x22\xef\x08\x20\xd3\x71\x43\x9f\xbc\xdd\x51\x45\x51\xc6\xe3\xc5\x1c\x3a\x6b\x77\x3f\xfe\x5e\xc8\x9d\x29\xf9\xaf\x58\xaa\x41\x56\x52\x23\x35\xfe\x1e\x54\xad\x97\x69\x44\x07\xe6\xc3\x49\x4b\xfc\x2c\xf2\x7f\x6b\x2c\xdd\x8a\x02\xc7\x23\xa3\x34\xfc\x84\x1d\x88\x1c\x3b\xd4\x87\xa2\x55\x52\x4f\x58\xac\xf7\x1c\x68\x86\x52\x26\x60\x29\xaa\x7e\xb0\xd4\xd6\x48\xab\x47\x41\x32\x9c\x56\x04\xfe\x99\xdb\x18\x93\xaf\xf0\x7d\xbf\xac\x50\x2d\x25\x83\x5b\x8c\xf7\xd1\x0b\xaf\x83\x94\x43\xae\xd2\xb1\xd2\x7d\x01\x4a\x49\x5d\x6e\xfa\x83\x94\x7a\xfe\x3e\xcb\xb4\x31\x0a\x13\xa8\x68\xca\xa5\x79\xc7\x63\x8f\x5c\xca\xb9\xf5\x37\x9d\x57\x2f\x8e\xad\xd7\xbe\x67\x61\xac\xc5\x14\xe4\xeb\xf1\xfd\x4c\xbc\xa5\xea\x79\x99\x60\x08\xfb\x47\x96\xad\xad\xa9\x13\x44\xd1\x73\xd2\x7e\xdb\xc0\xab\x8e\xb4\x27\x3e\x30\x10\xdf\xd9\x4a\xa1\x17\xd2\x96\x7e\x80\xec\x5b\x47\x39\x13\x94\xdc\x41\xc5\x46\xfd\xb4\xc5\xc0\xfa\xa3\xd2\xf6\x28\x4e\x78\xc7\x27\xb1\x56\xc7\xb7\x1d\x72\x49\x85\x60\xcd\x81\x36\xcb\x3d\xcb\xe5\xb6\xab\x6a\x2d\x18\xbf\x4e\xe6\xa9\x39\x4c\x29\x6b\x4b\x72\x96\x43\x65\xda\xb6\xbd\x61\x5f\xd9\xc2\x60\x8b\xe0\xa5\x1e\xa8\xe3\x44\x78\x1a\x71\x02\x7f\x7a\xf3\x62\xcb\x25\x16\x16\x4c\x7f\x5f\xd1\x1f\xff\xf1\xd5\x2a\xb5\x52\x17\xb9\x4a\x1f\x64\x9e\x93\x3b\xb6\x33\x88\xbc\x51\x9e\x65\x92\x01\x6d\x15\x7e\x16\xa5\xc9\x5e\xca\xb6\xa4\x8b\xbe\xad\x4d\x6e\xa4\x66\xa7\x9e\xf4\x67\xdc\x20\xc0\x70\xf9\x41\x72\xaa\x6f\xd7\x6a\xee\xd1\x45\xe2\xd2\xdb\x92\x71\xce\xea\x67\xca\xe3\x37\x94\x1a\x8c\x55\x3d\x2a\x8f\x47\x59\xf2\x7d\x51\xd7\xf1\xc8\xb5\x44\x66\xad\x29\x9d\x8e\x6d\x4d\x15\x36\xfc\x79\x90\x53\x5e\x97\x3a\x4d\x5f\x71\x79\xce\xc4\xda\x34\xc9\xc2\x3d\x87\x49\xa0\x2e\x5c\x94\x27\x99\x51\x18\xc8\xa4\x68\x21\x9b\x27\xa5\x03\xdb\x75\xc8\x39\xe4\x14\xba\x43\x9d\xb8\x16\x14\x6b\x1b\x5c\xe3\xe9\x07\x7e\x5c\xcb\x5d\x30\xaf\x2c\xf7\xee\x6c\xc4\x49\x4c\xa2\xf1\x11\x4d\x2a\xc8\x94\x1c\x62\x33\x58\x3c\xdf\xc4\xa4\x6c\xe3\x8c\xa0\xe3\x3b\xfe\x6b\x33\x8d\x70\x3f\x78\x4e\x72\xf9\xea\xd5\x5b\x4e\xee\x28\x5b\xeb\x15\x70\x91\xd9\x54\xc3\xc9\x96\xcc\x76\xbf\xc0\x96\xb5\x5a\xdd\x33\x1b\x99\xed\xa2\xcd\x73\xa5\x0c\xcf\x84\x37\xd3\xd3\x1d\x7a\xc1\xa4\x6d\x72\x01\x29\x35\xea\x26\x5f\x58\x4e\xd7\x5b\x1f\xf2\x9a\x82\x92\x62\x21\xb6\xfe\x00\xc5\x48\x37\x6e\xc4\xd6\xa3\x22\xa1\xe9\xdc\x18\xb4\x22\x8f\x3c\x28\x10\x4d\x2d\x55\x7b\x58\xd2\xfa\xa5\xeb\x41\x14\x45\xb7\x52\x85\xc1\x45\x56\xd3\xb7\xa0\x38\xc1\x9a\x9d\x78\x29\x46\x4e\x1b\x6d\x8e\xca\xc4\x76\xdc\xd7\x8a\x16\x8b\xb9\xa6\x90\x9c\x83\x6a\x29\x3f\x4e\x3b\x7e\x29\x1b\xf2\x73\x47\x53\x05\x39\xac\x96\x06\xb9\xd6\xcc\xa9\xbe\x2c\x0a\x4d\x57\x6f\xe2\x93\x8c\x58\x92\x81\x2a\x64\x26\x39\xa7\x59\xcb\x76\x74\x9e\xa4\xb6\x2d\x72\x96\x6d\xa9\x9a\xeb\xc8\x4e\x1c\x5a\xaf\x9d\x00\xe3\xe7\x6b\x28\x24\xa0\xf8\x0e\x4f\xbb\x0d\x65\x0a\xd6\x3b\xa3\xd1\xc4\x94\xd0\x90\x3b\x6a\x48\x41\x0d\xa2\x13\xff\x7b\xa3\x06\x6b\xf4\x98\x64\xb2\x6a\xa8\x78\x9a\x47\xe0\xc9\xb0\x3f\x43\x1c\x79\x64\x7a\x46\xa5\x48\xc2\xb8\x5a\xc0\xe2\x22\xdb\x29\xaa\x3a\x2d\x50\x4b\xf7\x5b\x66\x26\xb8\x94\x81\x2d\x45\xdb\x25\x9c\x33\x70\x1c\xf2\x96\x33\xd9\x1e\xe2\x8a\x6f\x55\x97\x95\x82\x69\x8a\x9e\x93\x73\x3c\xb9\xb6\xb5\x44\x1a\x2c\xcb\x28\x67\x3b\x18\x95\x78\x16\x85\x14\x9e\x94\x2c\xed\xe6\x59\xdd\x20\x20\x1f\xa8\xd6\x23\x3a\x76\xf5\xbe\x76\xbc\x1b\x02\xd7\x75\xe8\xfd\x5c\x24\x39\x1d\xd5\xe6\x81\x9c\xc3\x76\x1c\x9f\xe9\x67\x48\x67\x96\x0e\xe6\xa8\x4a\x2a\xe8\x65\xc6\x99\x98\xe7\x09\xed\x78\x94\xd8\x3c\xd0\x68\xf8\xf1\x7f\x96\x1d\xac\xb4\x2a\x67\x51\xfb\x5a\x68\x87\xce\x72\xca\x8d\x91\x0d\x8d\x44\xd2\xc8\x72\xda\x2e\xd7\x76\xc8\x5b\x64\x1a\x3b\x60\x10\xba\x00\xc5\x72\xfd\xe4\xad\xd4\x2b\x74\x6d\x77\x6c\x55\x7f\x56\x2d\x7b\x1a\x0b\x29\xc6\x3d\x82\x1c\x8c\x91\x4c\x52\x50\x82\x0e\x39\x9c\x90\x29\x9f\x5c\x63\x18\xc5\x0b\xd9\xbf\x17\x34\x8c\x90\xc3\x76\x3d\x6c\x99\x6b\x7b\x8b\xe6\xb5\x96\xc8\x7b\x35\x4b\xbd\x4e\x39\x41\xa6\x55\xf2\xa2\x3a\xc9\xd2\x86\xcb\x0c\xf8\x4c\xcc\x30\x38\x90\x0b\x25\xf7\x5c\xd7\x4d\x74\xbe\xe3\x5b\x1e\x66\x3b\xe7\x54\x15\x9a\x11\xe6\xf4\x0a\xd1\x2e\xd2\x9c\x4e\x22\x14\xf3\xea\x4c\xff\x7a\x1c\x8d\xdf\x9a\xc5\x7d\x4b\x4a\xb9\x6f\xda\x6e\xb3\xc1\xce\xf6\x0c\x10\xf4\xe2\x83\x09\xae\x63\xf8\xce\x7f\xc5\x20\x67\x6b\xc5\x28\xc8\x82\xfa\x41\x0a\x68\x4b\x10\x4b\x60\xd6\x2f\xb2\x9b\x04\xd8\x0c\x34\x2b\x52\xbf\xf9\x8a\x87\x1e\xb9\xdf\x03\xa2\xa9\x17\xc5\x06\xcf\x77\x3c\xac\x68\xfe\x7f\x04\xc6\xe4\x22\x37\xea\x9d\x4c\xd9\xb2\xcd\xb8\x98\x37\x51\xba\xd3\x18\x35\xc9\xa6\xcd\xc6\x1e\x82\xeb\xf8\xe4\x13\xdd\x93\x0b\x68\x5a\x7e\x28\xa0\x87\x52\xf4\xe7\x74\xf8\xee\xb9\x24\xf7\xba\xf2\x70\xfa\x3e\xbe\x8b\xbc\xa8\xa3\xfc\xc6\x33\x4d\xfa\xeb\x51\x53\xa4\x9c\x24\xe9\xa3\x36\xb1\x03\x77\x9e\x37\xd1\x5a\x97\xf3\x34\xb6\xe7\x84\xf1\xac\x6a\x7e\x4d\xc9\x47\x45\x2b\xce\xc4\xeb\x73\x96\xfd\xf8\x9f\x5b\x7d\xbb\x4f\xcf\x1a\xe5\xda\x13\x8b\x19\x25\x9f\x39\xdb\x19\x6c\x13\xdb\x2d\xe6\x19\xf6\x12\x3f\x88\xba\xb1\xa3\x20\xb8\x3e\xa3\xb6\x15\x8e\xca\xcd\xc7\xe8\x95\x65\x9e\x2a\x5d\x8a\x38\x97\x8d\x60\x40\x60\x48\x29\xa9\x7a\xa2\x85\xdc\x9d\xfa\x5e\x6a\xc2\x54\xcd\x65\xae\xd3\x5a\xed\xf6\x0d\x96\x50\xe7\xb4\xd1\x6e\x79\x4e\x9d\x40\x43\x3f\x71\x16\xe5\x88\xaa\x2a\x34\x30\xe4\x0c\x15\xe8\xb9\xc3\xf7\x52\xe4\x9d\x82\xd3\x5e\x40\xe7\x65\xf4\x62\x10\xe4\x33\xe1\x52\x3a\x98\xa2\xfd\x10\x6a\x7e\xeb\x68\xd3\xce\x08\x1a\x17\x7b\x99\x69\x7f\x94\x40\x8a\xc3\xe3\x7a\x9c\xc4\xae\x33\x96\x58\x16\x24\x2a\x5f\x98\xc1\xe8\xd6\x9a\x42\x25\xfa\x9e\xf0\x3e\x93\xf3\x90\x97\x1d\xd8\x2e\xb9\x6f\xb1\x8c\x86\x63\xf5\xcf\x91\xd6\x57\x1d\x88\xa2\x84\x75\xa0\x26\x2e\x12\xa5\x6a\x8e\x1b\xca\xd0\xdf\x2d\x28\x6e\x28\xcb\xa3\x3e\x79\x84\x1a\xc4\xf0\x87\x8d\x4d\x71\x37\xb6\xc9\x19\x16\xa2\xe5\x21\x35\xf6\x7b\x25\xb3\xf2\xd7\xff\x75\x47\x1e\x7e\xfc\x5f\x82\xa2\x48\xd1\x7a\xdd\x1f\xd7\x90\xa5\xb6\x52\x30\x49\x34\x77\x80\x09\x60\xbe\xcf\x08\x72\x00\xfd\x41\xf4\xf4\x4c\x4d\x35\x76\x3d\xf2\x20\xf7\x82\x1e\x19\xad\xff\xc0\x3a\xbe\x16\x36\xde\xd5\x1c\xa9\x07\xaa\xc5\x57\xac\x80\xa9\xa6\x3e\xe9\x16\xc7\x56\xd2\x43\x39\x73\x21\xd8\x11\xb9\x94\x02\x78\x3e\x1d\xc4\x20\x70\xf4\xf0\x2b\x32\x61\x52\x4a\x6e\x58\xd3\xb0\x95\xa1\x0d\x2e\x92\xa6\x4e\x82\xb1\x8b\xad\x9b\xf8\x03\x1e\xcd\xb6\xc5\x76\x42\x69\xbd\x18\x5e\xf6\x7d\x72\x4e\x39\x2f\x94\xdc\xd1\xc3\xf4\xe7\x86\xe6\x54\xf4\x59\xa9\x43\x94\xd3\xd3\x4a\xb9\x48\xa1\x8a\x39\x10\x08\x46\x71\x62\x43\xf7\x86\x1f\xf6\xa3\x58\x4c\xb3\x45\x63\xec\x24\x19\x54\x6f\x20\x1b\x7b\xae\xe4\x92\x72\xd8\x83\x3a\x42\x0b\x7b\xcd\x1e\xd9\x4a\x49\x1d\xd2\xa8\x1a\x8a\xc7\x09\x86\xf2\x36\x2f\xa8\x49\xe9\x5a\xc4\xa1\xc4\xae\x11\xce\x7a\x93\x8d\x6d\xb9\xd0\xb2\x43\x9f\x7c\x64\x35\x82\xe2\x8f\xca\xee\xd5\x52\x14\xba\x6d\xb9\x92\xc7\x5f\x92\x8e\x8c\xf2\x6d\x33\xc4\x06\x50\xbd\x2d\xf6\x92\xe6\x65\x23\x55\x43\xbb\x96\x6b\xf2\xc2\xc0\x7a\xed\xd9\x98\xf7\xdc\xb1\x94\x32\xf5\xe3\x3f\xac\xd6\x6f\x74\x35\x93\xaa\x66\xa5\x9b\xc6\x4d\xa6\x3e\x88\xc2\x79\x93\xd8\x3f\x0e\x8d\xf7\xc3\x80\x5c\xd2\xef\x2d\x2a\x39\x9b\xa2\x50\x10\x7a\xbe\x37\x87\x92\x37\x9d\xc8\x25\x6f\x4a\xca\xaa\x95\x42\x48\x64\x54\x7d\x5b\x31\xde\x93\x8f\xfa\x4b\xcc\x2a\xb8\x74\xcb\xaa\x38\x48\xec\x20\x8a\xa6\xbe\x9c\x1b\x47\x53\x9e\x7a\x44\xa9\x08\x04\xb9\x61\x45\xa7\x59\x81\xd6\xaa\x33\x20\x99\xea\x1d\x64\x25\xe5\xe4\x5d\xc7\xf9\x18\x81\x18\xf1\xf3\x0d\x9a\xe2\x30\x69\xfa\x0a\x44\x4b\x67\x7a\x44\xcf\xb2\xb0\xdc\x32\xbc\x08\x07\xcd\xd4\x9b\x6e\xc4\x98\xae\xb6\x2e\x1d\x85\x34\x65\x07\x33\xad\xc3\x1d\x05\x9e\x19\xc1\xf3\x47\xe4\x74\x88\xa3\x79\x72\x39\xc4\xe9\x0c\x6c\x08\x1c\x60\xa0\xee\xa4\x28\xd6\x7b\x9e\x91\x41\x75\x44\x29\x7c\xde\x9b\x7e\xc0\x3b\xb6\xa3\x19\xef\x10\xd6\x99\xc9\xc1\x1a\xc7\x08\xcc\x33\x14\xed\xd3\xfb\xec\xc6\xe4\x02\xa1\x5d\xd7\x4c\x1c\x25\x51\xbd\x87\x1a\x14\xdd\x61\x54\xdd\x1b\x6a\xcb\xd3\xeb\xaa\xb8\xc8\xa6\x8a\xcd\xe2\xc5\xe3\x3c\xab\x8f\xb4\xe3\xeb\x0c\x49\x53\x33\x5a\x50\x6e\x64\xa6\x63\xf2\x50\x32\xaa\x0c\xe4\xda\x40\xf5\x7c\x3f\xb6\x70\x10\xea\x63\x07\x1c\xc8\x75\x57\xd5\x9a\xd5\xf2\x06\x38\xf4\xa7\x76\xfa\x48\xaa\x6a\x06\x61\xdf\x31\x6a\xc6\x45\xcf\x18\x37\x17\xa7\x41\x4a\xa2\x26\x4e\x93\x0a\xd4\x96\xb6\x7b\x68\xb3\x72\xae\x54\xba\x64\x38\x74\x52\x6e\x0f\x75\x63\x28\x42\xb2\x9b\x96\x15\x6b\x4e\x30\xbb\x33\xc3\xea\x35\xdd\xeb\x94\x73\x1a\x35\x6c\xf8\x60\x8a\x33\x3d\x4b\x3f\xd3\xd3\xdd\xd7\xb2\x3c\x32\xaa\x71\x0b\x39\x88\xa2\x61\x39\xad\xea\x6e\xcd\xee\x06\xd2\xac\x5e\x51\xa9\x0a\xfa\xac\x77\x8a\xec\x30\xa3\x8a\x67\x31\x76\x4f\xe3\xfc\x80\x88\xc9\x8b\x7c\xcf\x1b\x1e\x79\x25\xf7\xcd\x11\xa1\xa9\x92\xd6\xb4\x65\xbb\xd5\xf8\x48\x5d\x77\x52\x8d\x59\x68\x68\xcd\xe1\xb0\x91\xd0\x8a\x69\xd2\x35\x30\x16\x0b\xe2\xb9\xbf\x78\x98\xc5\x5c\x43\x55\x97\x9d\xee\xc1\xae\x40\x0e\xe0\x22\xc1\xaa\xe1\xbc\x34\x9c\xe7\x9f\x7b\x69\x70\xbe\x99\xe4\x34\xde\x24\x0a\xaa\x94\x53\x65\x60\xcb\xa1\x47\xce\x95\xdc\x8b\x63\x23\xcb\x1f\x59\xa5\xd9\x24\x57\x80\x27\x20\xbb\xea\x39\xa8\x74\xb8\x53\xa8\x57\x86\x6f\x0f\x15\x2d\xd3\x54\x4b\x69\x31\x18\xe3\x02\x5d\xfe\x82\xe7\x80\x2a\x75\x2c\xdc\x78\xa7\x58\x03\x66\x3a\xec\xba\xfb\x4e\xab\x54\x76\xaa\x38\xed\x92\x9c\x91\x30\x76\xd6\xf1\xf9\x49\x08\xa9\x6b\x03\x5a\xc5\x27\x2e\x93\x54\xca\xed\x02\x28\x13\x93\x73\xca\xf8\xa6\x6b\x9a\xc3\x02\x2a\xad\x6a\xb9\x1e\x5e\xcb\xf5\x96\x8c\xed\xb3\x78\xc5\x73\x86\x4e\xa3\xd9\x16\xb3\x65\xdd\xd4\x5e\x90\xab\x1e\x20\xd1\xba\x2d\x54\xf0\xd8\x91\x8f\x2c\x5f\x11\x8d\xe6\x22\xad\x2a\x2a\x23\xdc\xc0\xa2\x20\xf0\x24\xf5\x12\x54\x35\x58\xe3\xc7\xa4\x46\xa1\x70\x39\x4b\x65\x79\xa1\x1b\x90\x4b\xb6\x63\x43\xce\x7f\x34\x09\xbb\xfe\xeb\x1f\xff\x54\xc8\x5d\xb3\x65\xab\x5d\x2f\x5f\xd7\xf3\x87\xbf\x6d\xe6\x80\xb9\xa4\xbb\x7a\xcc\xee\x95\xa6\x80\x89\xb7\x49\x26\x33\xc9\x65\xf1\x5a\xb0\x4d\xdb\xcf\xca\xbf\x73\x87\xf8\x60\x0b\xaf\x4c\x53\x6a\xf5\xd1\x00\xd7\x0b\x0c\xeb\x01\x9d\x24\x64\x9f\x2b\x77\xa0\x8a\x6c\xcc\x13\x91\xc2\xac\x14\xe3\x84\xff\x2f\xa2\xbf\xd7\x94\x6b\x00\xc3\x4a\xc7\x72\x84\x8e\x68\x1e\xc8\xe5\xc3\x7c\xd3\x4f\x13\xa2\xdb\xe9\x59\xae\x12\x41\xdb\xc1\x0e\xf9\x8e\xaa\x96\x35\x83\x6f\x31\x68\x7a\x3b\x20\xd7\xd0\x29\xca\x5f\xb2\xbc\x7f\x60\xab\xcd\xea\xb9\x48\xb2\x7a\xa1\x80\x15\xf3\xc5\x5b\x74\x9a\x32\x7d\xef\xc4\x0b\x4a\x29\x2b\x8a\xc8\x39\x34\xcf\x24\x56\x2d\xdb\x41\x19\xe3\xcf\x0d\xc7\x17\x70\x85\x8e\x28\x52\xac\xce\xbb\x35\x92\xc0\x5c\xc2\xd3\x13\xa7\x73\xbb\x77\xbb\xa7\x69\x1a\xcb\xa3\xc3\x87\x76\x64\x78\x96\x3e\xc3\xf6\x08\x2d\xd1\x17\x10\x4c\xc8\x95\xdc\x88\x3f\xa9\xc7\x5c\x74\x62\x88\x21\x0c\x19\xff\x35\xdb\xd1\xd6\x64\x9a\x2a\x9b\x3e\x8a\x6b\xa4\x45\x7c\x93\x61\x15\x3b\xb2\x02\xf2\x93\x98\xa8\x47\xe0\x18\xe3\xea\x39\x6b\x59\xa6\x89\x2c\xbf\x75\xd0\x4a\xc5\x80\x93\xab\x8e\x09\x7a\xe2\x85\xda\x73\x45\x6e\x92\x35\xd6\x4c\xb9\x63\x24\xdc\xa2\xb4\x71\xfc\x2d\xa9\xa9\x78\xa3\xe9\xcf\x22\x27\x26\xb7\x0a\x98\x62\x14\x7b\x4b\x87\xbd\xb3\xab\x4e\x7e\x5f\x4d\x7d\xcf\x45\x92\x55\xad\x3d\x35\x2a\x83\xa1\x77\xec\x74\x2d\x2e\xcf\xa4\xdc\xc6\x2a\x69\x65\xcd\x59\xd3\x1a\xfc\x92\xe3\xdb\x1e\xf9\x89\xd3\x21\x97\x7e\x39\xc0\xfc\xbe\x93\x59\x49\x9f\xd6\xe2\x36\x73\x91\x4f\x55\x8f\x17\x7d\x05\x3e\xf6\x93\xa4\x34\x99\xd8\xe3\x1e\x8d\x71\xf3\x5b\x80\x5d\xdb\x45\x35\x0f\x26\xb3\x23\x0a\x75\x9f\x40\xed\x20\x5b\x95\x30\xd6\x45\x5a\xd5\x1b\x50\x05\x28\xda\x12\xc3\x8f\xdb\x4c\xa1\x71\xa5\xab\x4e\x55\x65\x3e\x88\xdb\xdf\x9a\xbe\x09\xdc\x20\x0a\xc8\x35\x55\xf2\x70\x5c\xf6\x17\x29\x8a\x47\xb6\xce\xcc\x9b\xeb\x6b\xa1\x7e\xc1\xe8\xcc\x42\xc9\xb4\xb2\x1b\x64\xaa\x7b\x8a\xbb\x24\x6b\x2a\x29\x86\x58\x62\x2a\x9f\xba\x2e\xb9\xa5\x08\x31\x39\x14\x5e\xbd\x55\xb2\x05\x65\xc0\x9e\x7d\xad\xba\x13\xef\x58\x80\xd5\x69\x94\x45\x5c\xce\x85\x8d\x8c\x29\xca\xcc\x84\xc5\xd3\x90\xb6\x76\x2e\x2e\xb9\x28\x41\x0d\x69\x19\x42\x5f\x8e\xf8\xc6\xcf\xbf\xfe\x0f\x42\x92\xeb\x2e\xed\x9a\x2d\x5b\x49\x17\xd2\x45\x7e\x55\x64\x7e\x24\xef\xe5\x7e\x44\x57\x4b\x0d\x07\x78\x2c\xd1\x14\xef\x93\xa6\x04\x45\xa1\x81\x19\xa4\x15\xc7\x81\x47\xae\xe5\x10\x43\xe5\x98\xb3\x1d\x25\x81\x5c\xaf\xe8\x88\x04\xab\xe3\x6c\xc3\x25\x03\xdc\xac\x51\x08\xa8\xce\x19\x3c\xc5\xdf\x93\x7a\x59\xa7\x0a\x42\x77\x26\x36\x3b\xe4\x8c\xed\x1e\x99\x28\x36\x6b\xdd\xaa\x78\x8e\xf7\xa7\xae\xcb\x03\x14\x1b\x4a\x31\x28\x56\xba\xed\xd2\x3f\xe3\xde\x88\x5d\xac\xdf\x8b\xe1\xe8\x1e\x08\x01\xfd\x02\x29\x97\x62\xd5\x24\x06\x09\x55\xaf\x51\x99\x4f\xbc\x08\x40\x7e\x91\xdd\xb8\x34\xbe\x88\x3f\x9e\x92\x94\xaa\x2d\xe5\xb4\x1f\x7b\xd2\x8e\x4d\x7e\x5f\x97\x50\x1d\x8e\x67\x94\x20\xca\x95\xa0\xaa\xc8\xa9\x6a\x48\xa6\xe7\x31\xd9\x0f\x20\x7a\x3d\x76\xa2\x87\x64\xc1\x7a\x49\x7f\xeb\xc6\x7a\xce\xa8\x61\x39\x3d\xce\xf7\xc5\x1a\xde\xc3\x76\xd8\x35\xc3\xb0\xb7\xc6\xb6\x39\x0b\x6a\xf0\xa9\x5c\xba\x9c\x93\x6d\xb1\x62\x0a\x76\xb2\x67\x5b\x86\x14\x9d\xf3\x12\x9d\x38\x20\xb7\x20\xb6\x0a\xda\xa7\x05\xee\xca\x0d\x2d\x53\xe7\xa1\x8d\x58\x79\x2c\x2a\x58\x44\x21\x63\xef\xe5\x79\xac\xa8\xbb\x2f\xe0\x1c\x53\x94\x8d\x03\xcb\x26\xd7\xb0\xa5\x98\x7e\x5e\x81\xca\xa9\x68\x8e\x30\xd2\xb1\xae\x05\x51\xb4\x6b\xe9\xeb\xb8\xc1\x3c\x59\x73\xd9\x09\x13\x17\x2d\x5b\x4c\x59\x8e\x66\x70\x13\xbb\x13\xb9\x6d\xba\x15\x96\x4d\x2e\x40\x80\x3a\x64\xb5\xbc\xef\xaa\x94\xaa\x0a\x44\x51\xb0\x15\x13\x6c\x24\x58\x35\xf4\x16\x33\x7d\xec\x3b\xce\x6a\x93\x81\x56\x9a\x3f\x16\xbc\x63\xb4\x9d\x81\x1b\x84\x64\x08\x5f\x86\x6b\x08\xcf\x4a\x91\x5e\xe0\x5b\xaf\x3d\x7f\x84\x58\xef\x80\x97\x46\xf1\x78\x8d\x76\x75\xb0\xd0\xb9\x3b\xef\x5a\x13\x95\xbc\x1b\x71\x72\x29\x9a\xc0\x4f\xf6\x94\xa6\x73\x49\xdf\xb5\x2d\x72\x23\x1b\x26\x28\x3d\x92\x8b\xae\x5f\xd1\x47\xc6\x55\x8d\xbe\xc2\xc7\x0e\xf7\x6e\x29\x21\xf9\xa8\xb4\x1d\x82\x04\x54\x56\xb2\x1d\x9d\xc8\xb3\x96\x3a\xf0\x87\x6b\xfb\x43\xbf\x95\xbb\xb5\xf2\x6c\xe4\x5b\xfd\xc4\xb2\x52\x72\x68\x9e\x51\xae\x8e\xd3\xa4\xad\x54\xb8\x41\x62\x64\x5e\x85\x30\x41\x65\x93\x49\xfd\xce\x0a\xc9\x7d\x26\x7f\x5b\xfb\x63\x2d\xc6\x1f\x17\xe9\x56\xf5\x08\xdb\x47\xc1\x8a\x52\xcf\x2f\x3c\x43\xa6\xc2\x16\x3f\x80\xe8\xa5\xc8\x93\x8f\xb8\xd4\x21\xd9\xe3\x53\xfb\xc2\xb5\x2c\xd7\x00\x27\x08\x62\x43\x3e\xfd\xf8\x5f\x0c\x33\xf9\x0a\xa8\x09\xe4\x5d\xbd\x93\x3d\x79\xcb\x39\x93\x7a\x38\x43\x0f\x91\x1a\x51\x64\x45\xf5\x07\x10\x2f\x65\x9e\x62\xc7\x8a\xc8\x3b\xa9\x90\x05\xf2\x65\xe8\x45\x55\xc5\x9a\xf2\xaf\x7f\xfc\xd3\x4a\xc7\x11\xb9\x55\xcf\xaa\xc1\x85\xeb\x1f\xf9\x44\xd5\x39\xa4\x2a\x00\xc9\x6e\x6a\x97\x05\xe4\x06\x54\x8f\xac\x88\x2f\x57\x21\xbb\xbc\x13\x8f\x6c\xa5\x18\x0b\x69\x55\x8d\x00\xd7\x7b\x33\xba\xf5\x69\x9c\x47\x83\x12\x54\x0b\x69\xd2\x09\xda\x64\x72\xf4\x10\xc1\x10\x4c\x0c\x09\xd9\x81\x73\x38\xfb\xd6\xc2\xaf\x7f\xf7\x6a\x35\xf2\x4a\x37\x9c\x08\xce\xc4\xdc\x2d\x3b\xef\x9e\x9e\x30\xf1\x7a\x85\x63\x40\x83\x19\xb2\xa4\x29\xb5\x08\xd0\xc2\xa9\xbb\xa1\x65\x91\x87\x12\xb2\x2d\x55\xd0\x1f\x86\xc2\x50\xac\x04\x84\x0b\x97\xd3\x32\x5f\x29\xec\x46\x09\xbf\x8e\x8e\x7d\x98\x6c\x8f\x66\xc8\x93\xae\x11\x74\xbf\x64\x1b\x9d\x1a\x9b\x07\xc9\xd8\x43\x27\xcc\xd3\x7b\x62\x36\x5f\x57\xb3\xa7\xb2\x06\xa6\x5a\xf0\x07\xda\x8e\x7c\x81\x7c\x4f\xd3\x14\x68\xd2\xcb\xae\xed\xde\xe8\x28\xca\x23\x6f\xab\x8a\x1e\x32\xc2\x7c\x05\xb6\x85\x2a\x65\x7c\xc5\x61\x26\x4d\x99\x8a\x72\xef\x5f\xd9\xa8\x6c\x3c\xf2\x4a\x35\x7b\x34\xc1\xe6\x70\x46\xd7\x0b\x63\x72\x01\x8a\xf6\x8b\xab\x74\x79\x6d\x23\x6b\xc8\xd7\x92\xb5\x63\x1d\xc1\x84\xf0\x1f\x99\x28\x72\x59\x9d\x76\x69\xe1\x44\xee\x78\x01\xaa\x9d\x94\xb6\x52\x0d\xe7\xdb\x66\x68\x84\x22\x11\xa0\x64\x6e\x9a\xe9\xae\x8f\x7d\xa3\x23\x17\x48\xb4\x30\x38\x4b\x1c\xc8\xbd\x90\xf5\x8f\xbf\xc1\x1f\xd5\x2a\x93\x04\xe1\x38\x8d\xbb\x25\xd7\x30\x4e\x1e\x3f\x80\xe6\x7b\xd9\x70\x34\x41\x79\x00\x52\xb7\xad\x05\x7b\xef\x41\x36\xf2\x00\x4a\xc1\x46\xdf\xa9\x0b\xa8\x29\xf9\x42\x55\x7e\xe2\x87\x36\x9e\x08\xce\xcf\xa9\x10\xd3\x1c\xe4\xc8\xde\xfb\x98\x6a\x2b\xb0\x97\xda\x4c\x96\xef\x06\xe4\xf7\x2d\x94\xb3\xa3\x88\x9c\x88\x68\x0c\xf7\xdb\xbc\x49\x7b\x4d\xd9\x77\x7a\xfe\x90\x48\x93\xb6\xe7\xac\x2d\x27\xb5\x87\xaf\x6c\x52\xb2\x40\xc1\x07\x78\x7c\x2e\x37\x68\xd9\x71\x64\x93\xb7\xdf\xeb\xe1\xef\x39\x12\xe9\x5d\x4b\x51\x3c\x95\xab\xd5\xd5\x90\x35\xd5\x2c\xe9\x1c\x46\x19\xe0\x85\xbc\xcf\x63\x8a\x56\xd8\x2e\x48\x2b\x31\xd4\x0b\xc9\x7d\x4d\x87\xaf\x79\x8c\x92\xee\xe7\xce\x00\xd0\x57\x58\x91\x33\x62\xbf\x86\xef\x97\x99\x44\x8a\x43\x53\x8e\x73\x80\x4d\xa3\x3f\x00\x7e\x20\x0b\x16\x5b\xe4\x5c\x0a\x31\x2c\xea\x25\x5d\xdb\x5b\x4e\x2e\x54\x97\x19\x6d\x8e\x4f\x2c\x03\x05\x45\x77\xe2\xb5\xb9\x18\xdd\x75\x0a\xc8\x2d\x9d\x99\x86\xc6\x49\x4e\x5e\x0f\x46\xa8\x92\xe6\x5b\x07\x8a\x3e\x53\x53\xf1\x83\xc0\x21\x37\x52\x62\x2f\x06\xf7\xca\xf5\x2c\xeb\xb5\x65\xe1\x60\xe3\x15\xec\x98\x28\x65\x33\x38\xc4\x73\x60\xdf\xd7\xc3\x6d\x6b\x02\x55\xc8\xb6\x3a\x70\xc2\xbb\xf2\x07\x29\xab\x27\xa9\xff\xfb\x11\x03\x27\x0a\x22\xe1\xcf\x45\x55\x7c\x2f\x76\xc8\x3b\x60\x8a\x7c\x86\xed\x91\x0a\xcd\xfd\x8f\xbf\xa9\x46\x71\xea\xe1\x8b\x0c\x37\xf8\xc4\xbb\xe7\xcf\xdc\xa3\xca\xb8\x8f\x67\x04\x36\x65\x8d\x1e\x44\x26\x5d\xc6\x67\xe1\x63\x7b\x08\x88\x53\xa9\xda\x63\x35\xf9\x6b\xe0\x5d\xad\x1f\x0d\x14\xc1\x3c\x71\xc8\x11\x05\x33\x81\xe0\xa4\x2a\x78\x09\xe9\xa3\xae\xfb\x3e\x6a\x51\x41\xa8\x93\x9e\x72\x2e\xf7\xcf\x65\xdd\x26\xfd\xbd\x23\x11\x3c\x87\x0c\xfa\x62\xdd\xee\xde\xc8\xac\x8a\x9a\x15\x38\xac\x09\xd5\x44\x0d\x62\x8a\xbf\xd5\x7e\xb2\xc3\xb7\x84\x55\x79\x3a\xab\x3d\x5c\x48\xd5\x64\xf2\x08\x43\xc1\x39\xcd\xa1\xef\x5a\x14\xf6\x5c\x2f\x5c\x44\x72\xd5\x91\x91\x6d\x41\x5d\x86\xec\x12\x1a\x07\x61\x88\xcb\x40\x61\xe9\x17\xe6\x91\x3f\x8f\x9c\x09\x01\x15\xa8\xed\xd2\xfb\x07\xbe\x33\x56\x7d\xd1\xf9\xaf\x80\xf0\x40\x7e\xd5\x4f\x2c\x93\x9c\x92\xf7\xa0\xe3\xbe\x9f\x3b\x26\xf0\x2a\x89\x12\x38\x87\x26\x69\x64\xa7\x32\xba\x91\xaa\x98\x54\x2e\xa3\xc8\x72\x70\xc7\x52\x7a\x38\xf3\x77\xd7\xb2\xa6\xcc\x4a\xba\x5a\xc1\x09\x29\x55\x6f\x18\xcf\x15\xcd\x5f\x70\x81\xbc\xa4\x2a\xa8\x66\x46\x10\x68\x5f\x10\xd2\xfb\xb6\x1d\xda\xe4\x52\xee\x68\x0b\x8c\x1f\xc4\xc5\x37\xec\x71\x3d\xf0\x03\x92\xab\x0e\xcf\x76\xf9\x8c\xfe\xea\x1c\xd0\x3f\x9a\x42\x7d\x33\x72\x60\x41\x97\xe0\x30\x92\xa9\xa6\xf9\x8e\x6d\x91\xfb\x12\x06\xb7\x7e\xa8\x8e\x20\xbb\x8a\xae\x84\x80\x88\x9d\xb9\x75\x74\x0d\x7b\xb3\xa8\xe1\x3d\x33\xf4\xd9\x35\x47\x2b\xec\x7e\x83\x61\x35\x72\xc8\x3d\x97\x70\x5c\x51\xeb\xac\x1a\x75\x65\xd7\xf1\x20\xb1\x3b\x92\x9d\xbf\x00\xe1\x18\x41\x95\x47\xc4\xe0\xc0\x3e\xe9\x58\x97\x8d\xef\x99\x6f\x59\x1e\x39\xef\x78\x5b\x19\x18\xe2\x8b\x17\xed\xd7\x7f\x4f\x45\xbb\x63\x39\xa9\x15\x23\xf7\x2d\xfb\xcb\xbf\x15\xe8\x4c\xee\xb9\xdc\xd1\x13\xeb\xcb\xba\xc8\xae\xaa\x21\x39\xb7\xd0\xce\xd4\x50\x0f\xfb\x71\x2a\x3a\xaf\x47\x3b\x7c\x4f\x40\x2e\xf9\x54\x03\x9b\x5c\x2a\xba\x57\xfd\x61\xc5\xe3\x13\x83\xaa\x95\x9d\x29\xf2\x3e\xc8\xe2\xa4\x4a\x68\x2e\x12\xa9\xe2\xc6\xcd\x41\xd6\x85\x2c\x98\x99\x8e\x1b\x63\xac\x3e\x91\xbb\x72\xd2\x01\x0e\x9d\x28\x20\x97\x7b\x56\x94\xed\x54\xdc\x8d\x5d\x3f\xb6\x9f\x11\x2c\x9c\x73\x10\xd9\xeb\x1b\xda\x08\x1d\x7e\xad\x50\xe3\x45\x1a\x55\x84\xc1\xdd\xb3\xaa\x36\x3b\x76\xc7\x36\x9b\x91\x67\x54\x35\xda\x0e\x4f\x49\x06\x1b\x8a\xa9\xd9\xb4\x6f\x41\x44\x6e\x80\x73\xa9\x0e\x2b\x6f\x97\xb0\x9e\x50\xa4\x8b\x04\xaa\x13\x39\xac\xc1\x87\x8d\x8a\x31\x59\x26\xe5\x36\xb5\x92\xa6\x56\x4c\x14\x74\x01\xc5\xf1\x2d\x03\x2b\x3a\xf4\xf6\x7f\xfd\xe3\x9f\xa8\x6a\x56\x4a\xd3\xe2\x68\x4a\x65\x2e\xa4\xac\xc7\x4a\xa2\x2c\x6a\x30\x38\x01\xb4\xa6\x76\x52\x35\xdd\x14\x0b\x93\xeb\x4e\xe4\x4b\x8e\xb5\x89\x15\xa2\x64\xa9\x69\xcb\xd7\x27\x2e\xf6\x22\x4f\xea\xa8\xe0\x79\xce\x9a\x52\x62\x85\xe6\x8e\x02\x1f\x19\xf8\xeb\x14\xcd\xa9\x93\xec\xa5\xca\x9f\x9d\xb7\x28\x8a\x1c\x72\xdd\xe5\xbf\x81\xdd\xbe\x55\xe2\x11\x76\x52\xd7\xbb\xa8\x3a\x31\x33\x9e\x87\x8c\xa9\xba\x3a\x35\x57\x07\x3e\x8e\xc0\xb6\x8d\xae\x0d\xa4\xee\x73\xe4\x4d\xe4\x47\x1e\x79\xc7\xa5\xe6\xb8\x3f\x00\x80\x81\x32\x41\xc6\x4a\x71\xbd\x87\x3c\xa9\x97\x0c\x04\x90\xaf\x52\xea\xc6\xdd\xdb\xbc\x98\xa2\x8c\x7c\x3f\x58\x53\x2f\x61\x1b\x3a\xc3\x6f\xa2\x20\x76\x2c\xf2\xbe\xcb\x1b\x79\x64\x4c\xec\x8a\x71\xb9\x37\x39\xdd\xe9\x71\x5f\x9e\x35\x0d\xb3\x2c\x8a\x6d\xf7\x5b\x26\x74\x53\x6b\x2c\xb6\xa5\x7e\x42\x41\xb5\x25\x67\x62\x3b\xfa\xf5\x80\xdc\x32\x41\xc9\x97\xe3\x88\xd8\x0b\xc9\x7f\xfc\x83\xce\xc2\x60\x08\xf8\x4f\xbb\x2a\x77\x09\xaf\x5c\x68\x34\xcf\x74\x6a\xa3\x46\x73\x1a\x24\x5d\x26\x27\xa6\xc2\x20\xf6\x83\x68\x24\xa7\x3f\xd2\x19\xa7\x9c\xa6\xec\xf5\x6d\xc9\xb6\x7b\x9d\x86\xcb\xb6\xd9\xc3\x69\x5d\xa0\x67\x79\x23\xc1\x1f\x79\x2f\xb9\xe9\x89\x5e\x40\xc5\x72\x23\xc1\x84\xc6\x34\x3c\x10\x44\x77\x03\xdf\x0f\xc8\xa5\x06\x52\x1d\xca\x66\x95\x2c\x5d\xc7\xa9\x7b\x96\x3f\xc9\xa0\x7f\xa0\x62\xa4\xe0\xba\x2f\xbb\xcd\x86\xd3\x94\xea\xa8\x90\x3f\xea\x8f\xd2\x28\x99\x73\x95\xd1\xc3\x5b\xe4\x8e\xe6\xe4\x82\xcb\x2e\x3f\xb2\x6f\x1f\x25\xd7\xae\x70\x95\x78\xd7\x43\x22\xd4\x2b\x2e\x15\x03\x72\xad\x53\xe4\xfb\x2d\xab\xcd\x8b\x55\x70\x4a\xd3\x38\xc9\x4a\x96\x41\x21\x5b\xc5\xd2\x6e\x16\x69\xb6\x7c\xcb\x5a\x48\x75\x1e\xe2\x87\x60\x0f\xaf\x57\x2d\x77\x78\xc8\x82\x6a\x72\x95\x59\x96\xe9\x7e\x3b\x7a\xfb\x5a\x8b\x32\xa5\x90\x64\x9c\x65\xdb\x14\x66\x1f\xe2\x91\x3b\x56\x88\x67\x0d\xa4\x45\x2b\x42\xd3\x0d\x43\xdd\x01\xf2\x8f\x9d\x7e\x5e\xc0\x43\xca\xd3\x3b\x4d\x0b\xb3\x38\x98\x4b\x91\x1c\x35\x9e\xca\x34\xc9\x95\xac\x53\xf9\x7d\xda\x3a\xc7\xc1\x3b\x86\x30\xbe\x19\xf7\x1b\xc6\x81\xf5\xda\xb6\xb1\x2b\x71\xc6\x33\x18\x0e\x45\x0e\x04\x99\x96\x5f\xad\x54\x3d\xf5\x0c\x05\x6a\x4f\xde\x77\xe3\x46\x18\xb6\xbf\x79\xc6\x4a\x95\xf8\x59\x9a\x25\xad\xfe\x68\x82\xdb\xfb\xbe\x15\x90\xb3\x8a\x2a\x96\x81\x20\x67\x4d\x79\x38\x88\x04\xa2\xd8\xaf\x14\x01\x7b\x48\x88\xaa\x9b\x80\xc3\xbb\xfd\xca\x0c\x6c\x9a\x5a\xe3\x76\x78\xb5\xd3\x3c\x49\xbb\xa7\xa7\x0d\xa5\xf9\x0c\x9b\xf5\xc9\x25\x94\x47\xa0\xe8\x5f\x41\x14\x2b\x69\xc9\x78\xb6\x3d\x8f\xe0\x2f\xfa\x14\x37\x94\x9a\xda\xdb\xd8\xa4\x48\x8f\xc9\x51\xfb\x5e\xb0\x28\x75\x1f\x44\xf6\x20\x72\xd3\xa4\x38\x79\x45\xd8\xb3\x9d\x89\x77\xc5\xd0\x92\xfc\xdc\x8d\x57\x6c\xcb\xaa\x74\x93\x74\x4d\x3e\xe1\x51\x6c\x8b\xbc\x03\xb5\xa7\x9c\x1f\xd6\x6d\x3e\xca\xae\xfe\xf1\x67\x6e\x18\x20\xd4\x96\x09\x20\xef\xa0\x39\x69\xa6\xec\xd9\xee\x82\xd0\xf5\x3e\x2b\x2b\x96\xe3\x97\xc4\x86\xd2\x34\x9d\xd9\x34\xfa\x93\xb4\x48\x44\xb3\x1f\x16\x67\xb4\x8c\xec\xc0\x26\xf7\xa5\x6c\x4a\x29\xe8\x91\xd9\x45\x99\x82\x58\x0d\xc6\xe6\x21\xf9\xe9\xb9\xa2\x22\x87\x69\xb8\xe3\xe7\xce\x60\x87\xd2\x4c\x75\x4f\x69\x99\xe4\xf4\x8d\xee\x45\xbb\x8e\xed\x47\xe4\x8a\x0f\xbf\x9c\xd3\x43\xb1\xe9\x8e\xaf\x45\xfe\xe9\x21\xd1\xe9\x25\x4d\xa5\x82\x72\x0e\xe9\xb5\x77\x6f\xca\x45\x48\xcf\x92\x16\xaa\x29\x9b\xf4\x6d\x9f\xdc\xe3\x93\x7c\x5c\x03\xf7\x1d\x55\x39\x13\xc3\x65\xda\x81\x26\x7a\x53\x12\xda\x53\x6f\xd2\x22\xe6\x30\xe7\xd0\x30\xbc\x19\xc2\xfc\xa2\x31\xd6\xf4\x31\xc9\x59\x93\xc9\x1d\x55\x33\xe7\x83\xed\x8e\x29\xf3\xe1\xd3\xfc\xbe\x7b\x2a\x57\x2a\x8c\x7a\xf6\xd8\x5b\xa1\x18\xc7\xea\x48\xa1\x30\x75\xc3\x2a\xef\x84\x48\xb7\x49\x93\xee\xd9\xac\x2f\x1d\x3a\x91\x4d\xce\x3b\x85\x41\xef\xb0\xcb\x47\x31\xf5\x1f\x18\x5d\xcd\xa7\x63\x49\x43\xb2\x66\x56\xa9\x7f\x50\x5d\x6e\x98\xba\x51\xa4\x3e\xe5\xc9\x66\x08\x9e\xa6\x37\xd7\x8d\x02\x3f\x22\x5f\x60\x70\x0d\x64\x48\x42\x17\xcd\x07\xdf\x60\x1e\x7e\xfc\x37\x7c\xd7\x3c\xfe\xf8\x4f\xaf\x56\x41\x3d\x78\x76\xbc\xe8\x13\xdd\x30\x83\xa0\x5f\xf0\xea\x6e\xab\xc1\x98\x56\xcf\xca\xbc\xb1\xed\xba\x01\x72\x73\x30\xce\x8f\x65\xcc\x3f\x0d\x49\xf2\xaf\xff\x08\x2b\x55\x36\x90\x98\xf4\x0b\x53\x05\x13\x0c\x21\x87\x3b\xfd\xf5\x90\xe1\x47\xdf\x93\x5d\x89\xd6\x54\xfc\x86\x80\xb6\xe7\xa0\x80\x8f\xa2\xdb\xc9\x1b\x7a\x43\x50\xe8\x68\x50\xf9\x2d\x13\x25\x65\x6a\xb5\x56\xba\x87\x24\xa5\x23\xd1\xff\x57\xca\xb3\xd2\x6c\xdc\xa8\x46\xb1\xd9\x0f\xc6\x54\x26\x4d\x29\xeb\xd7\xb5\x1a\x79\xeb\x6c\xc3\xc0\x8a\xec\x53\xc7\x2e\xd9\xe0\x88\xa4\xe6\xe7\x5b\x01\x56\xee\x21\x4d\xa9\xae\x4a\x7d\x05\xa5\xb4\x0f\xbb\xe9\x75\xd0\xb3\xd9\xa3\x29\xad\x93\xa6\xed\x72\x26\x9f\x55\xdb\x02\x17\x45\xf8\x19\xff\x7f\x98\x7b\x97\xde\xc8\x91\x2c\xdf\xf3\xab\xd8\x2e\x56\x11\xf0\x07\x5f\xbe\xa3\x1e\x11\x52\x86\xa4\x88\x28\xb9\x3a\xa2\x22\x77\x87\xa4\x39\x69\x4e\xa3\x99\x87\x91\x74\x17\xb5\xea\x04\xe6\x2e\x06\xb8\x17\x18\x60\x56\x85\x59\x35\xba\x07\x7d\xeb\xd6\xf4\x66\x6a\x30\x68\xdc\x5e\xcc\x42\x59\x1f\xa4\xea\x93\x0c\x78\xcc\xf8\x70\x27\xb3\x97\xce\xda\x34\xb2\x8d\x59\x4a\x51\x24\xcd\xce\xe3\x7f\x7e\x7f\xde\x73\x73\x77\x57\x33\x77\x61\x14\xca\x2b\x1d\x37\x49\x5d\xae\x3f\x7f\xf1\xda\x5a\x74\xd6\xfb\xad\xeb\xd2\x03\xa5\x46\xd0\x8b\xa6\x4b\xc1\x0f\x1f\x7d\x89\x36\x68\x91\xd8\xe4\x60\x36\x96\xad\x41\x45\xc3\xe4\xf2\x9a\xe6\xbf\xfe\xef\xf5\x3f\xbc\x2f\x12\x86\x0f\xff\xac\x77\x64\xb5\xbe\x3d\x57\x09\xec\x75\x05\xe7\x3b\xa4\x4d\xb7\x61\x17\xe2\x6a\xa0\x7c\x5a\xe4\x55\x0f\xfb\x40\x6e\x24\xdf\x98\xc2\xd4\xe0\xf0\xba\x91\xea\xa5\x9a\xac\xde\xb6\xe8\x4d\xa5\xb4\x73\xb1\x55\x73\x7c\x61\xe8\x94\x6b\xdd\x68\x40\x3b\xc7\x82\x25\x06\xed\x5a\xf3\x3f\x1c\x20\xbd\x2a\x15\x2b\x58\x1d\x11\x9a\x4e\xda\xb9\xd1\xdb\x16\x32\x49\x8d\x97\xe0\x91\xe9\xfe\xcf\x52\x1a\xf4\x36\x6d\x4d\xf7\x83\xc2\x2f\xba\x2c\x6b\x6e\xb7\x0e\xa5\x83\x9d\xfe\x0a\x68\xf1\xfa\xc7\xa9\xee\x09\x51\x60\xe8\x14\xd1\x6d\xf2\x1d\x0c\xcc\x6c\xf1\xa5\xaf\x68\xc4\x36\x9b\x36\xc1\x5a\x79\x9e\x4d\x3e\xd7\xcf\x29\x91\x6a\x84\xb2\x7a\x0f\xa5\x42\xbb\xa1\x0f\x8a\xb2\x02\x26\x7b\x62\xde\x11\xf3\x47\xbc\x80\xa1\xa1\x7c\x07\x33\xa4\x1d\x9b\xc5\x60\xef\xd3\x67\x1c\x41\xd1\x7b\xbc\xbd\x5c\xd6\x9b\xa7\x18\x8d\x0c\x3f\xa7\x3b\xa9\x45\xe6\x53\xa4\x90\xc8\x24\xbd\xa4\x45\x51\x91\x6b\x5d\x7f\xf8\x2a\x65\x86\xcf\x25\x88\xa0\x0a\x0e\x7e\x9e\x56\xbb\x6e\xc8\x6b\xe5\xb9\x1d\xaf\x79\x10\xc5\x7f\x83\xb4\xac\xa6\x69\x0d\x59\x08\x21\xd5\xfe\xc5\xad\xc1\xc9\x47\x5a\xec\x4a\x9e\xe3\xeb\x17\xa0\xbf\x49\xf0\xec\xef\x40\xd5\x21\x60\x37\x76\x68\x91\x0f\xda\x69\xae\x2b\xc9\xaf\x96\x4b\x6f\xa6\x93\x61\x2a\x64\x4e\x2e\x98\xb1\x8e\x7d\xa0\xcf\x2c\x3c\x6f\x5a\x8c\xf0\x51\x6c\x20\x77\xb8\xa2\x9f\xa5\xd4\xba\xc1\xad\x66\x15\x05\x95\x1f\xd1\x3c\xa2\x7b\xc3\x8c\xb5\x5c\xcf\x36\xef\xda\x58\xdf\xff\x8a\x29\xfc\xbd\xa6\x53\x44\x59\x88\x1f\xfd\x58\x46\x55\x17\xe6\xae\x3b\x75\xaf\x8e\x72\x5f\x8e\xbb\x42\x2e\xb9\x67\x22\x0a\xa8\x8a\x47\x1c\x7e\x3f\x41\x2c\x95\xa8\x26\x1a\xc8\xb6\x90\x3a\xda\x86\xb8\xef\x39\x67\x2d\x77\xb4\x61\x8a\xec\x69\xbd\x1a\xce\x7c\x50\x59\xf5\x2e\xd3\x61\x83\x3d\x6b\x76\x86\xc1\x1d\xfd\x9e\xfd\x98\xa8\xb5\xb0\xec\x15\x2b\x5a\xed\x2b\x8e\xd4\x98\xbd\x2b\x40\xf1\x6b\x38\xf7\xb7\xb0\x87\xee\xf1\xac\x93\x3a\xbd\x87\x71\xf2\xd7\x25\x36\xf4\x27\xba\x21\xbb\x2d\x94\x75\x00\xba\x9f\xa5\xec\xc6\xec\x94\x86\xd0\x85\x0b\x1f\x54\x5e\xd0\x30\x11\x2c\xec\xb0\x58\xb6\xe7\xd8\xe4\x1b\x54\xba\x78\x71\x2a\x59\xbb\x2b\x8b\xe9\xa8\xd2\x16\x02\x46\x1f\x65\x45\xae\xf4\xb7\x73\xcf\x44\xca\x99\xf1\x48\x50\xa1\x7c\x0e\x97\x7e\xc6\x8a\x26\x57\xb4\x9a\x19\xe4\xe1\x49\x54\x6f\x7f\xba\x9c\x7e\xe6\xd1\x20\x4b\x33\x44\x29\x08\x1c\x44\x6d\xd4\xba\x2f\x2f\x01\x35\x2a\xf2\x1d\x2e\x87\x96\x9f\x73\x16\x51\x9c\xec\xea\x7a\xaa\xeb\x92\x73\xb6\x07\x71\x5a\x41\x7f\x02\xf6\xb7\x7f\xfc\xc3\x54\x85\x32\x64\x88\x7e\x92\x2a\x03\x9c\x1e\x39\xfd\x84\xc4\x81\xe6\x45\x68\xfb\x61\xd4\x9a\x71\xb8\x0b\x8b\xdc\x29\xb9\xdb\x8c\xe1\x35\xb7\x65\x50\xfe\xe5\x9f\x52\xdc\x11\xa6\xf7\x1a\xb1\x90\x1f\xaa\x89\xd8\xdd\x28\xcd\xf1\xe4\x2a\xd5\x03\x35\xa1\xe3\x67\x90\x27\xd0\x73\xa9\x5a\x2c\xad\x39\xb9\x4a\xa8\x92\x29\xa5\x03\xf6\xe6\x7d\x29\xa6\x04\x48\x5b\xd6\x6c\x24\xe3\xfd\xc0\xd9\xae\x9d\x34\x36\x69\x6f\xe8\xfa\x31\x95\x21\x2b\x58\x4f\x68\xed\x2c\xc8\xba\x14\x91\x3c\x1c\xef\x82\x0b\xc7\xb2\xdf\x5a\x36\xfa\xd7\x3e\x80\x60\x3f\xca\xc9\x8c\x33\x2d\x04\x8c\x1a\xb3\xbb\x9e\x3b\x74\x5f\x44\xc3\x8d\x3b\x74\xe8\xf9\x1b\xf9\x7c\x34\x5c\x48\x6e\x01\xf2\xa1\x67\xc0\x27\xb9\x47\xc1\xef\xa4\x4f\x6e\x61\x22\x25\x51\x91\x0f\x2c\x4f\xcc\x78\x1d\x04\x9a\x33\xba\xc1\xa5\x70\xe5\x97\x59\xdb\x03\x9f\x2f\x5d\x67\x49\xd6\x10\x26\xf9\x48\x32\x25\xf9\x44\x07\x16\xf2\x45\xcd\x09\x7c\x94\x1d\x6a\xcd\x67\xd4\xfc\xf5\x83\x36\x45\x0c\xe1\xb4\x97\xea\xe0\xbe\x43\x7f\x33\x07\xfe\x5e\x4e\x66\x73\x6a\x21\x61\xf4\x1b\xf0\x3a\x3e\x3d\xba\xbf\x8b\xc6\xb6\xe2\xd0\xdd\x59\xe0\x33\xb1\x91\x39\xa5\x69\x67\xf7\xe0\xac\x5c\xf2\x40\xb9\x92\xf9\x48\x12\xbc\x46\xc7\xb5\x00\xf2\xe9\x64\xe4\x16\x62\x46\xdf\xef\x29\xaf\x04\xb9\x54\x10\x99\x5f\xf1\xab\x94\x66\x9a\x01\x8d\x58\x39\xad\xc2\xd0\xcf\x8b\x32\x0b\x38\x2d\x77\x1d\x23\x7b\xb9\x68\x95\x91\x5f\xf8\x49\xf4\xfe\x0d\x44\x1c\x96\x53\x3d\x39\xa7\xa7\xc0\x6b\xe3\xdc\xee\xb6\x76\x3a\xca\x8d\x8e\xe6\x33\x96\x33\x72\x25\xa3\x0e\xb7\xb6\x9a\xcf\xe7\xc6\xaa\x35\x81\x88\x5c\x43\x51\xbe\x99\x08\xd0\x6e\x59\x6e\x23\xe7\x6a\xbd\x2b\x3e\x50\x1a\x19\x44\xc1\x16\xcd\x2b\x42\xda\x47\xf8\x3a\x33\x43\x72\x2f\xb0\xd3\x38\x18\x33\x7c\x5f\x27\x2d\x13\x3d\x1d\xaf\xaf\xe2\xd2\x04\xf3\x37\xba\x10\xdd\x89\x12\xb2\x18\xd7\xc3\x8d\x5f\x8a\xb6\x87\x60\x59\x33\xf4\x0a\x08\xa9\x28\x74\xab\x7b\x84\x94\xf1\xf7\x81\xa4\xb4\x10\x2d\xba\x2e\x73\x10\xe4\x09\x2a\x23\x53\xfa\x9c\x69\x51\x6a\x5e\xe0\x52\x18\x6b\xe8\xbc\xa4\x22\x66\x9d\x36\x68\xe5\xae\xac\x81\xc1\xe2\xa9\x62\xfc\x01\x54\x28\x27\x09\x84\x91\x2a\x8a\x03\x95\xd7\xec\xb9\x19\x7f\x6a\x8c\x7c\xa2\x7a\x29\x4c\x7c\x45\xa3\x88\x75\x4e\xeb\xf6\x7c\x41\x6e\xea\xa8\xab\x8e\x07\x07\x20\x73\x50\xb0\xd7\xbb\xfc\x5d\xa5\xe2\xea\xe5\xdc\x7c\x06\xcb\x6e\x14\xa0\xf4\xa8\xa7\xda\xf7\x40\x8e\x9a\xb6\x6a\xc8\xfc\x7d\x2a\x45\x01\x69\x41\xcd\x60\xf9\xca\x5e\xce\xc8\x0d\x55\xda\x65\xea\x58\x54\xf2\x00\x39\xa8\x09\x7d\x02\x2c\xa4\x88\xa2\x0b\x72\xa7\x45\xee\xf4\x92\x4a\x2b\x91\xc3\xad\x2f\x23\x21\x53\x0e\x79\x2e\x58\xca\xcc\x8d\x2d\x96\x73\xdb\x23\x97\x1c\xc2\x34\x60\x63\x9d\x84\xfb\x4e\x29\x70\xa1\x62\x2a\x8a\x73\x6f\x26\xb6\x96\x84\x22\x4f\xf3\x68\xb0\x1c\x82\xd6\x1b\x2c\x33\x6a\xd7\x30\x3d\xaa\x05\x78\xcb\x19\xb9\x86\x1d\x13\x23\xbb\xc8\x2d\xb0\x74\x22\xe0\x8e\x85\x10\x51\xd3\xe1\xef\x8f\x3e\x7d\x49\x64\xd1\x88\x9b\xe2\x66\xf0\x29\xe4\xbe\xa2\x7b\xaa\x02\x4d\xe9\xed\x4d\x63\x5f\x82\x88\x50\x0e\x7a\x5a\xb3\x79\x28\xd5\x4b\xf9\x43\xa7\xe1\x41\x75\xe6\x7b\xc3\x91\x57\x79\xd0\xb4\x4d\xa6\x6b\x12\x17\x81\xd1\x98\x24\x87\x7a\x29\xcc\x7c\x41\x0f\x95\x54\x69\x6f\x6e\xc1\x3e\xd6\xa1\x0d\x9f\xd8\x85\x8a\x99\x61\x64\x29\x49\xc9\x4f\x79\xbd\xd5\x9c\x37\xb4\x47\x96\x68\x67\x74\xd6\x39\xa9\xdc\xb3\x3d\x6d\x66\x4d\x8c\x97\x4a\x28\xfc\x88\xee\x19\x88\x02\x54\xbb\x4d\xce\xc8\x67\xc5\x46\x5a\xe3\xf7\x74\x9f\x9b\xac\x7a\x02\xe7\x1b\xcb\x76\x9b\xea\x0d\x28\x25\x75\x8f\xb4\x9b\xe9\x55\xa1\x5e\x0d\xe5\xd8\x50\xef\xca\x73\x5b\x7f\xcb\xf6\x4d\xf4\xbc\xd9\xac\x67\xc3\xfa\xfa\xbf\xee\x98\x01\xbe\x4c\xd0\x60\x45\x92\xe8\xc7\x7a\xeb\xef\x48\x22\x3d\x9c\xdc\x56\xa3\x44\xc2\x9d\x0f\x75\xb8\xde\x71\xe8\x57\x76\x1d\xde\xd7\x07\x01\xf2\x99\x4f\xbd\xe2\xe9\x6e\xc2\x7a\x1b\xf2\x44\xeb\xb4\x59\x01\xc1\x01\x73\xfc\x55\x5e\x34\xf6\x3a\x4f\xea\x95\xf0\x87\x9f\x53\x9a\x03\xb4\x9a\x5d\xcb\xf9\x0d\x5d\xe4\xf7\xb2\x7e\x4d\x27\x8a\xe5\x91\x22\xaa\x79\xf3\xb7\xd4\x34\x75\x9a\x47\x54\x94\x7a\x38\x26\x4c\x9a\x2b\xa1\xf2\x2b\xe0\xb4\x9d\x0f\xb2\xea\x87\xba\xd3\x3d\x86\xb1\x89\xc9\xbb\x44\xee\xb1\x4e\xfa\x20\x45\x2c\xf9\x99\x1f\x13\x82\x44\x4d\xd7\xb8\xc3\x27\xf5\x75\x6a\x99\xc6\x27\x85\xb9\x9e\x66\xd0\xa7\xb2\xbd\x5a\x2e\x5c\x72\x5b\x3e\x73\x3a\xc4\x34\xde\x43\x2c\x81\x20\xd2\xe6\xcd\x34\x3d\x48\x04\x88\x62\xa1\xb4\x35\x87\x3f\xc6\x6b\xe6\xe8\x0f\x1f\x16\x3e\x04\x61\xfd\xf6\x19\x6d\xe4\x62\xee\x2e\xad\xa1\x9d\x8a\x63\xcd\x96\xbd\xcd\xe2\x8b\xfe\x97\x27\xd8\x28\x90\x1b\x8a\x72\xae\xa3\xd1\xcf\x47\x29\x23\xed\x92\xc7\xdb\xd1\xcf\xb0\xf4\x0b\x04\xc7\x41\x97\x2c\xbb\x0b\x72\xcf\x8a\x82\x53\xf2\x81\xd3\x52\xf5\x1a\x79\xf6\xcc\x25\xf6\x02\xff\x4e\x52\xbd\xfe\x9f\xba\xcf\x74\x7e\x5d\x97\x63\xb5\xac\xe8\x2f\xc0\x33\xfd\x32\xde\x1d\x58\x16\x68\x05\xf2\x76\x87\xab\xe1\xde\xa7\x6f\x15\x0d\x93\x62\x61\x19\x68\xa8\x37\x5f\x58\x16\xb9\x92\xbc\xcc\x82\x32\x1f\xfa\xed\x55\x20\xa0\xd4\x1f\x5e\xfd\xcf\x19\xa8\xf3\xde\x58\xc7\xd9\xe8\x88\xa1\x47\xee\xb1\x89\x66\x86\x86\x07\x7f\x53\xaa\xd6\xb4\x62\xb1\x22\x5f\x29\x2b\x92\xd1\x9d\xe3\x01\xf8\x9e\x09\xc8\x49\x1b\xf7\xe6\xd3\x45\xc1\x4e\x9f\x62\xfe\x85\x2a\xbd\xe1\xf7\x4a\x04\x6a\x57\x2f\x86\xcf\x3e\x6d\x11\x86\x4b\x87\x5c\xc9\x0c\x44\x98\x8c\xd5\x07\xd6\x75\xf8\x69\x48\xbd\xaa\x8c\xcb\xf3\x62\x1b\x2c\x04\x85\x36\xfe\x52\xdd\xa0\x3c\xb6\x2a\xdb\x1a\x41\xa0\x67\xe5\xc3\xca\x0f\x82\xa0\x19\x90\x77\xbd\x39\x59\xef\xe0\x68\x2a\xb9\x85\x84\x26\x72\x9f\xee\xa7\xa2\x9f\x5a\x88\x09\xfd\x28\x05\x14\x09\x88\x1e\x8f\xbd\x71\x3f\x37\x3c\xf6\xf0\xc5\xa7\x01\x54\x1d\x8f\xcd\x5e\x90\xf5\x01\xea\xd0\x6a\x98\x80\x7d\x95\x39\x35\xa4\xc9\x27\xd8\xb2\x94\x9d\x3d\x77\x46\x3e\xe8\x55\xeb\xa1\x82\x1b\xc8\x49\x0c\x15\x6e\xeb\xc5\x68\xe6\xc7\x32\x82\x28\x6a\x35\x5d\x8b\xd9\x4a\x0b\x0b\xbb\x2d\x63\x69\x11\x0b\x45\x0d\x37\x65\x5e\xc0\x3e\x0f\xa8\x7e\xac\x13\xec\x87\x6e\xcf\xaa\xfe\x86\xf1\x80\xea\x08\xef\x3d\x98\xf9\x27\x88\xf5\x62\x34\xef\xa1\xb0\xde\x25\x1a\xa1\xe7\xcc\x16\x75\xe8\x11\xd5\x81\xed\x28\xbc\x3c\xae\x26\x2b\x6c\x23\x1f\xf4\x9a\x06\x0a\xc8\x5d\x9d\x19\x47\xb8\x5b\x34\x60\xc0\x28\xd5\x6b\xd1\xe2\x88\x36\xef\x2c\x17\x36\x79\xa0\x99\x2e\xa8\x9d\xde\xd1\x95\x14\x21\xdd\x85\xcc\xcc\x86\x5e\x4a\xce\xf6\x67\x0e\xa6\xdc\x45\x0b\x98\xeb\x02\xf9\x27\x88\x9b\x20\xd1\x04\xf2\xd1\xf2\x14\xf2\xb5\x58\x92\x0b\x1e\xcb\x0c\x9a\xde\xac\xb7\xb2\xed\x99\xde\x6e\x26\x66\x95\x5b\x08\x0e\xbd\x83\x22\xa9\xcc\xa4\xf1\x9b\x3e\x6c\x28\x4d\x70\x2d\xb2\xfa\xf0\x13\x7b\xb5\x5a\xad\xc8\x6d\x1d\xf6\x1e\xa1\xd8\x3c\x7b\x4e\x66\x4b\x13\x13\x16\x2c\xe7\xa0\x77\xc2\x35\x97\x7b\x48\xcf\xfd\xb0\xac\xc6\x09\xb1\x38\xde\x34\x7a\x13\x78\x66\xd7\xb0\x9b\xaf\x8b\x69\x30\x0f\x59\xaa\x68\xc4\xd2\x2c\x4c\x80\xbf\xfe\x93\x7e\x17\xb9\xcc\xce\x2d\x1f\x77\xb5\x7a\xa3\xca\xa4\x88\x5a\xee\xee\xdd\xa1\x65\xe6\xab\x3a\x84\x88\x1c\xbf\xdc\x51\xd1\x0d\xb7\x3a\x73\x9b\x3c\xca\xc3\x68\x55\xf4\x82\x43\x2c\x99\x48\x26\xd3\x12\xba\x4e\x6b\xf5\xd2\xb9\x1c\x5c\xd3\xbd\xd9\xd4\x41\xdb\x1c\x44\xee\x29\xa9\xdb\x76\x96\xe4\x21\xac\x8f\xe9\x71\x97\x83\x5b\xa4\xc8\x97\x3a\xcc\x95\x65\x91\x90\x8b\x8d\x3a\x33\x7b\xcd\x42\x80\x68\xab\x85\x7a\x94\x5a\x7f\x87\x25\x36\x73\x7f\x7b\x25\x73\x1a\x79\x3d\x58\xf2\xc2\x22\x37\x0a\xf2\x3c\x85\x6c\x37\x78\x03\x2f\x41\xa9\xfa\x69\x4d\xf5\xb0\xbc\x86\xf9\x6c\xa0\x03\x2d\xe4\x0a\x14\x0b\x69\xb4\xea\x6f\x11\x8b\x65\x6b\x92\x3a\x08\x28\x3e\x32\x78\x46\xc3\x8e\x84\x8a\x38\x2e\x41\x4c\x37\x13\x64\x90\xa1\x9a\x45\x59\x2a\x33\xa3\xdb\x53\x6f\x6c\x83\x7a\x35\x82\x66\x87\xd8\xe9\x99\x0b\xd7\x25\xf7\x25\x92\xb9\x4f\x93\xe3\xa7\xb2\xbe\xa7\x03\x08\x3a\xa5\xac\x10\xb1\xa1\x9d\xeb\xdc\x45\xaf\xaa\x71\x07\xda\x3e\x35\x05\xb3\x18\x05\xc7\x37\xb7\x5a\xb9\xcb\x39\x79\x2c\xf3\x7c\x74\x46\x72\x8d\xe2\x5c\x7c\x05\x4b\x41\xd9\x79\x6f\x6b\xde\x1b\x8d\x34\xc9\x48\x7e\x04\x25\x6b\xb4\x29\xb9\x6a\xaf\x46\xa1\x8f\x4d\xf4\x8e\xb8\xd9\xb2\x51\xfb\x59\x97\xe5\xcd\xed\xb7\x8e\x83\x96\xd9\x6b\xa8\x6f\xe5\x22\x52\xec\xf5\x9f\x25\xf9\xca\x5e\x28\x9f\x6e\xc0\x1a\xe9\xa2\x0d\x89\xed\xa2\xac\x8f\xb3\x37\xc6\xfd\xbc\x19\x87\xca\x00\x97\xa3\xc8\xcf\xeb\x00\xe2\x5d\xf1\xfc\x4e\x6f\x7e\x4b\xaf\x9b\x3d\x39\x91\x85\xad\x0b\x99\xbf\x4c\x09\x10\x41\xb2\x68\x83\xf6\xbe\x36\x12\xb7\x7b\x13\x22\x86\x11\xad\xdf\xcf\x63\x47\xac\x85\x6b\x91\x87\x4a\x15\xa6\x80\x78\x02\x80\xbd\xfe\xcb\x9f\xd2\xbf\xfc\x93\x11\xc6\xdf\xb3\x22\x29\xe1\xcc\xec\x2e\xcb\xd3\x31\x07\x55\x15\xef\x44\x01\xdf\xc1\x14\x6f\x42\xd4\x04\x44\x1b\xdf\x9e\xbf\xd3\xef\xd3\x9c\xdc\x4b\x11\xa3\x33\xe0\xc9\xd3\xb9\xa4\xac\x20\xb7\x52\xe9\x97\xfb\xa7\x5c\x01\x3d\xf3\x5b\x67\xa3\x9a\x58\x84\x15\xb9\x54\x15\xe8\x3d\xa0\x17\x6d\x88\x00\x57\xa3\xd8\x2f\xf3\x42\x51\xc8\xde\x15\xfa\x04\x5b\x2c\x3b\xeb\xd4\xd3\x80\xe3\xb1\x0a\x84\xac\xf0\x85\x9b\x40\x8b\xa2\xc9\xa1\x65\x54\xa1\xa7\x8e\x30\xde\x80\x1d\x81\x4c\x2f\x46\x89\x2f\x68\x91\x87\xd0\xc9\xfd\x67\x4b\x9b\xac\x4b\x21\x2a\xe4\x31\x8c\x97\x6a\x2a\xa9\xe2\x49\xf5\x7a\x88\x0e\xbd\x02\xc5\x65\xde\x49\x38\x9e\xc0\x0c\xd1\x84\x28\xe0\x88\x18\xba\x01\x96\x75\xd6\xd8\xd5\x0d\x2d\xdb\x22\xeb\x32\xcb\xa8\xfa\x0d\x8f\xca\x3b\xa9\x58\xfe\xfa\x67\x5d\xd4\x52\x94\x9e\xb9\x2a\x8a\xd4\xd0\x66\x03\x6c\xe2\xa8\x1b\x08\xf6\x4c\xc7\x47\x19\x86\x51\xdb\xa1\xbe\xd2\x9a\x91\x07\x88\x23\xca\x99\xa0\x63\xd6\xa2\xb2\xd2\xf7\xf9\x93\xa2\xe7\xdf\xfd\x56\xbd\x31\x9a\x07\xa9\x4c\x1e\xd8\xcb\x26\x21\xc3\xd5\x28\xf5\xf3\x90\x51\x51\xb0\x0d\x0b\xc1\x74\x28\xbb\xf2\x06\xb9\xaa\x03\xe3\xa1\x3a\xea\x77\xe5\x1e\x72\x89\xcf\xfe\x1f\x5e\x02\x3a\x41\xd5\x06\x09\xa2\x77\x2c\x0b\xa8\xe2\x55\x1d\x0d\x0a\xbd\x8b\x3f\xc9\x1d\x0b\xb9\x2c\x8d\x17\x7b\x1a\xe0\x95\x88\xfb\x52\x41\xd8\x3d\xbd\xb9\xbd\x5a\x92\xaf\x90\x70\x2a\x06\xfb\xc8\x03\xa8\x7d\x04\xb9\x36\x8a\xf9\x49\x9d\xfb\xc6\xe6\xbd\x44\xac\x1f\x5a\xf5\x63\x7b\xd5\x86\x57\x99\xcf\x44\x44\x77\x54\x44\x54\x14\xbd\x92\xdb\xd2\x22\xef\x21\xfe\x0d\xce\xe6\x53\x52\x8a\x98\xac\x0d\xa4\xfd\x29\x01\x76\xf6\x37\x14\x41\xa2\x1a\x97\x87\x2e\x29\xda\x65\xf4\xae\x17\x1c\xd7\xbf\x52\xbd\x1e\x89\x53\xd1\xa5\xed\x38\x2b\x4f\x0f\x00\x8f\xe8\x12\x13\x10\xc9\x54\xd2\x07\x04\x88\x6a\xaf\x21\xed\x96\x62\xee\xca\x4c\xbb\xe6\x7a\x2d\x92\x3e\x67\x7b\x6a\x9c\xd9\xda\xbb\x9a\xb9\xe4\x81\x2a\xd4\xfc\x8d\x3d\xb4\x35\x08\xf2\x85\x46\x4a\x92\x8b\x0a\xb2\x5d\x89\x04\x87\x9b\x12\x0a\x9a\x01\x3f\xf3\x6d\x5a\xad\xed\x79\xdb\x22\x3b\xf9\xf0\xb6\xd8\x23\x8b\x76\x47\x63\x11\xcb\x99\xed\xd5\x19\x9b\xc8\x71\x12\x7b\x64\xd3\xa4\x39\xc8\x29\xcf\xb9\x95\x99\x83\x65\xfd\xd9\xde\xdf\x95\x4c\x4f\xeb\x65\x7a\xb2\x37\xfa\xe1\x87\x8a\x42\xc1\xf6\x34\x94\x59\x26\x45\x4b\xb7\xb5\x5c\x72\x07\x05\xa3\x83\xec\xfa\xab\x02\x11\x53\x3e\x99\xa7\x92\x85\x0c\x51\x23\xf1\xea\xfb\xfa\x5e\xd3\x8c\x65\x01\x3e\x9e\xb8\xb1\xf5\x8d\x94\xbf\x67\x19\x95\x1d\x1e\xfb\x48\xe5\xdc\xec\x97\x0b\xcb\xb5\xbd\xae\xb1\xf9\xa4\x5e\xff\xc8\x59\x9e\x6b\xb4\xc8\x04\xfd\xcd\x95\x16\x99\x56\x21\x25\x4f\x52\xa9\x0e\x3f\x67\xb4\x7d\xdb\x02\x57\xa3\xdc\xdf\x14\xbd\xb9\x58\xe2\x14\xc9\xd8\xf4\x32\xdb\x41\x5a\x4e\x97\x64\xaf\xbc\x0e\x28\x02\x7b\x13\xec\x4b\x13\x4e\x66\x51\xbd\x14\x15\x47\x60\xfd\xd5\x6a\x35\x5f\x90\x0b\x95\x82\xc8\x21\x1f\x1c\x6b\x57\xc0\xcb\x20\x2c\x03\x72\x2d\x27\x1d\x20\x58\xe9\xaa\x08\x08\x8d\xd4\x6c\x0a\x8d\xba\xd8\xb3\xdd\xe0\x5a\x54\xfa\x59\x75\x64\xf7\xe0\xcc\x16\x7d\x41\xf0\x20\x22\xf9\xc4\x68\x79\x20\x9f\x58\x98\x52\xa5\x3d\xbf\xd6\xa5\x62\xf5\xaf\x79\xce\x9b\xb3\x91\x2f\xda\x40\x6d\x6f\x58\x60\xce\xee\x7a\x07\xd1\xca\xd9\x30\xc6\xc5\x68\xef\xd3\xa8\x0c\xb8\x8c\x3b\x32\xf6\xdc\xeb\x1c\xf4\x06\x85\x91\x9f\x0a\xa6\xca\xd7\x7f\x2d\xdf\x4c\x52\x9e\xb3\x11\x31\xfa\x0d\x2a\x41\x7b\xdd\xcc\x96\xad\x74\xd0\x8d\xcc\xe8\x30\x50\x96\xda\xd6\x7c\x61\x91\x6b\x38\x8c\xcf\x2b\xfe\xe5\x3f\x5e\xff\xac\xe0\x60\x6a\xb3\xe7\x2f\x13\xd8\xb3\x85\x61\x39\xf4\xb5\x97\x6d\x7f\x62\x67\x84\x97\xd1\xf3\xa9\x31\xa0\x4b\x9e\x54\x09\xcf\x83\x3e\xd2\x47\x56\xe6\x13\xd9\xfa\xda\x48\x17\x6d\xa8\x51\x1f\xa5\x8a\x74\xd1\xa3\x97\xcb\x44\x5b\x5c\x8d\x2a\x3f\x91\x59\xbd\xa3\x43\xd4\x11\xd8\x66\x0b\xf2\x24\x03\x18\x91\xef\x3d\x81\x88\x5f\xa6\x19\x93\xb5\x35\x53\x94\xe5\x80\x45\x99\xce\x71\x5f\xcb\x02\xf8\x0e\x17\xa3\x17\xbf\xce\xc3\x42\x26\xcb\x3c\x82\xaa\xe3\x8a\x7a\xde\x42\x7b\xa8\x60\xee\x39\xd8\x34\x2e\x81\xf1\xf2\xc7\x54\x7d\x4c\x1b\xe9\xa2\x5d\x1d\xf5\x0a\xb2\x5d\x60\x5a\xea\x06\x31\xda\xce\x20\xa5\xa1\xb9\x48\x67\x83\x8d\xc3\xc5\xfc\x9a\x15\x43\x11\xdf\x45\x9a\x14\x72\x27\xf1\x27\x4e\xa1\xbc\xb4\x11\x30\xfa\x04\x59\x56\xe1\x5b\xd5\x8e\x04\x9b\x41\xa4\x02\xea\x45\x3a\x3f\x05\x37\x2e\x16\x5d\x5b\x69\x4c\xdb\x2c\x8a\x1c\xf6\x2c\xd4\xee\x2c\x97\x94\xc3\x99\x5d\xf7\x6d\x44\x8b\xde\x52\x7c\x74\xc7\xbd\x75\x5d\x60\x4c\x4c\x6b\x9d\x2e\xfc\x10\x04\xf0\x23\xab\xd4\xf9\xaa\xf5\x28\x1e\xd0\xb1\x21\x02\x11\x97\x1c\xd2\x42\xab\x9f\x27\x8b\x3f\x6c\x44\x8c\x36\x05\xd4\x47\xca\xb0\x0f\x71\xcd\xf6\xa0\x75\xa1\xa1\xa2\x2c\xa2\xcb\xee\xbb\x6b\x77\x46\xab\x63\xea\x0d\xa3\xaa\x07\x99\x17\xd5\x54\x7b\xfd\xaa\x1d\x50\xff\x06\x7c\x84\x5e\xa1\x0e\xb8\x4c\x2d\x5f\x3e\xbf\x83\xb0\x71\x13\x34\xdc\x9e\xfa\xeb\xca\xbb\xc1\xf4\xd5\xc2\xb1\xec\x2e\xfa\xbd\x94\x25\x97\xb1\xa0\x6f\x2f\x19\xe7\x20\xc2\xe6\x53\x3c\x7f\x1c\x6c\x23\x56\x74\x9d\x30\xc5\x69\x85\x38\x8b\x5d\x2b\x39\x85\x3c\xc9\xf5\x03\xc9\x0b\x73\x81\xda\x47\x1e\x83\x8b\x95\xb3\xd2\xf7\x3b\x76\x5e\x3f\xc9\x8c\xc9\x74\x1a\xf8\x88\x8d\x88\xd1\x9f\x14\x15\x94\x7c\x97\x46\x8e\x77\x07\x95\x89\xee\x59\x85\xb9\xa7\xe3\x07\x65\x5e\xc7\xb4\x39\x13\x39\x8b\x7a\x0a\x7c\xcb\x99\x59\x6d\xe3\x69\xf8\x66\xde\x48\x0e\x21\x9b\x0e\xc6\x6c\xcf\x17\x5d\xb7\xf0\xb6\xd4\xd1\xdf\x77\x69\x34\xcf\x5b\x64\xdc\xba\x03\x3f\x04\x77\x36\x73\x16\xe4\xfd\x9e\x2a\xb4\xb8\x18\xd9\x2d\xef\x68\x16\x80\x88\x53\x5a\x67\xa0\xe4\x1e\xca\x94\x3c\xb1\xac\x9c\xf2\x46\x97\x3d\xfe\xde\x27\xca\x8f\xea\x5b\xa6\x53\x93\x0a\x5c\xa7\x9e\x4f\xe9\xae\xaf\x75\xb1\x1c\x72\x5d\xa6\x23\x00\xa3\x6b\x5a\x84\x09\x13\x13\x8d\xa2\xda\x9a\x3d\x6a\x04\x7f\x9d\x61\xfd\x1d\x98\xb8\x3f\xd0\x6e\xf5\x74\x35\x42\x57\x58\xb4\x87\xdd\x90\x17\xf1\x30\xf5\x39\x80\x2c\xd2\xf7\xa0\x38\xb9\x85\x43\x03\x9a\xee\xa6\x27\x68\xa2\x57\x29\xfc\xe6\x87\x47\x2e\x02\xaa\x46\x5c\x72\xef\xcb\x2c\x80\x6a\x52\x46\xb8\x8d\x34\xd2\xa7\x84\x2a\x9a\x03\xf9\x50\xe7\x55\xfa\xcf\xdf\x77\x57\x29\x36\x7a\x9d\x06\xf5\x69\xd7\xbe\x8a\x4b\x6b\x6e\xcf\xc8\x43\x18\x4a\x95\xb1\x30\x1d\xbe\x91\x5f\x4a\xaa\x0a\x49\x7e\x57\x52\xde\x78\x0e\x5c\x25\x8c\x9f\xf9\x30\x70\x35\xae\x24\x82\x5e\x77\xf7\x8b\x8c\x0c\xda\x8d\xeb\xde\x2e\x0d\x7d\x5e\xf2\xb2\x23\xbb\x35\xc2\xba\xd1\x56\x28\x13\x11\xcb\xa6\xa2\x69\xd9\x48\x25\xbd\xa4\x7b\x2c\xfc\xdf\x48\x15\x19\x07\x23\xed\x54\x6f\xf4\xc2\x41\x8c\x17\x68\x34\x1c\xc5\x72\xc8\x83\xfc\x8d\x8f\x6d\x5d\xa6\xa0\x20\x9b\x6e\xf0\xc5\x46\x36\xa9\x61\x12\x34\xc1\xe1\x93\x6a\xfb\xa2\x01\x07\x41\x29\xf5\x0f\xf9\xb6\xd7\xa4\x69\x2d\xd0\x87\x1e\x91\x54\x14\x25\x9f\xee\x76\x10\x4a\x7a\x53\x47\xba\x1d\xab\xee\x83\x29\x1a\xc4\x1a\x55\x47\x37\x27\x04\x59\xd7\xb6\xdc\xa3\x7a\xe3\xa9\x08\xe6\x7b\x1d\x6a\x89\x38\x4c\x26\x4b\xdc\x10\x44\xaa\xb1\x33\x7a\xfa\xc5\xf4\x43\x55\xa9\x83\x63\x91\x99\x55\x1a\xfb\x79\xa8\x58\xd0\xa6\xd9\x96\xeb\x3a\x73\xf2\x81\xe5\xe9\x70\x3f\xfc\xc8\xe8\x66\x9a\xbe\x85\xbd\xe8\xab\x40\x2e\x4b\x55\xb4\x65\x2b\x5d\x96\xcb\x02\x5c\xa3\x89\x1f\x56\x01\x55\x61\xc2\xb2\x5d\xde\x65\x6b\xe4\x0a\x78\xb5\xcb\xe5\x51\xdf\xc2\xb5\x97\xee\x42\x1f\x16\x0d\xb3\x64\x32\x1d\xaa\x8d\x14\x52\x5d\xbf\x6a\xac\xa6\xde\x83\x01\xa1\x1d\x42\x29\x53\xca\xfc\x1d\x15\xef\x74\xb4\xe5\xcc\x17\x2b\x72\xa1\x0a\x96\x83\x18\xe9\x58\x7f\x01\x0e\xba\x62\x35\x41\xa0\x81\xf4\xd1\x27\x19\x45\xe4\x8b\x6c\xc7\xfd\xc0\x40\x99\x8b\x1d\xae\xd1\xad\x5f\x81\x88\xe8\x73\x37\xdf\xed\x6a\xa1\x3a\xd6\x41\x46\x09\xb8\xaf\xff\x2c\xc9\x47\x59\xff\xdf\x48\xe2\x84\xd0\x54\xd4\x4e\x1b\x59\xa4\x66\xf8\x6f\x9d\x99\x9d\x6f\x9d\x36\x06\x75\x79\x5e\xaf\xd1\x74\xa0\x46\xb2\xc9\x15\xa7\x99\x14\xf9\x98\xed\x4f\x49\x85\xa1\x2a\x84\x25\x44\xf2\xac\x03\x3c\x36\x72\x48\xf1\x99\xf5\x28\x25\xed\x6e\x51\x18\x48\x09\xe5\xbe\xa2\x51\x58\x7f\x42\x6d\x3f\xc9\x58\xac\xe8\x8d\xb0\xdd\xde\x6d\xd7\x22\x4b\x44\x69\x7f\xa5\x45\xbd\xf3\x6b\x21\xf4\xd9\x47\x0c\xec\x85\x9e\xac\x0d\xea\xff\x5a\x37\x9d\x3f\x74\xe8\xd0\x43\xfa\x34\xf3\xb9\x6c\x49\x5c\xf3\xb9\x87\xaf\x30\xc4\xf4\x54\x9f\x34\x19\x1b\xc2\x46\xfe\xe8\x35\x08\x46\x79\x37\xdf\xfd\x40\xa9\xe9\xbb\x44\x38\xe0\x4d\x85\xbf\x95\x32\xe3\xd0\x56\xe0\xdc\x3a\xa1\x84\x32\xa0\x7c\xb4\x75\x7b\x55\x36\x69\xc0\x83\x7c\x81\x2c\x40\x32\xd5\x59\x6f\x4b\x97\x3e\x44\x54\x91\x47\xc8\xcc\x97\xdd\x77\x6a\x56\xaa\x5e\xa6\xd2\x2f\x73\x28\x64\x04\xed\x88\x8b\x37\xb7\xd1\x40\x50\x31\x51\xff\x6e\x57\x72\x3f\x2a\x8f\xfb\x08\x2a\xae\x77\xc5\xe2\xcd\x44\xb3\xa8\x36\xa2\x49\x1b\x73\xe3\xae\x56\xdc\x4e\xbb\x64\xba\x54\x4c\x77\x7e\x0e\xbc\x03\x0d\xac\x16\xe4\x8e\x65\x83\x91\xe1\x0b\x55\x31\x25\x77\xf2\xf5\x7f\x72\xb3\x51\x9e\x5f\x9a\x64\x23\x94\xf4\xb1\x0c\x2a\xf2\xf9\x60\xa4\x04\x57\x32\xee\x24\xf9\xb2\x5e\xa5\x3f\xfc\x9d\xe2\x32\x6e\xde\xc5\xa5\x4b\x3e\x20\x47\x9b\x5c\xc3\xa8\x57\xd8\x8d\x02\x01\x4a\xbb\xcd\x9c\x5f\x9a\x64\x23\x93\xf4\x16\x2d\xb5\xba\x91\xe1\x6b\xba\x6f\xab\x70\x89\x1e\x19\xa6\xca\xaf\x64\x99\x96\x5d\xe4\xe4\xcd\xc9\x43\x1d\x7b\x80\x18\x91\x7d\xff\xcc\xaa\xa9\xac\x7e\x6d\xe4\x92\x9a\x41\xab\x2e\xf5\x47\x41\xc4\x8b\xd4\xbf\x3d\x98\xf4\x3f\xf7\x45\x95\x41\xdc\x11\xa8\x3c\xcb\x6e\x19\x5b\x66\x17\x5c\xb8\x0b\xfb\xad\xbd\x40\xad\xf0\x13\xec\x20\x02\x12\x41\x8e\xf7\xfe\xfa\x3f\x74\xeb\x76\x02\xa5\xb0\x8d\xc0\xd2\xa6\xe6\xdd\x6b\xb0\x53\x51\x30\x2d\xfa\x4e\x74\x83\x9d\x16\x3e\x0d\xa5\x90\x19\xcb\x8b\x2e\xe9\x7a\xa4\xd1\x01\x71\x1e\x03\xc9\x77\x42\xc5\xdf\xfe\xf1\x0f\x5a\xf7\x31\xc1\xc3\x43\xc5\x07\x45\xe5\x42\x3b\x7c\x80\xb6\x45\xba\xd3\xae\x58\x48\x69\xd9\x9f\x3b\xb5\x97\xb6\x4d\xee\x21\x35\x1e\x83\xa7\xe9\xc9\x27\xd8\x83\x62\xd5\x54\x53\x9a\x36\xb2\x4a\x0d\xa2\xe4\x96\x2a\x51\x07\x82\x98\x9e\x1c\x4f\x0c\x27\xcd\x25\xba\x3f\x2a\x00\xdb\x3d\x8f\x8b\xd3\xf6\xc4\x15\x0b\x98\x9a\x4e\x13\x61\x23\xc2\x54\x7b\x09\x1c\xdd\xd9\xa5\x62\x71\x52\x34\xb5\x43\xe8\x6e\xed\xe0\xe7\xac\xa0\x19\xed\xf5\x96\x2c\xdb\xb5\xc9\x2d\x4a\xe3\x06\xfd\xc0\x6b\xc9\xc5\xeb\xbf\xd5\xdf\x30\x6c\xe5\xde\xbc\x0b\x57\x2f\x34\x4c\xc8\x23\xdd\x95\x01\x67\xe1\x79\xef\xd7\x6b\xa7\xe7\x1e\x65\x6c\x10\x92\xf7\x4c\xa4\x5d\x68\x95\x2a\xbc\x40\x9f\x3b\xdd\xb7\xeb\xd8\xee\x82\x5c\xa8\xfa\xfb\xab\xff\x54\x63\x26\x98\x5b\x83\x53\x3f\x7b\x80\x85\x4c\xd3\x8f\x32\xa7\xbb\xa4\xc3\x6f\x7d\x63\x29\xd3\xfc\xe6\x2d\xf2\xb7\x68\xe5\xb3\x2c\x2e\xdb\x67\xb6\x22\x57\x1c\x54\x1d\xdb\x17\x43\x1c\xd0\x85\xca\xe0\xf5\x5f\x31\x71\xa1\xe4\xf2\xf5\x7f\xbe\xb0\xc9\x32\x17\xa4\x9a\xde\xc3\x41\x1b\xc5\x76\x7b\x24\x96\xb6\x51\xce\x52\xff\x7f\xdc\x6c\x93\x2f\xfe\x81\xa5\x6c\x47\x23\xd6\x06\x92\xb3\xc5\x9c\x5c\xf0\x4c\x8e\xf1\xc4\x7f\xcf\x76\x6c\xaa\x13\x0e\x69\xa6\xc6\x48\xa1\xd3\xda\xb6\x06\x11\x54\xab\x6c\x37\x33\x1f\x32\x78\x91\xc2\x60\x15\x66\x8b\xd9\xc2\xf9\x4f\xb6\x93\x7b\x26\xc2\x64\x2a\x8c\x9f\x8d\x20\xd3\x0b\x0e\x82\x3c\xd0\xca\x54\x7d\x1b\xe2\x3b\x64\xf5\xd2\x66\x7e\xe4\xc1\xba\x98\xeb\xd6\xc3\xf0\x7b\xba\x95\x92\x4f\x17\xf9\x22\xc7\xd4\xcc\x85\xb4\xbe\xf5\xad\xc3\x40\x88\x9e\xf5\x9b\x85\x1f\xb0\x18\x27\x88\x3b\xc1\xa9\x33\x9b\x39\x27\xa1\xfd\xa0\x21\x4d\x15\x0b\x59\x3a\xdd\x96\x8f\x10\x53\xcd\xe4\x27\x8f\xb4\x12\xd2\x20\xa9\x5a\x13\xbe\x42\x99\xd5\xcd\xd2\x3f\x24\xf2\x9d\x89\x2e\x16\x4b\x72\x4b\x39\x15\x30\xb8\xa5\x47\x48\x41\xa7\x04\x9f\xe8\x81\xfc\x4c\xe1\xec\x21\x30\x62\x4b\x1b\x36\x70\x1f\xd7\x87\x94\x99\xa6\x32\xdf\xf0\xfa\x36\xd6\x88\x61\xe2\x7c\xb1\x58\xf5\x3c\x8d\x7a\xdb\xc5\xd2\x9e\x93\x15\x72\x3f\xbf\xbe\xfe\xcb\xf3\x64\x2e\x5b\x36\x32\x4c\x3f\x82\xa0\x45\xcf\x52\xe1\x92\x97\xb4\x09\x89\x8d\xab\xc2\xc6\xf6\x21\x90\x65\xa7\xc8\xb4\x5d\x6b\xd6\x4d\x2e\x0d\xc4\x48\x1f\xca\x3c\x79\xfd\xd3\xdb\xcb\x92\xff\x60\x2f\xaf\x7f\xc2\x13\x80\x07\xe7\x1e\xc6\xb2\x91\x68\x7a\x29\xf5\x74\x71\x63\xae\xd0\xd9\x7a\xa0\xb7\xc2\xc6\xf1\xb5\x50\xa7\xd9\x40\xbc\x8e\x0e\x74\x2a\x1c\xfb\x06\xaa\x8c\x4b\x51\x4e\xd9\xea\x3b\x06\x9b\x7e\x6b\xbd\x8c\xdb\x30\xdf\x8c\x9a\x65\x9d\xcd\xf1\xc6\x3d\x15\x6a\xae\xe6\xfd\x9e\xd8\xe9\x61\x7d\x57\x06\x20\xe2\xdc\x78\xe8\x4e\x74\x9b\x2b\xd3\x70\xe4\x6c\xd7\xed\xfd\xfd\x14\x74\xa7\xb7\x7f\xef\xc8\x00\xce\x21\x17\x42\x40\x06\x2a\x1d\x66\x9f\xff\x90\x65\x04\x38\xf9\x1d\x54\x07\xa8\xf0\xef\x65\x4a\xdd\x17\x0a\x02\xf2\x3e\x63\xea\xec\x15\x6f\xa4\x9c\x5e\x61\xdf\xb6\x8f\x1d\xeb\x19\xcc\x76\xd4\xb1\xcd\xca\x67\x22\x2f\x20\x56\x90\x75\xe0\xbb\xa6\x8e\xf0\x58\x8a\xc1\xeb\xfa\x53\x0c\x0a\x5e\xff\xb5\x24\x91\x24\x4f\x8c\x16\xaf\xff\x63\xa2\x90\x0b\xc9\xa7\xe6\xf0\x6b\x67\xac\x9f\x40\x5b\x7c\x86\x38\x5f\xbd\x01\xbf\xa0\x61\x12\xaa\x52\x74\x9e\xfe\x16\x79\x9f\xa7\xa3\x5e\x41\x3f\x27\x94\xb3\x89\x1a\x4b\xc8\x3a\xc5\x79\x18\xe8\xb5\x36\xaf\x59\xcc\x8a\x32\xd0\x73\x5b\xba\xb1\xb9\x09\x4e\x99\xf1\x0e\xb9\x95\x88\xd8\x79\xd4\xe6\x9f\x83\xa1\xa6\xed\x44\x0a\x4e\xdb\x38\xce\xee\xa9\x20\x5f\x5a\x66\xd0\x43\xa5\x77\xca\x7c\x87\x4b\x9b\xd0\x3f\xe8\xd2\x42\xe7\xf4\xa9\xef\xe4\x00\x1d\x23\xce\x99\x69\x7b\xea\xfb\xf2\x85\x2a\xa1\x0f\x36\x56\xbc\x50\x75\xf6\x23\xdc\xee\xf8\x1f\x72\x97\x50\xd5\xd9\x21\xf5\x59\x99\x21\xda\x21\x6d\x22\x9f\x05\xed\x77\xb5\xec\x5b\xb4\x0e\x36\x92\xdf\x33\x91\x94\xd3\xf0\xf0\x6d\x64\x9b\xde\x28\x1a\x23\x10\x1d\x2a\x1d\x36\x3d\x26\x95\xa9\x11\xc7\xbb\x7a\x6d\x43\x5b\xa1\xc7\x41\xd3\xe2\xf5\x6d\xad\xdc\x19\x79\x90\xf5\x05\x3a\x34\x2e\xf8\xf5\xbf\x82\x96\x56\x3d\x40\x48\x23\x79\xee\xc3\x1a\xc1\xa6\x86\x84\xdf\xc9\xd7\xd1\x29\x24\x01\x1d\x46\x1a\x09\xfb\x66\xd3\xe3\x65\x2c\x97\x68\x65\x9d\x53\xd5\xd3\x8b\xd9\xd6\x82\xb8\xcb\x37\xcd\x70\x46\x5e\xe8\x57\x73\x82\x08\x0b\xb1\xa6\xc8\xb1\xee\x3e\xab\x75\xca\x0c\xa1\xdb\x7c\x57\xb1\x9f\xc8\x43\x5e\x94\x9b\xcd\x41\xaa\x34\xef\xdb\xb4\x12\x3d\x80\x3d\xd6\xb0\xb8\x67\x62\xcb\x26\xab\xa7\x22\xd1\x14\x33\xfe\x3a\x8d\xbe\x52\x70\xd8\x48\x5d\xb0\x7e\xa0\x74\xab\x27\xf8\x43\xb3\xba\x49\xfc\x30\x08\xdf\xe9\xde\x9e\x6d\x6b\xe1\x80\x88\x4e\x3b\x4a\x77\x9c\x1d\xd0\x7c\x2c\x60\x22\x0e\xd8\x74\x38\x6b\xdb\xa0\x4d\x15\x24\xdd\x37\xd6\xc6\x8d\xb9\xfe\xc4\x98\xbf\xa3\x72\x67\x80\x5c\xda\x6c\xcc\x39\xea\x04\x0e\x9d\xb9\x58\x44\x31\x4b\x9b\x40\x57\x8b\x84\xd3\x8f\x74\xb3\x51\xb4\xc2\x42\x0d\xfe\x22\x0d\x8e\x6b\x1b\xd6\x2b\x9b\xed\x91\x19\xe6\x6a\xe5\x90\x6b\x50\x07\x36\xd6\x5e\xbf\x63\xb1\xd4\xf2\xa2\x27\x10\x2f\x67\x8f\xec\x9d\xf9\xc9\xd6\xde\xd7\xe5\xb7\xa3\x21\x61\x23\xc8\xdf\xa4\x3e\x7d\x86\xac\xce\xa1\xbb\x14\x66\xe5\x90\xfb\x32\xd2\x21\x72\xfb\x2a\x3a\x73\x2c\xe9\xdf\xbd\xfe\x7b\x40\x45\x02\x7b\x7d\xb6\x53\x51\x87\x94\xe7\xbd\xc3\x45\x9f\x1d\xb4\x66\xad\x40\xf8\xb3\xd4\x9b\xc7\x3e\xd7\x6b\x1b\xee\xe7\xb2\xdc\x19\x55\xc4\x6c\xee\x92\x4b\x1a\xd6\x51\xd3\x50\x66\xfa\x55\xe6\x85\xaa\x20\x9d\xcc\xfd\xdd\x46\xbc\xe9\x17\xaa\xb1\x39\x01\x55\xc5\x91\xea\xd9\xb0\x4c\x76\xaa\xb9\xb4\xc9\x70\x08\xb7\x2b\x0b\xeb\xda\xc9\x58\x73\xf3\x7b\x39\x95\x13\x88\x8d\x4c\x53\x0d\xf6\x3f\x51\xca\xb6\xe5\xc4\x48\x4b\x65\x37\xe2\x78\x68\xd3\xb1\xdc\xc5\x0a\x4d\x77\x8a\x2d\xed\x0d\xf5\x58\xcb\xc5\x72\xfe\x46\x63\xc6\x10\x77\xfa\x66\x52\x61\x0e\xb2\x4d\xaf\xa5\x00\x1e\x21\xd4\x44\x16\xa7\x2c\x90\x88\xea\xe5\x8d\xf4\x13\x28\xa8\x80\x77\x82\x1a\x83\x1a\x97\x3c\x25\x8c\xaa\xfa\x1b\x3d\x15\x53\xfd\xc4\x21\x9e\x56\x5a\xaa\x99\xa6\xfa\xce\x6e\x94\x7e\xa3\xae\x2b\xd1\xa8\x8e\x54\xac\xa0\xda\xec\x7c\xb4\xa5\xe9\xb6\x0e\xd7\xf1\x3c\xf2\x40\x21\x92\x07\xf2\x15\x46\x60\xd6\x9f\x4b\x25\xc9\xa5\x02\xa1\x15\x12\x13\xa4\x5c\x8e\xdb\x51\x9f\xef\xe5\xce\x34\x97\x20\x68\xd0\x12\x19\xaf\x17\x37\x3f\xfc\x90\xcb\x32\xda\xd4\xe7\x78\x37\x6e\x4b\xb4\xa5\xc8\x69\x7a\x72\x4b\x03\x36\x95\x9a\x0f\xb1\xa6\xe8\x70\x4f\x2e\x54\x96\x17\xaa\xe1\x22\x40\x43\x0e\x4e\xa1\x59\xdf\xa8\xa1\x1d\x59\x1d\xf9\xf6\xfa\x13\x27\xcf\xeb\xe7\xb2\xa2\xfb\x74\x2a\xb5\x18\xc2\x4d\x1f\x58\x98\x00\xe5\xe4\x42\x09\xc9\xa3\x37\x27\x2c\x89\x0c\x70\x79\x93\x1f\xe3\x90\x9c\x39\xb9\x63\x22\xce\xeb\x20\xab\x0b\xe8\xbd\x79\x7d\x0a\xf4\xac\xc5\x2f\x78\x80\x79\xe5\x04\x73\x2e\xee\xac\x8b\xa4\x1a\x03\xcd\x4e\x98\x9e\xa3\x7d\xe6\xa6\xf0\x43\x11\x84\x3d\x88\xf5\xb2\xaf\xd9\x1e\x31\xec\x02\x01\x49\x09\x62\x1a\x93\x46\x1b\xd1\xa6\xa8\xb6\x37\x4e\xc1\x37\x2c\x6e\x76\x0c\x1a\x41\xb5\x29\xc7\xe3\x79\xcb\x9b\x3b\x73\x72\x4d\x85\x60\xf9\x98\xb0\x19\x98\x28\xde\xde\xd7\x2f\xb4\x2a\xde\x46\xf4\xed\x3d\x94\x2f\xa6\xb0\x07\x02\xa2\xf3\xbe\x94\xae\xae\x72\x14\x52\x30\x79\x34\xa0\xb4\x16\xb0\x6b\xfc\x6a\xa0\x19\x50\xda\xec\x87\x6e\xc2\x0b\x72\x59\xf2\x22\x03\x31\xcc\xa0\x1f\x40\x94\xf5\xbb\x6e\x7e\xfc\x03\xcd\xeb\x20\x9f\x3c\x94\x6a\x1a\x45\x99\xab\xbb\x33\xa0\x78\xfd\x5b\x48\xa5\x98\x61\x82\x34\x03\xe1\x19\xae\x6d\x0e\xfe\x96\x41\x91\xb0\xee\x1e\x2d\x7b\x61\xa1\x5d\x54\x41\xee\x99\x9e\x79\x3f\x0e\xfa\xbf\x48\x5e\xc1\x74\x7e\xbc\xb6\xdb\x18\xe1\xb7\xe6\x9b\xdd\x9c\xfb\xee\x40\xf3\x62\xf3\x7c\x4a\x4c\x76\x97\xab\x95\x4b\x3e\x27\x4c\x8e\x04\xfe\xdf\x40\xc4\x7c\xb2\x63\x00\x09\xa8\x2d\x51\xe8\x16\x44\xae\xe7\x55\x51\x0b\xa2\x8f\x81\x04\x17\x37\x95\x9f\xc1\xee\x47\x49\x3b\x79\xcb\x6a\xac\x56\xea\x58\x36\xb1\x31\xd4\x5a\x17\xea\xf5\x5f\x44\x2c\x5e\xff\x65\x22\x36\xbe\xed\x6a\xfc\x87\x54\x31\x25\xb7\xa0\x43\xa5\x5e\x30\x12\x27\xa0\x8a\xcd\xcb\x88\x07\xe0\xd2\x60\x84\x06\xb2\x9d\x7f\x50\xa1\xe1\x48\x9c\xfd\x8b\xc2\x91\x16\xa9\x58\x6f\xa2\xe5\x91\x02\x0f\x4d\x89\xd4\xcc\xb4\xc4\x33\x7f\x23\x55\xd0\x9d\xd3\xb3\x85\xdb\xab\xe8\x9c\xb6\x05\xeb\x97\xef\x79\xba\x97\x0f\x75\xa7\x52\xb1\xbc\x23\xea\xb4\x78\x85\x08\x59\x3a\xf1\xfc\xd8\x7e\x7c\x49\xd6\x10\xf7\x6a\x6d\x8e\xb7\x24\x0b\x33\x20\x1d\x6f\xa4\x9a\xec\x55\xeb\x69\x4d\xa9\xe9\x46\xff\x24\x84\x2c\x2a\x2d\x77\x56\xaa\x5e\x8d\x17\x7e\x5e\x80\xa8\xa3\x8b\x96\x5f\xe2\x59\x36\xb9\x92\x9c\xd6\xfb\xfa\x80\x7c\x0a\x4c\x06\x94\xa9\xa9\x68\xc2\x36\x42\x4f\x11\x5b\xf2\x51\x26\x22\x37\x6d\xb1\xfe\xf8\xf0\x76\x6b\x2e\xc4\x4b\x1f\xf2\xb4\xf3\xd9\x41\xb1\x18\x22\x0b\x6f\x40\x45\x74\x54\xa0\x7e\xcd\x20\x03\x6d\xbc\x30\x61\xce\xe2\x69\xb2\xba\x60\x39\x25\xef\xa3\x03\x28\xdd\x6c\xff\xc6\x5a\xd7\xf8\x88\xea\xe5\xd8\xea\xa0\xf1\x66\x50\x7a\xbe\x5a\x38\xf3\xd6\x98\x77\x44\xa1\xb9\x29\x34\xe0\xfa\x7d\x5c\xed\x8a\xf3\xde\x97\x36\xad\xcd\x93\x12\xc8\x37\xd6\xe4\xd1\xf7\x6c\x4f\x9b\xbe\xca\xf6\x80\xcb\xb1\xed\xef\x58\x1d\x3d\xf5\x36\xf7\x45\xfd\x56\xaa\x88\x72\x06\x23\x85\x0f\x1c\x06\x89\x8d\xd3\xf0\x54\x8f\xad\x27\x40\x5d\x27\x70\x78\x73\xe2\x83\x0d\x79\x02\x87\xd8\x39\x8d\x30\xac\x59\x7d\xcc\xc9\xfd\x58\xfe\x7c\x0d\x15\xec\xa6\x4d\xa0\x3d\xab\xfd\xe2\x20\x4c\x9b\x12\x55\x25\x85\xde\x2e\xb6\x5b\xbd\x1a\xbb\x43\x1d\x95\x4b\x6e\x61\x4f\xab\x91\xf8\xfe\xa7\x02\x76\xa0\x8c\xc3\xe6\x14\xdb\x88\xdd\xc3\x2a\x7c\xd0\xdf\xd4\x07\x4a\x23\x6d\x7f\x87\x01\xe1\x46\x3e\xc7\x9e\x5f\xff\x34\xaa\x7a\x60\x85\xe5\xcc\x73\xfa\x08\xa4\xe1\xb0\x5f\x52\xee\xa8\x98\x50\x13\x81\x08\x54\x6d\x75\xb5\x0e\x4d\x21\xa7\xc7\xdf\x0a\xf3\x7a\x31\x5e\xf5\xa7\x17\xeb\xac\xe5\x2b\x55\x42\x8a\x31\xc9\xdb\x9a\x89\x08\xa6\x54\x44\x34\xd8\x53\x7c\x5a\x3d\xe8\x80\xd6\x3b\x87\x88\x1c\x88\xc1\x17\x9d\xab\xda\x62\x49\xae\x69\x30\x66\xec\xfa\x7b\x76\x98\x68\xba\x0f\x11\xa7\xda\x2f\xe3\x06\x94\x32\x94\xa3\xd6\x2f\x23\xd6\x6b\x71\xe0\x57\x90\xc8\x8e\xfa\xe6\xb8\x96\x47\x1e\xa0\xd2\x2d\x87\x41\x4f\xef\x0b\x02\x05\xee\xf0\x9e\xa6\x40\x48\xda\x08\x39\x45\x34\x50\xa7\xb4\x7c\x68\xea\xf3\x46\x64\x19\x87\x47\x8e\x48\x9e\x63\xa1\xa5\xf5\x9e\x9d\x80\x31\xe7\x1a\xcd\xfd\x39\xe7\xf8\x37\xf9\x24\xd5\xe1\xbc\x8e\x41\x36\x02\x4d\xbf\x40\x46\xf9\xe9\x11\xd5\x74\x93\x77\xe6\x88\x8a\xfc\x08\x18\xaf\xea\xff\x48\x77\xf8\xba\x56\xc3\x8c\x1f\xee\x79\xb7\xaf\xff\x37\x90\xcb\xd7\xff\x4b\xe0\x8f\xf9\xca\x68\x21\x20\x3b\xef\xcd\xcd\x75\x95\x57\x00\xf9\x50\x72\xae\x4f\xa5\x75\xca\x76\x8d\x57\x46\xb4\xc1\xe5\x98\xfa\x10\x45\x85\x04\xd1\xb5\x8a\x5c\xf2\x3e\x8e\xa9\x88\x40\x15\xa7\x5d\xbd\x47\x1c\x03\x9e\x4c\x41\xba\xd2\x75\x0c\x41\x89\x96\xdb\x63\x6c\xd3\x88\xa4\x20\xc1\xb5\x78\xe3\x73\x26\x52\x1a\xb1\x6e\xe4\x68\x86\x48\xaa\x06\xa6\x38\x68\x37\x7c\xa1\x2a\xc1\xb7\xf0\x03\x13\x67\xff\xa8\x90\x60\x8a\x87\x6e\x2b\x25\xfd\x59\x4a\x2d\xa7\xd9\xa2\x94\x34\x8e\xb5\xaa\x6d\x27\x8b\xae\x9b\x6c\xff\x27\x64\x95\xef\x65\x31\x11\x8f\xd5\x46\x50\xe9\xfb\x8c\xf1\xfe\xb0\x51\x3f\x57\xa4\x7a\xd8\x28\x4e\xfc\x9c\x09\xe8\xf7\x5b\x9d\xd6\x62\x62\x28\x41\xe4\x10\xe7\x05\x6c\x36\x6f\x26\x74\x00\xb1\x11\x55\x5a\x47\x7b\x82\x3c\x40\xeb\x53\x50\x68\x65\x5d\x9e\xd5\x4b\x31\x3b\xf5\x0a\x9a\xe9\xda\xcb\xc8\x59\xfb\x05\x72\x9a\x4c\x77\xd2\x22\x9f\x54\x8f\x3a\xb4\x8e\x97\x18\xc7\xc4\x4d\x45\x29\x0d\x9b\xf5\x78\xeb\x97\x82\xe6\xa1\x6c\x65\xf3\x33\xdb\xc6\xa3\x8d\xe5\x52\x8c\x94\x3f\x9f\x20\xd0\x6f\xe0\x4f\x7b\xa9\x2a\x72\x25\x21\x3f\x6f\xfe\x81\x68\xd2\x2f\x49\xc5\x39\xcb\xc9\xad\xe4\x59\xfb\x65\x65\x2f\x52\xd7\x21\x76\x09\x2e\xc7\xa9\xbf\x83\x98\x09\x1a\x33\xe0\xad\x69\xd0\x6a\xe1\xda\xcb\x96\x06\x3d\x52\xf4\x64\x2a\x4f\xe5\x54\x75\xc0\x95\xd7\x1a\x2f\x7e\x62\x61\x22\x79\x93\xa3\x33\xd1\x03\x02\x0b\x7d\x29\xe6\x7e\x00\x22\x0a\x21\xdb\xb5\x79\x88\x63\x1f\x03\x66\x4f\xe7\x72\xbe\x81\x2c\x45\xf4\xfa\xc7\x37\x1a\x69\x41\xcf\x3d\xfa\xb6\xd2\x9a\x8d\xac\xa4\xbc\x07\xb2\xa8\x43\xdb\xc8\x38\xd2\xe2\x62\x9c\x9d\x7e\x6e\xb6\x35\xfb\x2d\xd3\xcc\xe6\xde\xde\x73\xc8\xf3\xd7\x3f\xeb\xbc\xff\xfc\x43\xa6\xce\x6c\xd6\x0b\x71\x1f\x2a\x33\x64\xf4\x5d\x96\x1b\x4a\xf1\x44\x0b\xb3\x7a\x31\x16\xbf\xc5\xf7\x59\x2c\x3d\x97\x5c\x33\x72\x2f\x15\x2d\x64\x5b\xfd\x5c\xd8\xcb\xd9\xdb\xe5\x72\xa9\xff\x52\x01\x7d\xfd\x57\x2d\x69\x9c\xcc\xea\xc4\x41\x86\xa9\xb6\xd1\x6c\xdb\x96\xfd\x2f\x30\xc1\xbe\x65\x2c\x7d\x44\x35\xd7\x91\x49\xdb\x4b\x77\x3d\x9b\xdc\x31\xce\x23\x3a\x2a\x7e\xb8\x97\x62\x07\x92\x5c\xc3\x16\xa6\xdb\x42\x1d\x64\x99\xde\x81\xa2\xa2\x57\x18\xbd\x84\x20\xe0\xd4\x40\xdf\x52\x53\x19\xdd\xf9\x59\x95\xff\xe8\x48\x46\xe4\x23\xad\x43\xb3\xc1\x10\x74\x5c\x02\xb9\xe4\x4d\x33\x6c\x12\xd6\xb8\x83\x54\xd3\x4b\x19\x04\x15\x79\x08\x23\x6c\xa7\xbf\x19\x8c\xfc\x05\x99\xb9\x14\xff\xf0\x69\xa9\xe4\x0e\xde\x51\x5d\x53\xb4\x16\x8b\x19\xb9\x01\x4e\xbb\x63\x7c\xe6\xb4\xee\xdd\x04\xcb\xf3\x9f\xcc\xa4\xdf\xf9\x7b\x98\x0e\xe2\x4d\x35\x20\xb3\x45\x5d\xa0\xd4\xde\x4c\xad\x17\x08\xbb\x88\x95\x0f\x2a\xab\xde\x65\x7a\xd7\xb7\xbc\x19\xb9\x91\x3c\xa2\x82\xdc\x53\xd8\x0c\x9e\xdb\x5f\xfe\xab\x90\xdb\xbf\xfc\xb7\x37\x53\x50\xfa\x1c\x84\x9a\x1a\x8c\xdd\x03\x2b\xc2\xc4\x28\xa7\xea\x2f\x2d\x92\xda\x92\x3c\x33\xeb\x71\x7e\x34\x60\x35\x5f\xcd\xe7\xf3\x16\xe2\x31\xac\x6f\x80\x82\x1f\x4c\xb0\x3c\x29\x26\xab\x71\x38\x48\x34\x7d\xac\x43\xbe\x87\x30\xd4\x27\xae\xf9\xc4\x1a\x73\x28\x95\xd5\x17\xe2\x62\x58\xc1\x59\xcc\x3c\xbb\xb1\x6a\x3c\xad\x6f\x5f\x25\x7f\xfd\x8f\x7f\x26\x0f\x7f\xfd\x8f\xff\x83\x4d\x94\xa4\x39\x08\x35\xd5\x9a\xd0\x6f\x8c\x73\x06\x59\xa3\xa0\x6a\xf0\x32\xe2\xd0\xae\xc7\xa5\xbf\x53\x82\x1e\xf2\x43\x6f\x9f\xb4\xac\x3a\xab\x3e\x88\x04\x54\x34\x74\xaf\x7d\x62\x0a\x76\x13\xcd\x9d\x3a\x88\x33\xed\xf1\xf9\x4c\xb8\xfc\xdc\xd5\xdd\x82\xa8\x14\x22\xde\xfb\x62\x97\x37\x02\x60\x6f\x61\x2d\x1a\x97\x90\x61\x5e\x73\xc1\xb8\xce\xbd\x1f\x40\xe5\x09\x70\x4e\x7e\xca\xeb\x7c\xed\xac\x55\x52\x47\x53\x4d\x2b\x10\xe4\x46\x8a\x17\xe0\x66\xe2\x94\x3d\xcb\x40\x33\x4b\x54\x6c\xd6\xe3\x83\x1f\x52\x91\x97\xed\xed\xcd\x3c\x7b\xd1\x54\x43\x4e\x1f\x56\xe7\x36\xf1\x51\x86\x72\xc7\x0c\xc1\x69\x9a\x33\x00\x89\xa6\x38\xaa\x79\xc1\xf7\xa0\xba\x71\x76\xd3\x94\x00\xd0\xcb\xf1\xb3\x2f\xa0\x28\x7b\x2f\xe4\xdc\xb2\x16\x64\x1d\x26\x9c\x65\x31\x1d\x8e\xb1\x5c\xfe\xfa\x8b\x60\xd5\xaf\xbf\xe8\x5e\x59\x75\x66\x94\x9d\x83\x44\x53\x23\x11\xfb\x06\x4a\x9d\x36\x9c\xd5\x01\x17\xe3\xca\x17\x49\xde\xe0\x68\x1d\x77\xa1\x89\x9f\xb4\x28\x46\xda\x64\x62\x5b\x8a\x98\xdc\xb1\x68\x42\xee\x9b\x83\x2c\xd3\x46\xd5\xb7\x07\x3d\x95\xf3\x40\xa9\x56\x39\x47\xb4\x5e\x8a\x5f\xfc\x03\xcb\xdb\xf1\xaa\x99\x67\x61\x12\x30\x6c\x45\x3c\x95\x22\x8e\xa4\x9a\xa4\x82\xe0\x20\xac\xf4\x1a\xf6\x2c\xea\x55\x19\x6f\xa8\x4e\x49\x23\x5d\x64\x4c\x66\x7e\x4e\xa1\x8e\xf2\x0b\x96\x75\xfd\x66\xdb\x25\x9f\x64\x40\xf9\xe0\x7c\x7e\x92\xe9\xaf\xbf\xe8\x12\x58\x19\x9d\xf9\x78\x9e\x6b\x93\xfc\x3a\x8b\xec\xe1\x2e\xaf\x64\xdc\x34\xf8\xb6\x86\x76\x99\xcc\xfd\x7a\x7b\xdf\x33\x41\xbb\x38\xb1\xfe\xfb\x4b\x99\x8e\xa4\x31\xf7\x60\x0c\xe4\x27\xea\x16\x39\xc8\x29\x7d\xa4\x01\x0d\x43\x40\x7e\x6e\x37\x8e\xd3\xf4\xf8\x14\x42\x74\x55\xb2\xf0\x41\xe4\x07\xaa\x7a\x52\x1b\xcf\xb5\x1b\x26\xf7\x49\x8d\xf1\x46\x8a\x58\xc8\x89\x4a\x8c\x0e\xf2\x49\xdf\x73\xf6\x02\x01\x2d\x92\x96\x5d\xd7\xd7\xef\xd1\x50\xca\x34\x59\xfa\x19\x55\x8a\x41\xf6\xf6\x40\x83\xbc\xcf\x52\xf7\x66\xae\xdd\x9f\x39\x1a\xc8\x88\x5e\x7f\x89\x99\xcc\xc9\x7a\x57\xa9\xd7\x7f\x8b\xa6\xa2\x1d\x39\x48\x2a\x6d\x9a\x98\x4d\x2c\xdc\xb3\xa7\x01\x0c\x85\x13\xcb\xdf\x41\xd1\xcd\xf1\x39\xae\x4d\xae\xe9\xf3\x78\xe1\xfb\x13\xa4\xd3\xa6\x64\xc8\x2a\x35\x28\x3b\x94\x0d\x98\x81\x3e\xba\x6f\x07\x8f\xf2\xad\x5e\x4f\xec\x01\xd8\xdf\x5e\x2e\x56\xe4\x0a\x38\x03\x11\x0f\x6a\xdf\xef\xa3\xd7\x3f\x82\x29\x43\x50\x25\xe5\x99\xb7\x11\xc4\x78\x50\x10\xe4\xb6\x9d\x3d\x6a\xa6\x07\xb6\x09\x2e\x25\xce\x7f\x62\x2d\x67\x2f\x9d\x85\x4d\x2e\xeb\x9f\x56\xd0\x6c\xf8\x4e\x5e\x03\x9f\xa6\x64\xec\x20\xb2\xf4\x22\x82\xac\x07\xba\xe8\x55\x43\x40\xa3\x2e\x12\xd7\x0f\x13\x25\x85\xa4\x22\xee\xed\x90\xce\xd2\x26\x4f\x54\x08\x9a\xe7\xb4\xa3\xda\x39\x36\x71\xd0\x8f\xfd\xf6\xf5\x5f\xf2\x9c\xf2\x00\x97\xcf\x2f\xcd\x71\x90\x5b\x8a\x13\x63\xf5\xf7\x8d\xaf\xe2\xd7\xce\x8d\x21\x47\x84\x78\xe2\xf9\x79\x86\x9f\xd7\x3b\xd0\x44\x78\x6b\x3e\x6f\x86\x75\xc6\xc8\x91\x5f\xd9\xeb\xbf\xca\x7c\xa2\x7e\xba\x83\xe4\x52\x8d\x62\xfd\x08\xa1\x0c\xba\xd2\xa3\x39\xcf\xc4\x16\x97\x93\x95\x9f\x84\x4b\x67\xd6\x3e\x29\xa7\x1d\x66\x19\x04\x1c\x6b\x10\x05\x7a\x6a\x6b\x86\x93\x2a\xe3\xf2\xbc\xcd\x40\x07\x71\xa5\x8d\x7f\x63\x37\xb1\xd8\x33\x2f\xd7\xe3\x8a\x09\x8c\x47\x1e\xf3\xa5\x75\x32\x45\x70\x2a\xdf\xbb\x29\xe5\x64\xc6\xd8\x0e\x32\x4c\x4d\x78\xd8\x01\xd3\x7e\x96\xd2\x40\xb7\x22\x4d\x4b\x4b\x82\x63\x04\x9c\x6d\x59\x08\xb7\x8b\x68\x36\xb4\x2f\x67\x51\xf5\xeb\x2f\x6f\xb4\x20\x87\xe6\x05\x13\x0c\xb4\xa6\x80\x15\x52\x9d\xf9\xe9\xd9\x0d\xb7\xef\x68\x30\x73\xb7\xd3\xb1\xba\xd2\xa3\x99\x49\xe8\xef\xd3\x6e\x64\x6c\x36\xeb\x1f\xd2\x83\x6e\xfb\x1d\x15\x79\x28\x75\xcb\xe9\x16\x58\x71\x56\x6b\x6c\x07\x01\xa6\x17\x79\xc2\x69\x45\xae\x19\xbc\xe8\xf4\xb7\xc9\xbe\x22\x06\x2f\x49\xe4\x97\x19\x0b\x93\x26\x9c\x9f\xdb\xa6\x0f\x33\xc8\xb9\xbe\x32\xce\x81\xbc\xfe\x22\x62\xe3\x7b\x7d\xa1\x62\xaa\x95\x6f\x67\xbd\xa5\x66\x8a\x25\x81\xde\x17\xf6\x04\x1c\x9a\x29\x16\xfd\x85\xd1\x13\x15\xa9\x4b\xae\x55\x15\xd1\xb1\xc1\xc5\x1b\xc8\x02\x50\x5b\x28\xa6\x83\x5b\x38\x08\x30\x7d\xaf\x58\xd8\x0f\xef\x9f\x7a\xb6\x9a\xb4\x89\xef\x37\xfe\x8e\x03\x32\x4c\x8d\xd4\x77\xbe\x9a\xcd\xba\x70\x63\x08\x47\xdb\x4b\x25\xf7\x78\x6b\x97\x32\x17\x0c\x08\x08\x24\xcc\xbd\xd0\x58\xee\xcf\xfe\xf8\x56\x1d\x55\xa0\x6b\xc9\x34\xca\x1c\xd3\x90\x49\xe2\x31\x47\x62\x7b\x3e\x5f\x19\x19\x42\x17\x78\xac\x9c\x39\x59\x3a\xfa\xe6\x22\x2a\x26\x3a\xa0\x11\x62\x7a\x49\x8b\xa2\x22\x6b\x96\xe1\x59\xf6\xb1\x31\x59\x0e\x72\x96\xe5\x49\xe2\x87\x2c\x0f\x65\x87\xb1\xa8\x73\x66\x64\x00\x0d\xdb\x12\x3f\x89\x3d\x55\x19\xd5\x71\xe6\xf9\xe7\x3c\x1c\xe4\x97\xbe\xaf\xc3\xa5\xa2\x37\x7b\xd4\x23\x90\x50\x3d\x7a\x94\xb0\xa1\x4b\x92\x6b\xdb\xbd\x7e\xe7\xb0\x5d\x7d\x0f\x39\x37\xb3\xa6\x57\x4a\x42\x71\xe6\xcf\x0c\x29\xa6\x37\x5c\x2a\x06\xe4\x0b\xd4\xdb\x76\xdb\x81\x7f\x6e\x9a\x12\xf1\xae\xb9\x90\x6c\x4f\x61\xd5\xf6\xa2\x7e\x09\x1b\x5e\xc2\x49\x68\xff\xb1\x84\x09\x77\x10\x4d\x33\x15\x02\xda\x57\xb0\x85\x0a\x00\xbe\x82\xc3\x81\x67\x77\xe1\x78\x0b\x72\x5f\xd6\xcf\x6b\x50\x92\xfa\x00\x22\x9d\x4a\xa2\xe3\x20\xb4\xb4\x87\x34\x6a\x6a\x6c\xef\xa3\x98\x1e\x8c\xf6\x28\xd3\x55\xb6\x84\x1f\x8f\xa8\x93\x66\x40\x76\xd4\x03\xa3\xfc\xc1\x26\x2b\x0a\x20\xb2\xf4\x51\xe6\x94\x5c\x96\x2a\xc5\x7b\x38\x62\x59\xa8\xa0\x5e\x4e\x32\x1f\x54\x98\xb0\x3d\x6d\x65\x12\xf3\x79\xfd\xbf\x50\xf2\x90\x0f\x41\x6f\x1c\x0a\x88\x9b\xea\xfc\x44\x35\x1c\x44\x97\xea\xff\x38\x0e\x03\xeb\x60\xbe\xdf\xc1\xdc\xd1\x7a\x39\x11\x3e\xec\x76\x9d\x9b\xf7\x72\xb5\x58\x90\x6b\xb9\xa7\x05\x30\x7e\xfa\x35\x7d\x81\x9c\xd5\xcf\x7f\x3a\x6e\xa9\xb3\x74\x9b\x07\xf6\x0d\x62\xd1\xa9\xdf\xba\x9e\xd1\x01\xd7\x13\x79\xfa\xc8\x16\xcb\xd6\x5b\xa1\x0d\xe1\xbd\xb9\x45\x2c\x3d\x57\x94\x96\x45\x9e\xbe\xfe\x8b\x9a\xea\xb8\xf2\x74\xaf\xaf\x22\x97\x4a\xca\x34\x6f\xde\xc4\xa6\xcf\x17\xe0\x6a\xb2\xf3\x05\xe4\x2d\x55\xa5\x4f\x84\x3c\x8d\x0d\xd7\x09\x88\x78\x33\x15\x78\xc4\x41\x38\xa9\x9e\xc2\x79\x90\x52\x7f\x4c\xeb\xb4\x32\xc5\xde\xac\x5e\x4a\x7e\xf8\xf4\xad\xa2\x61\x52\x2c\x2c\x83\xb9\x74\x96\xe4\x03\x70\x8e\xb8\xba\xbe\xa8\x74\xe1\x2e\x66\x3a\xaf\xa1\x45\x7d\x0e\xeb\xe7\xfe\x89\x16\x89\x06\x16\x9d\xf7\xdb\x42\x36\xa9\xbe\xb7\x76\x1a\xbd\x87\x3c\x8b\x62\x05\x55\xa2\x8e\x25\xc0\x0b\x67\x46\x1e\x58\x98\xb0\x78\xd4\x07\x63\x5d\x80\x82\x0a\xc8\x03\x54\x53\xd9\x61\x38\x48\x26\xd5\xa2\x87\x1b\xc5\x36\x1b\xd6\x8c\xe5\x34\xbb\x7b\x1a\xeb\xe5\x24\xf7\x0f\x34\xa8\x3f\x91\xbe\x68\x76\xe1\xea\x11\xcb\x90\x8a\x82\x7c\x86\x74\x6c\x78\xf6\x1b\xe3\xa9\x3c\x18\x8d\xc0\xf9\xf9\x2a\x0e\x82\x4a\xef\xe8\x9e\x89\x1e\x13\xec\x2b\xdb\x6b\x55\x9c\xe6\x81\x25\x85\x9f\x31\x56\x04\xb4\x8e\x73\x63\xd9\xcc\x88\xad\x16\x1e\xd6\x1d\xc9\x37\xe0\x7c\x58\x04\xb8\x92\x22\xa4\xbb\x90\xbd\xfe\x59\x90\x88\x92\x7b\x20\x5f\x69\x8c\x3f\xf6\x5a\x66\x4c\xb0\x10\xc4\x24\xe0\x63\x07\x91\xa6\xf7\xb2\xfe\x0e\x1b\xb6\xa2\x86\x63\x36\x43\x64\x1c\x01\x8b\x49\xe9\x33\xce\x99\x90\x2c\x6f\xf3\x4e\x8f\xbc\xe7\xe9\xb0\xbf\xfe\x04\x5b\xf5\xeb\x9f\x72\x5d\x0b\x56\x67\x6e\xba\x20\xc4\xf4\x13\x0b\x25\xa7\x47\xd3\x63\x5f\x99\xf1\x0b\x6a\x26\xc7\x92\x7d\x37\x55\xd5\xcc\x1e\x99\x82\xc7\xa8\xe9\x02\x07\x11\x4c\x7a\xb2\x21\xca\xf4\x23\xcd\x73\x16\x02\x8e\x56\x86\xed\xe9\x66\x2c\x33\xb6\xb9\x5e\x4d\x0e\xfe\x0e\x62\x9a\xef\xea\x88\xf8\xad\x44\x3f\xfc\x77\x1b\xfc\x97\xed\xd9\x92\x7c\x7e\x46\xd0\xc0\x29\xb6\x09\x38\x14\x52\xc4\xe9\x34\x96\xca\x8e\xe5\x74\x07\x37\x6f\x12\xe2\x2f\xec\x59\xee\x5a\xc3\xd0\x7a\x35\x79\x3e\x95\x0f\xbb\xce\x62\xe9\x36\x25\xff\x71\x2b\x4d\x96\x99\x27\x37\x11\x86\xd6\x41\x84\xe9\x47\x10\xb4\x3f\x87\x64\xea\x8b\xdb\x8d\x7c\x4e\x2a\x1f\x67\x0c\x0c\x17\xde\x5d\x2e\x5b\xba\xd6\x38\x33\xac\xcc\x93\x69\x18\x83\x0e\x92\x4b\xf5\xc0\x62\xaf\xf7\xd7\xec\x91\xd4\xf4\xfd\x5e\x8e\xcd\x17\x16\x73\x4b\x23\xc8\x07\x9b\xc5\x3a\x01\x39\x99\x9d\x84\x63\x69\x0f\x38\xa8\x3f\x68\xd2\x68\x4b\xde\x34\x46\x70\xda\x4d\x32\x33\xcb\x6c\xe6\x43\x10\xbe\x13\xb4\x30\xd5\x7a\x77\x61\x5b\x16\xb9\xa6\x9c\xac\x25\x1f\xee\x82\x4c\x94\xd9\xae\x9c\x8e\xe7\xec\x20\x96\x74\xd8\xd0\xfc\x08\x5c\x6f\x17\xd8\xcd\x64\x73\x3f\xa7\xa2\x4e\x98\x4d\xcc\x68\xaf\x1c\x8b\xdc\x02\xe7\x75\xea\x32\x2c\x74\x3c\x81\x28\xb3\x69\x5c\x69\x1d\x04\x90\x9a\xd2\x68\x97\x5f\xf6\xc6\xde\x40\xa7\x97\x6c\x71\xfc\xf6\xd9\xf6\xd2\x70\x10\x46\xbe\xa5\x4b\x10\x11\x28\x0e\xd9\x6e\x4a\x20\xb0\x63\xf7\x41\x1d\x97\x20\x74\x84\x8f\xc6\x26\x6d\xea\x02\x41\xbd\xce\x96\x7e\x2e\x63\xd9\x95\xea\x17\xd6\x6a\x41\x1e\x65\x91\xf4\xd1\x89\x2b\x97\xac\xb0\xc6\xf6\xfa\x5f\x8a\x3d\x8b\x20\xa0\x3a\xcb\x99\x20\x75\x41\x2a\xe9\x13\x55\xaa\x22\x8f\x4d\x34\x9c\x19\x13\x82\x42\x41\xc5\x2c\x9f\x25\xed\xc3\xf2\x96\x8e\x65\xb0\x15\xdd\xdb\xb7\x74\x96\xb3\xb7\x4b\x5b\x27\x99\xaf\x7f\xde\x4b\xa8\x83\xa8\x2f\x54\x98\xba\xf6\x24\x22\x67\xdb\xea\xe0\x4c\xc7\x04\xe7\xfe\xb8\x55\x8f\xe0\xcc\x6c\x3f\x4f\xab\x5d\x27\x99\x9a\x59\xf5\x7b\x1c\x70\x3a\xa6\x56\xff\xc8\x60\x2a\x16\x9a\x83\x7c\x52\x74\x51\x5b\x87\x49\xc6\x22\x7c\xa7\x8c\xd7\x18\xc2\x70\xf0\xd9\xe5\xfa\x1a\x73\xfc\x0c\x2a\x19\xf2\x3a\x8c\x6d\x05\xa6\xad\xd5\xce\x50\x2b\xf0\x04\x09\xa8\xa9\xf6\x10\xa7\x21\x5a\xb4\xe1\xee\x13\xc4\x72\xa7\x37\x7b\x1d\xeb\x32\xf7\xf8\x39\xcd\x66\x27\xe8\xfe\x91\xfc\x0c\xa2\x49\x13\x17\x0d\x28\x35\x46\xe4\x1a\x3e\xf2\xc6\xe8\xc0\x0c\x58\x2b\x8f\x71\x95\x79\x7e\xc4\xf2\xb0\x7c\x69\xe6\x34\x2d\xcf\x72\x57\xe4\x03\x97\x0d\x02\x74\x64\x8b\xa4\xc6\x83\x76\x82\x17\xd1\x6b\x24\x02\xba\x01\xa1\x35\x61\x10\x34\xc9\x09\xe4\x7a\x99\xad\x8e\x01\xe2\x8b\xa5\xe3\x68\x68\xff\xb0\xbf\x77\x95\x50\x11\xe7\xc9\x44\xfc\x11\x07\xa9\xa4\x18\xe8\xd6\xf7\x35\xe2\x0f\x07\xf5\x32\x03\xbf\xcc\x0b\x45\x21\x7b\x57\x68\x0f\xbf\x46\xce\x3c\xe8\x16\x5d\x52\x5e\x55\xe4\x3b\xa8\x89\xea\x02\x1a\x4a\xaa\x89\x0f\x37\x0a\x12\xdd\x70\x78\xdf\x40\x49\x63\x5c\x62\x81\x9f\xc8\x32\xef\xc2\x8c\xa5\x3d\x23\xeb\x50\x36\xa3\x99\xc7\x92\xd8\xbb\xbf\xfc\xb7\x30\x61\x6f\x4d\x90\x7b\xfe\x5d\x02\xc1\xa4\x86\xe1\x63\xec\x99\xdf\x9c\x16\x12\xe3\x54\x5f\x60\xe1\x89\x12\x76\x36\x5f\x34\xdc\xa5\xb6\xc0\xb1\x98\xcd\xec\xb7\x33\x3d\xa2\x83\x2a\x81\x88\x12\xd4\x3e\xa8\xd7\x3f\x66\x93\x9d\x60\x48\x27\xd5\x8a\xcb\xab\x04\x76\x19\x9c\x48\x1f\xa2\x50\xaf\xb2\xc8\x8f\x98\x14\x1d\x0d\xd2\x99\x2f\xac\x23\xd1\xc3\x70\x93\xbf\x82\xa2\xde\x33\x8d\x77\x75\x08\x0a\xe2\xf2\xcc\xef\xe5\xb2\x9d\x48\xbd\x13\x2c\x4e\xf0\xd7\xbb\x07\x1d\x78\xd0\x14\x97\x18\x1d\x74\x58\x56\xab\x85\x53\x47\xf5\x1c\x22\xd9\x8c\xea\x9c\x54\x03\x7e\x5f\x4e\xb7\x7d\x20\xa6\xf4\x0a\x14\x97\x39\xb9\xaf\x44\x88\x65\x96\xef\xd2\x28\xf7\x42\x5e\x2f\xb1\xcd\xc0\x5c\x72\xb6\x9c\x91\xcb\x32\xa9\x43\x8d\xc1\xb4\xc7\x13\x55\x54\x14\x53\xd2\x1f\x1c\x64\x93\x6a\x30\xe4\x95\x2a\x5f\xcc\xb1\x6c\xfc\x59\xd2\x50\x95\x2f\x2c\x1e\xcc\xab\x7b\x4b\x9b\xdc\xd7\x4f\x11\x77\x16\x72\x09\x63\x14\x92\x8f\x10\x29\x10\x13\xf6\x2f\x11\x4e\xda\x98\xf9\x75\x55\xc5\x8b\x7d\x83\xa5\x4b\x74\x5d\x91\x25\x7e\x22\x33\xfa\x6e\xa7\x9f\xcd\x9c\x5c\x32\x15\x26\xc8\x32\x1a\x80\x49\x21\xdd\x33\xce\xa7\x6a\x36\x3b\xbd\xe9\x15\xe0\x06\xc6\xdf\x1b\x83\x10\x07\x5c\x65\xac\x67\xe5\xe7\x2d\x5d\x6b\x49\xee\xa9\x92\x55\xa7\x04\x70\xdd\x99\xab\xd5\x7a\xb2\x6c\x0a\x75\x93\xf1\x71\x1d\x47\xcf\xd6\x86\x3f\x4a\xca\x99\xa0\x9d\x67\x1a\x66\x61\x07\xd3\xf1\xd3\xb6\x69\x6c\xeb\xe7\x3f\x4a\x50\xf4\xc8\x90\x65\xe9\x5a\x5d\x24\x35\xcc\x9d\xaf\x12\x28\x77\x2c\x03\x35\x0d\xf1\xd2\x71\x74\xe4\x51\xf5\x5a\x63\x8f\x0d\x0e\xdd\xb4\xc5\x58\xda\x99\xdb\xd9\xe4\x33\xa4\x47\x01\xa1\xe5\x3a\xb3\xb7\xce\x0c\xf3\xca\x7b\x59\xe6\xa0\x67\x72\x27\x39\xbb\x5c\x63\x42\x5b\xf5\x26\x30\x5b\x97\xb4\x4c\x4f\x5f\x32\x3e\x82\xd9\xb3\x5d\xab\x05\xa2\x8f\x04\xbb\x9f\x5e\xff\x08\xe4\x01\x84\xe4\xaf\xff\xa4\xa3\xcd\xf3\xeb\xb3\x11\x4d\x8a\x01\x2f\xb9\xe0\xf4\x19\x1a\xd9\xe8\x5d\x4f\x5c\x94\x43\x73\x85\x65\xa3\x0d\x89\xa5\xb3\x58\x90\x5b\x80\xb1\x2a\xce\x55\xa9\x58\x00\xd5\x34\xaf\x21\x32\x49\x9b\x0d\xb1\x6f\xea\xff\x50\x19\xdf\xf1\xa4\x31\xf5\x67\xc2\x87\x32\x42\xd6\x94\x29\xba\x2d\xc9\x67\x1e\x91\x1b\x28\x68\xdb\xd1\xb4\xec\x39\x59\xa1\x59\xc9\x3f\x44\x11\xdd\x03\xe7\x13\x79\x74\x3b\x08\x20\xd5\x98\x5c\x33\x21\x86\xf7\xf5\x98\x54\x2f\x9a\xeb\x9e\x9b\x01\xb1\x17\x26\xfd\x58\x94\x9d\xa9\xf5\x72\x46\xd6\x85\x2c\xe3\xa4\x90\x23\x66\x99\xbf\x67\x20\x8b\xc9\x8a\xa4\xc8\x1e\x45\x0f\xb5\x93\x61\xc5\x75\x5a\x75\xef\x23\xef\x06\x16\xd9\xce\x17\xb4\xd8\xb3\x9e\x94\xcf\xb6\x56\xb3\x15\x59\x87\x89\x94\x7c\x24\x7d\xfe\x50\x86\xc9\x44\x19\xa6\x3b\x66\x4a\xdb\x5a\xde\xa1\x27\x2d\xfb\xe1\x0b\x7a\xa8\xa4\x4a\xbb\x28\x71\x46\x96\x6a\x14\x29\x73\x2d\x45\x1c\x4d\x75\x2f\x68\x04\x87\x5b\x1f\xb9\x36\xd2\xa8\x0f\x9c\xed\x9a\x4e\x43\x44\x41\x30\xe5\xd7\x7b\x84\x2e\x4c\x2f\x6c\x07\x27\x03\xb6\x50\x8d\x74\x63\xef\x60\xa3\xc8\x1a\x0e\x78\xc3\x1f\xa5\x3a\xf7\x00\x15\xd2\x47\x2f\x19\x47\x46\xac\x1a\xad\x41\x05\x49\xbd\x53\xe4\x7e\x50\xbe\xbc\x6c\x28\x8d\x3a\x12\xe4\x82\x7c\xa1\x80\x92\xb6\x91\x87\xb4\xa6\x7b\xaa\xf2\xd4\xec\x80\x13\xe4\xcc\x6e\x63\x37\x4b\x55\x1d\x6a\xdc\x43\x23\x6a\xeb\xfc\x59\x53\x8e\x8b\xac\xe8\x3f\x2f\xcb\x71\x5c\xf2\xa5\xfe\x9f\x7c\x1d\xb5\xec\xab\xdf\xbe\x0c\xd2\x89\x06\x3a\x34\x8f\xd4\xd0\x89\x3a\x9d\xca\x0d\x8b\xa1\xb1\x22\x54\x5a\xa8\xc2\x4a\x5f\x46\x42\xa6\x1c\xf2\x5c\xb0\x94\x99\xce\x97\xb7\x5a\x91\x4b\x0e\x18\x7d\x9d\x96\x6d\xae\x7f\xfd\x43\x20\x0f\x8c\x86\x6f\xa6\x29\xb1\x79\x8d\xeb\x3d\xed\xb8\xb1\x8f\x14\x78\xc0\x21\xc0\xad\x02\xd1\xb1\x6c\xef\x2b\x1a\x85\x75\x60\xd1\x0a\x8b\xac\x39\xb9\x10\x45\x42\xf3\x91\x81\x4b\x30\x5d\x87\xa9\xd8\x46\x0e\x72\x48\x1f\x81\xef\x92\x9e\xb8\xf7\xba\xab\xb0\x29\xad\xef\x65\x87\xa1\x70\xd4\xb3\xad\xa6\x28\x35\x78\x5a\x17\x3b\x56\x18\xd5\xde\x54\x12\x37\x24\x91\x1a\xdd\x72\x0f\x6b\xf9\xb1\xcc\x76\xbf\x5f\xe3\x69\x6c\xb0\x96\xec\xf9\xa8\x78\x68\x59\x9e\xe3\x92\x6b\xa9\x8a\x11\x23\xdd\x1b\xba\x8f\x29\x67\x5b\xdc\x38\x26\xb1\xd8\x72\x10\x41\xda\x10\x8e\xba\x5a\x6f\x3f\x38\xdc\x99\x62\x2f\xe2\xb3\xab\x77\x21\xbe\xac\xd6\xd2\x25\x17\x22\x01\x5e\x8c\xcc\xa8\x80\x00\x21\x7f\x94\x53\xa5\x94\x9e\xd5\xa0\x71\xc8\x03\x14\x45\x42\x0f\x9a\xda\x04\x26\x70\x0a\x33\xb3\xca\x5e\x7c\x1c\x2d\xd2\x9a\x9b\xe5\xd2\x22\x0f\x52\x09\x26\xe2\xe1\xb7\x75\x4f\xcb\x88\x72\x30\x50\xa4\xfb\xf2\x99\x66\x81\x2c\x55\x7c\xde\x1b\xb3\x5b\x89\x6f\x41\x0f\xe6\x14\x7b\x60\x85\x11\xf9\xea\xb5\xed\xcc\x8f\xd9\x4b\x26\xa3\x56\x0e\xe6\xcd\x5d\xc7\x21\xb7\x54\x65\x4c\xc0\x70\x66\xef\xe7\x84\x8a\x30\x29\xa7\xaa\x41\x21\x7d\xf4\x9a\x81\x80\x0e\xef\x73\xcd\xf6\xbd\x17\x30\x42\xc0\xcf\x76\xee\x07\x52\xa6\x4c\xc4\x5d\x2b\x65\xe6\x90\x6b\x1a\x72\x50\x48\x83\x18\xd9\xe6\xcb\x6d\x69\x3a\xcc\x6b\x99\xc1\x99\x1d\x9e\x1d\xc4\x90\xea\x42\xd4\xa5\x82\xc8\x1c\xaf\xad\x6b\x58\x1a\xe8\xc5\x2d\x9a\x3c\x73\x19\xd0\xae\x28\x3a\xb3\x9c\xc6\x08\x79\x98\x71\xbd\xcf\x76\x34\x52\x26\x61\x9e\x66\x0c\x07\xd9\xa4\x9d\x1e\xe0\xb6\xd4\x5b\x00\x0e\x38\x73\xa6\x5d\x05\x50\xbf\xb1\x5d\x9e\xce\xab\xb8\x7a\x8a\xd1\x18\x5e\xf6\xd3\xe6\xa5\xed\xcd\x30\xf7\xaa\x7f\x5c\xde\x56\xa5\xa6\xab\xe2\x78\x6d\x7b\xe5\xd6\x50\xea\xde\x68\x93\x23\x03\x56\xa9\xcf\x33\x5c\xde\x5a\x27\x7a\x8e\xc5\xca\xb2\x30\xb3\x3c\x8c\x16\x12\x6f\x81\x4d\x65\x56\xea\x20\xb1\x14\xcd\x4a\x0f\x3d\x62\xa9\xb6\xdb\xd2\xbf\x25\x68\x62\xe9\xd6\xf6\x0b\xca\x69\xac\x60\x97\x74\xe2\xcb\xe5\x62\xa6\xfd\xd4\xdb\x7d\xdf\x72\xdd\xd9\x5b\xdb\xc1\x27\xd7\xf6\x23\x5e\xff\x59\x92\x2b\x99\x67\x74\xb2\xa2\x0e\xc2\x4b\x4d\x71\xbb\x85\x59\x7c\xe1\xad\x3b\x50\x88\x38\x8b\xad\xe3\xb3\xfc\x5d\x8c\x72\x1b\xd7\x59\x11\x44\xb2\x8c\x44\xf9\x4f\xb4\xde\x3d\x03\xb3\x4d\x4e\x53\xd8\x5e\x99\x20\x64\x97\x80\x60\x94\xac\x59\x96\xc9\xc6\x39\x37\x6e\x26\x9d\xf3\x5c\x2f\x6f\x5d\xff\x19\x5d\x49\xeb\x97\xb2\x9d\xce\x21\xf7\x65\x9a\x52\x31\x32\x3c\xfb\xeb\x7f\x09\x5f\x5e\xff\x7c\x98\x28\x20\xd6\xfc\x52\x59\x85\x94\x7c\x50\x20\x52\xf3\x75\x9c\xc2\x08\x37\xe6\xda\xd6\xfb\x4d\xac\x9d\x43\x1e\x65\x98\xd2\x0d\xc5\x41\xb1\x11\xe8\x1b\xec\xeb\xfd\xd2\xec\x2d\x4f\x8a\x09\x16\x41\x84\x43\x71\x4f\x32\x80\x58\x9e\xf7\xbe\xad\x06\x03\x64\xc4\x7c\xed\xe9\xa0\x36\xf2\x79\xbb\xf2\x69\xd0\xf5\x5d\xec\x99\x86\x54\x0f\xf7\x93\xc7\x2a\x00\xf5\x32\xa5\x68\x00\xb1\xa6\x5a\x98\xd3\x0e\xa9\x1f\xd5\xba\x0b\x1c\x53\xdf\x82\xdf\x69\x58\xea\x1f\xdc\x26\xd9\x8b\xf9\x92\x3c\x20\x72\x21\xa5\x47\xca\xcb\xa5\x3b\x9b\x3b\x7d\xee\xdb\x93\x2c\x35\xed\x70\x02\xf0\xdb\xaa\x67\xc8\xb2\xc6\x8a\xa9\x76\x90\x37\x92\xc5\x38\xd7\x6b\xdb\xc0\xcf\x59\xb6\xe3\x34\x83\x30\xa9\x5f\xd3\x26\x7f\x5b\xd9\xe4\x4a\x16\x85\x14\xd8\x8b\x19\xa4\xdc\x1f\xa1\x60\x93\xba\xac\x3b\x88\x3c\xd5\x6d\xdc\x6f\x94\xeb\x8e\xe0\x0d\x04\x7b\xa6\x53\x97\xe8\x50\x2f\x6e\x43\x3f\x29\x63\x1a\xc9\x0c\x98\xe8\x48\xf7\x96\x45\xd6\x3b\x99\x88\xb6\xc7\xb4\x98\xcf\xe6\x06\xd9\xa7\xc3\xba\xad\x39\x6c\x26\x78\x70\xa6\x21\x93\x92\x4b\x0e\x21\xfe\x76\x47\x23\x2f\xf5\xe2\x36\xf2\x73\xc8\xf2\xb2\x0b\x31\x9d\xa5\x47\x6e\x25\xa8\x68\x98\xe5\xac\x21\x0e\xa0\x9a\xd4\x6a\xc1\x41\xc0\xa9\xf6\xce\x7c\x2a\x95\x69\xc3\x7c\x95\x75\x16\x8a\x99\x5b\x81\x8b\x5b\xea\x67\x90\x27\x10\x74\xfa\xf4\x95\x37\x23\x97\xa0\x82\x6a\x38\xca\x43\x33\x50\x53\xb6\x6f\x5d\x24\x9b\x3e\xb2\x30\xa9\xff\xec\x57\x52\xee\xf4\x6d\x1d\xf9\x81\xaa\x10\xd7\xb7\x9b\x46\x77\xaf\x79\xbb\xee\xc2\x23\xeb\x84\xaa\x6c\xc4\xaa\xf0\x9e\x72\x9a\xca\xbd\xd9\x21\xaf\x5e\x68\x98\x4c\x32\x24\xe1\xce\x7a\x71\xc9\x83\x54\x0d\xfa\xad\xf7\x91\x85\x99\x5e\xde\xc6\xfe\x46\x31\x2a\xa2\x7e\x35\x72\xb1\x58\xce\xc9\x85\x82\x1d\x24\x72\x68\x33\xb9\x2e\xd9\x64\x72\x02\x57\x93\x4c\x3b\x2b\x2e\x1d\x61\xac\x53\xd6\x19\x71\xa9\x62\x9b\xf8\x10\xc8\xb2\x78\xa7\x43\x43\x77\x69\x2f\x7b\x45\xc8\xfe\xc6\xef\xce\xe6\x9e\xfb\xc6\x28\xed\x3f\x2b\x4e\x4d\x0c\x37\x59\x3e\xe0\x22\xd4\x54\xdb\xba\x1e\xf5\xd2\x30\xed\x69\x02\xb0\xa8\x6d\xa6\x6d\xd9\x6f\x45\x29\x4b\xf2\x95\xb2\x22\x19\x6d\x1a\x3e\x42\x24\xb3\x69\x4e\x71\x57\x73\x4d\xa9\xa2\x39\xb4\xc0\x88\x7b\x86\x21\x97\xd1\x69\x46\x0c\x5e\xb6\x5b\x7f\x17\x74\x25\xc9\xd9\xca\xd1\x19\xc1\x08\x6b\x31\xdb\x51\xad\xce\x9c\x68\x2f\xb1\x4d\x9d\x55\xdb\xb6\x1c\x8c\x85\x41\xa7\x97\xc8\x36\xb8\xb8\x4d\xfd\x8d\xa2\xf4\x40\x83\xde\x69\x36\x23\x5f\x59\x34\x52\xb6\xbb\xac\x37\xc8\xc9\x42\x65\x57\xa3\x4c\x65\xc0\x44\x3f\x03\x78\x0f\x06\x3d\xab\x9a\xf8\x9f\xfb\x59\x2e\xba\xbb\xb1\x57\x8b\x7a\x6f\xac\x23\x8e\xd1\xd7\xee\x1a\x94\xd9\x38\x1e\xa4\x88\xe5\x99\x6b\x25\x2e\x52\x4c\xbb\x26\xc6\xd8\x71\x96\x9a\xe3\x2c\xf3\x05\x28\x19\x99\x32\xbf\xbb\x58\x5a\x73\xa4\x37\x55\xb9\x14\x23\x01\xff\x27\x1a\xcb\x82\x09\x4c\xd8\x27\x29\xb2\xba\x08\x31\x7d\x4a\xf4\x67\x75\x59\xc7\xc8\x6f\x8e\xad\x77\x8b\xa0\x5e\xdc\x0a\x7f\x0b\x3b\xd0\x61\xb1\xae\x95\x58\x4d\x91\x75\x4c\x13\x17\x84\x10\xd0\x29\xe3\x0f\x17\x21\xa6\x86\xfe\x2f\x39\x67\xfa\x55\xac\x37\x8c\x00\x79\x38\xf8\xd4\x42\x7d\x65\x2b\xfd\x24\x69\x19\xa6\xd6\xa2\xf5\x69\xe8\x24\x64\xf3\x25\x99\xa1\xaa\x71\x5d\xc8\x30\x4d\x24\xc7\x57\xf7\xfc\xbd\x77\x77\xae\x79\x1e\x62\x0b\x19\x13\xe4\x51\xc6\x26\xcc\x3f\x8a\x41\x02\x85\xeb\xdb\x9d\xcf\xb2\xb8\xec\xa0\x0a\xce\x7c\xde\xf9\x73\xf5\x3e\x34\xcf\x9a\x2f\x6d\x1d\x39\x72\xaa\xf4\xfb\xf8\x53\x01\xfc\xac\x90\x23\x17\xd9\xa5\xfd\x99\x46\xd9\x82\x15\x9a\xe6\x93\x30\x68\xbb\xed\x8f\xd3\x8a\xab\xeb\xcd\xbc\x25\x79\xa0\x79\x4e\x95\x11\xec\x77\xf5\xe4\xe5\x6a\xb1\x9c\xbd\x31\x0c\xda\x07\x16\x97\xda\x50\xe5\x81\x3e\xb3\xf0\xac\x49\xb5\x8b\x18\x53\x6d\x68\x75\xa5\xe0\xb0\x91\xda\xe3\xe5\x29\x41\x51\x44\xdb\xef\x0d\x43\x73\x71\xab\x8e\x7a\x36\x33\xaf\x67\xa1\x3c\x88\xfb\xef\xa5\x88\x27\xd3\x4f\xb8\xc8\x35\xc5\xe1\x3f\xac\x2b\xe3\x71\x84\xae\x2e\xfa\x9d\xdc\xa6\xf5\xea\x36\xf7\x99\xca\xbb\xc1\xfd\x47\xca\x44\x04\xc9\xc8\x24\xf1\x1d\x28\xc8\xcb\x0a\x26\x9a\xc2\x72\x11\x6b\xda\x4c\x2a\x69\x93\xb4\x37\xe8\xe6\xdc\x14\xe9\x00\x35\xdd\xf9\xb6\xf0\x77\xc9\xae\x19\x33\x70\xc8\xba\x14\xd8\xfb\x1d\xab\xd4\xdd\x43\x98\x94\xc5\x44\xcd\x27\x17\x79\xa6\x4f\x4c\x00\xf9\x7c\x30\x23\x06\xfd\x79\xfd\x42\xd6\xab\xdb\x72\xd0\xd8\xf5\xc8\x03\xc4\x91\x16\x0b\x0e\x44\xb5\x77\x09\xa8\x00\x8a\xe4\xd7\x5f\xc8\x25\x88\x5f\xff\x44\xfe\xfa\xef\xff\xfd\xd7\x5f\x94\x81\x6d\x4e\x4e\x4e\x73\x11\x77\x8a\x23\x3e\xad\x8a\xf8\x51\x4a\xe3\xa8\x54\xa0\x8c\x78\xbb\xf7\xc3\x20\xe7\x32\x84\xce\xd4\xc6\x75\x2c\xb2\x2e\x28\x15\xc8\xdf\x1e\xc7\x05\xc9\xe9\x8c\xa2\x5c\x44\x9b\x22\x34\xfe\x11\x32\x66\xf8\xd5\x7d\x86\xab\x52\x7a\x7d\x7b\x40\x07\x91\x5d\x37\xb2\xb4\x24\x58\xa7\xa5\xc3\x3c\xf4\x8b\x62\x22\xa4\xe4\xb1\xdc\x51\x55\x4c\xf5\x92\x7a\x8d\x5b\x54\x9f\x36\xa6\x4a\x63\xc2\xd6\xb0\x84\xb7\xcf\x7e\xb2\xeb\x44\x4a\xf5\xb7\x56\x67\x31\x63\x71\x7e\x08\xdb\xb2\xd0\x5a\xb9\xf7\x9c\xac\x81\xef\x21\x92\xea\xbc\x37\xa5\xe7\x6f\xa9\x88\xfa\x4e\x6c\xeb\xb4\xf1\x64\x08\x4c\x57\xa3\xf2\x37\x9c\x85\x69\x77\x60\x93\x4b\x25\x0f\x63\xa4\xf8\x2b\x56\xd0\x52\xd1\x72\x37\x5d\x02\x83\x58\xd3\x07\x16\x26\x40\x39\xf9\xc0\xf2\xc4\x24\x30\x0d\x5d\x37\xdb\xe0\xda\xf6\xc5\xcf\x13\xb9\x7b\xbb\x53\x8d\x32\xd5\x59\xcd\x97\xad\xdb\xfa\xc9\x78\xfe\x3d\x13\x71\x3c\x91\xb7\x97\xbb\xd0\xe5\x8f\x26\xd0\x7f\x84\x4c\xfb\x51\x37\xc3\x14\xa1\xaa\x57\xd2\x99\x9f\xf1\xa0\x1b\x00\x5c\x97\x9c\xb3\xfd\x98\x71\xfc\x27\x41\x0f\x58\x2f\xfd\xc4\x62\x7a\x66\x12\xbc\x8b\x18\x53\x14\x32\x52\xf2\x05\x2a\x1d\xcd\x6b\xe4\x45\x23\x52\x3a\xec\xea\xf5\x74\xd0\xbd\x9e\x2f\x16\xf3\x19\x79\xa0\x42\x66\x52\xb0\x4e\x81\xba\xf0\xdc\xd9\xdb\xd9\x1c\xe3\xe0\x8b\x8d\x14\xb9\xa4\x4c\x4d\x26\x8d\x76\x11\x69\xfa\x28\x83\xfa\xbf\xd6\x61\x31\xd1\xeb\x44\x33\xfb\x0c\x16\x33\x5d\xf8\x91\x2c\x9a\x98\x63\x69\xdb\x0d\x1a\xe2\xb4\x42\xf5\x25\xe1\xb0\xdb\x25\x1c\xc8\x55\xa2\x79\x69\x53\xb8\x50\xba\x0b\xab\x5f\x19\xe8\x76\xc1\x0f\xc6\xbc\x3b\x33\x7b\x60\xba\x3c\xc2\xdd\xdb\x73\xf2\x11\x04\x9c\x7e\x51\xd7\x10\x51\x1e\x25\xa5\xd2\x2c\xd3\x4f\x74\x77\xee\xc7\x64\xb7\x5e\x5e\x97\xf2\x60\x86\xaf\xee\xcc\xbd\x6c\x03\x5c\x4a\x3b\xef\x67\xad\x78\xd1\x2d\x78\x04\x20\x8d\x39\xcb\x31\x1a\x19\xdf\xee\x2b\xc9\x65\x16\x9c\xfb\xdb\x72\xf4\x13\x4a\x8f\x77\x73\x53\x4b\xcc\xf4\x6e\x9e\xda\x7e\x99\x89\x36\x72\x5f\xe8\xea\x7e\xc0\x54\x34\xa2\x0c\xde\x41\xa6\xef\xe7\xdc\x24\x19\x17\xa9\xa5\x0d\x1b\xe2\x0a\x14\xbe\x6a\x97\xe5\xcb\x4b\xeb\x84\x14\x82\x52\xa9\xe3\x47\x74\xcf\x70\x94\xaf\xe8\xd4\xce\xf3\xd9\x9c\xdc\x4a\x6e\xca\x36\x23\x2a\x17\xc9\x05\xd4\x81\xe1\xd6\xf8\x23\x4f\x53\xe5\x40\x84\x29\xea\x0a\x80\xac\x0b\x63\xce\xa5\xa3\x25\x73\x93\x80\x0e\xc8\xa9\xeb\x07\xb2\xfe\xa7\x76\x27\xb4\x57\xa7\xa3\x57\x83\x44\xe5\xf7\x0c\x26\x23\x7b\xb8\x8b\xd5\x51\x6d\xea\x1b\xd5\xfa\x96\xa3\x6a\x40\x7a\xa0\x79\x91\x7a\xbe\xa8\xd0\xe5\x55\xd0\x43\xde\x8d\x96\xad\x1c\xf2\x59\xb1\x61\x25\xf1\x96\x72\xf6\x3c\x0d\x7b\xc5\x45\x66\xa9\x6e\xd8\xb6\xd0\xb4\x47\x29\x9f\xf1\xa8\x29\x10\x9a\x96\xae\xfa\x86\xf8\xd6\x62\x4e\x9e\x94\xac\xc6\xf6\x8a\xcf\x69\x51\x41\xa0\xfe\xf6\x8f\x7f\xc8\x53\x36\x91\x9f\xa1\x8b\xdc\xd2\xab\x4a\x14\x09\x03\xb2\x16\x95\x29\xce\xaf\x93\x72\xb3\xe1\xb4\x00\x7c\x7b\xc2\x1c\x2f\xa4\xe0\xc7\x32\x82\x28\x6a\x9b\xeb\x73\x77\xa9\xc5\x1f\xc3\x7d\xe3\xae\x54\x65\x96\xea\xe7\x3c\xc5\x7d\x2d\xfa\x2e\x94\x2d\x26\xa7\xef\x87\xba\xd3\xa8\x9c\x34\xf0\x63\x2e\x03\xd9\xc1\x30\xdf\x43\x5e\x0c\x25\x7f\x1f\x59\x5e\xd0\x40\xbc\xfe\x1b\x9e\xcc\x13\xf6\xc2\x10\x5a\x8a\x19\x56\xe7\xd2\xf2\x74\x60\x66\x74\x47\x69\x9f\x96\x34\xf4\x43\x99\x85\x90\x17\x4d\x01\x60\xe6\xfd\x26\x10\xf3\x21\x00\x9e\x99\x71\xf1\x29\x2c\x16\x5c\x44\x97\xde\xc3\x41\x0f\xee\x7d\x69\xaa\xbd\x77\x07\x26\xf0\x8c\xe2\xbb\x7a\x29\x8d\x7c\xfa\x8c\xfa\x67\x1d\xae\x2f\xe7\x16\xf9\x49\x1e\x60\x94\x39\xf5\x49\xee\x65\x5c\x2a\xb9\x9f\xf2\xe3\xb2\xdb\xae\xc3\x2d\x35\x80\xdf\x37\xdd\x10\x45\x57\xef\x55\x49\x73\x39\xa5\x3e\xcb\xf3\xb2\x85\xcb\xb8\xae\x67\x1c\x0b\x4f\xf9\x46\x5f\x7e\xfd\x93\x22\x5b\x49\x6e\x64\x53\xd1\x48\x59\x5e\x9c\xf9\x8c\x5e\x36\x56\xb6\x9c\xd6\x69\x7b\xf3\xe0\x1e\x93\xde\x60\x52\xaa\x1f\xde\xe6\xb8\xe4\x6b\x93\x6b\x2a\x44\xfd\x71\x9e\x34\xd3\x1f\xa4\xd8\x82\x32\xb3\x7f\xb7\x52\x44\x65\x1d\x50\x9d\xf5\xa6\xdc\x0e\xcd\xda\x8b\x72\x5b\xbb\xeb\x5d\x13\xe6\xc6\x7e\x44\xdf\x69\xbf\x68\xdb\xe9\x8d\xe3\x73\x5a\x8d\xf4\x54\xae\x21\x4f\x4a\xb6\x93\x13\x1d\x5d\x9e\x4e\x62\xa3\x6e\xc8\xaa\x67\x38\xc3\x15\x0b\x69\x9a\xf8\x61\x87\x2e\x9d\x7b\xe4\x1e\x52\xd3\x62\x1e\x28\x1e\x5a\xcb\xb4\x07\xc8\x21\x29\xf5\xd4\xe7\x54\x55\x0c\x64\x99\x36\x09\xbf\x56\x9d\xbe\xd1\x5d\x30\x6e\xca\x7d\x99\x16\x9d\xa6\x6c\xd0\xb2\xb4\x1b\xc9\xf0\x60\xe8\xef\x1f\x02\x29\x14\x14\xa0\x11\x0a\x93\x24\x5c\x08\x32\xbd\xe2\xa0\xf7\xc5\x56\xea\xfd\xdd\xb0\x8b\x43\x14\x79\xa7\x5b\x3f\x66\x45\x52\xb6\x99\xbf\xb7\x72\x97\x0b\xf2\x95\x2a\x21\xc7\x2a\x34\x75\x02\xd7\xde\xd6\xb9\x87\x4f\x5d\x84\x98\xa2\x61\x44\x5b\x8c\xff\x2c\x75\x15\x43\x61\x21\x3e\x4d\xfd\xa2\xcc\x02\xae\x3a\xee\xfb\x03\xe5\x41\xff\x8b\xb2\xbc\xb9\xfd\x76\x36\x37\xad\x05\x15\x51\x3e\x5d\xae\x8f\xc4\xd2\x8f\x25\x67\x94\x3c\xca\xbc\x47\x93\xc9\x74\x03\x76\x5b\xe7\x1f\x29\xf7\x05\xec\x7b\x10\xf1\x39\xb9\x03\x95\x17\x54\x0c\xf7\xbf\x27\xc5\x24\xd7\x4f\xec\x01\x4a\xc5\x0a\x56\x9e\x77\xfb\x43\x20\xe9\xc7\xe3\x20\xb7\x9b\x8a\xdb\xea\x28\x37\xf3\x39\xcb\x8b\xb7\x19\x08\x88\x69\xef\x49\x49\x81\x74\xcc\xc1\x4b\x77\x09\x11\x9b\x54\xf7\xe5\x22\x98\xd4\x78\x6e\x5d\xf4\x0e\xe3\xfe\x70\x1c\x40\x73\x0c\x0b\x7f\xa7\x6b\xd6\x61\xda\x49\x81\x67\x73\x87\xdc\x41\xc1\xc6\x99\x5a\x22\x9e\x2a\x2d\x41\x32\x29\x6e\x63\xad\x80\xe8\x03\xa5\x51\x43\x40\x4b\x12\x50\x45\x2a\xfb\xa3\x48\x8b\x99\x33\x5f\x9c\xd2\x80\x1b\x79\xf3\x62\xe9\xcd\xde\x5a\x33\x57\x7f\x5e\x25\x4e\xe0\xaa\xc9\x30\x6f\x2e\x82\x49\x0d\xed\x42\x4a\xe3\x88\x0c\x4c\xe4\xbb\xc4\xf8\x3d\xa0\x30\x34\xdd\xf9\xa5\xc4\x3e\xc9\xbb\x40\xe9\x80\xd7\x9b\x2d\xc8\x3a\x94\x45\x6b\x28\xe6\x79\x9e\x85\xb7\x75\x27\x0b\x20\x77\x4c\x40\x60\xcc\xb7\x1e\x80\x43\x75\xee\xf8\x50\x53\x49\x69\x9e\x53\xfd\x6b\x62\xdc\x6a\x72\xae\x6d\x5e\xaf\xa4\x3f\xfc\x4d\x11\x36\x67\xf2\xca\x5d\x78\x08\x6d\xc2\x31\x8f\xa1\x1e\xfd\x9a\xbd\x80\x88\x4b\x6d\x1e\x3f\x49\x20\x8f\x70\x52\x84\x42\x5c\x96\xca\x00\x45\xae\x2b\xd1\x98\x97\x66\x01\xae\xa6\xea\xb7\x1c\xfc\x96\x73\x77\xd6\xb5\x5e\x07\x55\x9b\xaf\x0a\xc4\x56\xa3\xca\xa9\x3a\x77\x6d\x0d\x59\xa5\xf7\x38\x64\x2f\x3a\xd7\xbe\xbb\x03\x6b\xfa\xae\x1c\x5d\xfb\xd2\xbc\x2f\xf4\x5a\x2e\x1c\x7b\x4e\xee\xe5\xa6\xc8\x35\x65\x66\x98\x7c\x41\x82\x9a\xbc\xa9\x4a\x86\xf6\xac\x57\xd4\xed\x89\xa3\x3a\x6b\xd6\xcc\xa8\xa3\xd2\xa2\x7f\x67\xf6\xd2\x6a\xa6\x6a\xc7\xa6\xf0\x93\x5f\xff\x44\x20\x27\xeb\x72\xf3\xeb\x2f\x78\xbe\x7d\xa7\xd9\x99\x15\x29\x88\x2b\xfd\x08\x82\xd5\xb1\x13\x3d\xe8\xa0\xb0\x1b\x44\xda\xf2\x7a\x2d\x2d\xfd\x80\xc5\x21\xea\x6a\xba\x12\x80\x19\x57\x1d\x2b\xdb\xac\x81\x89\xe2\xed\xc7\xd7\x3f\xaa\xd7\xff\x27\x9b\xaa\x63\x8e\xb4\x52\xa3\x48\xb9\x02\xb5\x6b\xd1\x01\x46\xaf\x11\x50\x1d\x91\x8b\xb0\xb9\x98\xee\x8f\xa9\xa5\xb3\x65\xcf\xff\xe8\xa4\xdd\xf0\xd7\x7f\xff\xef\xe5\xe1\xf0\xeb\x2f\x0a\xfe\x4e\x7a\xe5\xf6\xb2\xad\xd4\x5f\xc3\x5e\x3f\xc8\x3a\x24\xd1\xd3\x7c\xdb\xa8\x5e\x4b\x0f\x7e\x45\x79\xdb\x7a\xb5\xbd\x95\x45\xea\x7c\x27\xa0\x2a\x4e\x46\x0e\x6c\x0d\x08\xc1\x1f\x30\x2d\x01\xde\x45\x8e\x69\xd3\xfa\xee\x60\x3a\xad\x1b\x43\xae\x61\x3a\xe9\xb3\x2f\xaa\x23\xef\xbb\x65\x5f\x09\x30\xbc\xc3\xeb\x92\x87\x94\x7c\x92\x59\xa0\x70\xbe\xec\xaa\xe4\xd9\xeb\xbf\x4d\x96\x56\xdb\x7d\x5d\x69\x0f\xea\xf7\x73\x67\xcb\x98\x19\xa4\x5f\x5a\x9d\x9a\x08\x79\xf3\xd9\x92\x3c\x29\x10\x39\x1a\x40\x75\xf5\x46\xc7\x9a\xcf\x7a\xe3\x12\x97\x50\x49\xa1\x37\xd8\xf3\x0f\x4c\xb8\x08\x37\xfd\x06\x75\x92\x7d\xcb\xc2\xd4\x28\xfb\xf6\x74\xc3\x34\x8d\xff\x90\xd4\xab\xe9\x8b\x2f\x02\xe8\xe0\xc8\x0e\xb9\x28\x34\x66\xec\x34\x05\x5d\xef\x20\xcf\xd3\xb7\xd7\xc0\xff\xf6\x8f\x7f\x10\x93\xd5\xb1\x10\x6e\x8a\xc3\xe1\x15\x16\x79\x52\xd6\x8a\x74\xd8\x06\x7f\xa9\x70\xa7\x57\xf9\xcc\x17\x14\xd5\x62\x10\xed\xa9\x2a\x58\xce\x44\xdc\x9c\x18\xf3\x9e\xc3\xeb\xb0\x3c\xf2\x40\x45\x6c\x9a\x9b\xe7\x8f\xa0\x11\x73\x7a\x95\x80\xe2\x34\x47\x21\xdb\x9b\xb6\x27\x6d\x64\x2b\x61\xca\x44\xcc\xe7\x7e\x9e\xa0\x73\xe1\x86\x57\x9d\xbd\xe6\x8c\xdc\xc2\x9e\x8e\x12\x5c\x64\xfe\x02\x0a\x0e\x26\x7a\x3e\x7b\x01\x01\x59\xa7\x1f\x41\xd0\xa2\x9b\x4c\xfa\x5d\xc9\x8c\x90\x7b\x8b\x83\x49\x7c\xe1\x27\xa0\xf6\xa0\xa2\xb6\x25\xb8\xb2\x74\x11\x7c\xf8\x94\x7e\x57\x86\x09\x88\x38\xf9\xf5\x4f\x6f\xb0\xd7\x1e\x27\x20\xce\x5f\x76\x44\xe2\xa9\x91\xad\x34\x63\xd4\x97\x8a\xc5\x49\x61\x66\x93\x82\xa4\x14\x05\x5f\xfa\x59\x75\xc4\x87\x9b\x79\xda\xe9\xb6\x28\x46\x3a\x16\x97\x65\xfd\xb2\x6a\x4b\x10\x9a\xc9\x50\x41\xc1\xc2\xf6\x44\x20\x72\x43\x8a\xa4\x0e\xba\xc5\x79\xc7\x1d\x5d\x64\xa1\x36\x71\x67\x67\x41\xfc\x91\x16\xad\x42\x8e\x6b\x1b\x62\x6e\xfd\xc6\x04\x9d\xb5\xd0\x3c\xd4\x93\x0e\xfc\x55\xfd\x28\x8b\x89\x34\x2d\xc8\x3e\xfd\xa0\x68\xd4\xd1\xd6\xeb\x23\x5d\x97\x53\x37\x48\x5a\xe7\xb6\x7f\xa0\x34\xe8\x3e\x35\x77\x41\xee\xcb\x3a\x5f\x18\x36\x3b\x7f\x0a\x64\xc1\x94\x11\x65\x9e\xdf\x9c\xd7\x45\xd8\xa9\xce\x52\x1f\x68\x64\xb8\xab\x38\xcc\xd9\x14\x17\x44\x86\xeb\xdc\x19\x98\xcd\x3b\xf6\x62\x46\x1a\x6c\xcf\x70\x13\xb9\x97\x22\x4e\x4a\x98\x6e\x46\x09\x91\xa7\xed\xe7\x16\x35\xf2\xe7\x26\xab\x0b\x12\x5c\xe3\xae\x1f\x72\x16\xa6\xf5\xa3\x6b\x9a\x4e\xde\x7c\x7e\x52\x17\x3f\x2d\x77\x5d\xb1\x2d\x64\xba\x21\x37\x8d\x5a\xcc\x69\x51\xeb\x1f\xa8\x12\x20\xa2\xa1\x64\xb1\xd8\x34\x57\xb8\x77\x4a\x66\xb0\x16\x16\xf9\x24\x03\xca\xc7\x2a\xfe\xf5\xad\x81\x56\xf8\xfd\x1d\xec\x23\x4e\x23\x60\xec\x3c\x60\xbf\xcb\xb2\x41\xaf\x6f\xb5\x09\x2c\x5f\x1d\x5b\x03\x5a\x0b\xb2\x2e\x40\xc4\xc3\x86\x68\x1d\x8d\x85\x5a\x1b\x32\x09\x4f\xc3\x45\x0c\xea\x23\x88\xa8\x22\x0f\xa5\x32\x46\x5f\xf8\xdc\x8c\xe7\xa6\xca\x70\x99\xc3\x91\x54\xdd\x5b\xce\xc9\x8d\x61\x50\x9c\xee\x88\x97\x7f\xfd\x9f\xff\x9f\x20\x4f\xba\xac\xf4\x95\xd1\x42\x40\x76\xde\x7b\xf2\x30\x3c\x47\x77\x97\x47\x33\x1c\xf7\x4d\xaa\xa8\x99\x7a\x49\x14\xa5\x11\x0f\xfc\x22\xa1\x50\x70\x10\x45\x27\x9f\xb5\xac\x3a\x92\x54\x19\xcb\x8b\x3a\xe3\x1d\x0e\xdf\x26\xec\x65\xca\x49\x47\xa4\x9f\x5e\x83\x60\x94\x93\x4b\x60\x66\x8a\xfb\x78\x0c\x30\xc0\x75\x1e\x0e\x79\x7f\x16\xb9\x61\x02\x86\xd5\x93\x92\x57\x10\x41\x34\xdd\xf4\xb7\x8b\x20\xd4\x47\xa8\x32\x29\x22\xf2\x05\x78\xa6\x77\xef\xef\x90\xb2\x42\x4f\xa5\xab\x1d\xae\xf2\xa8\x1f\xf7\x2f\x3d\x77\x61\x91\x2f\x0a\x98\xd2\xbd\x83\x11\xe4\xda\x1a\x58\x52\x27\xdd\xc0\xa7\x79\x64\xc8\x41\xd5\x46\x7a\x38\x31\x9d\x9b\x3d\xa3\x11\xf0\x44\x3b\x5c\xe5\xd4\xe7\x4c\xa4\xf5\xf1\xd6\x46\x5a\x2b\xd7\x33\x67\xc5\x58\x11\xe5\xae\x0c\x40\xc4\x93\x4e\xed\xbb\x88\x41\xbd\xe0\xf5\xb7\xf2\x20\x45\x11\xd7\xff\x21\x7c\x25\x9f\x0e\xad\x9f\x4d\xd6\x5e\xe0\x1b\x3f\xe0\x32\x2e\x80\xa7\x0a\x22\xd6\x93\x8b\x7c\xa5\x6a\xf8\x5e\x5e\x25\x94\xc7\xa0\x67\x68\xce\xae\x9c\x43\x0c\xea\x27\xa9\x32\xe8\xb8\x12\xdf\x9b\xd3\x5a\x20\x53\x82\xc7\x3e\x64\xf0\xd2\x09\xca\x3c\xcf\x9d\xa3\x25\x47\x1d\x5c\x9d\xc8\xef\x9f\xa0\xda\x81\x1e\xbe\x9a\xaa\x7b\x83\x0c\x54\x9d\xc8\x74\x0d\xde\x23\x3b\x80\xad\x6e\xf1\xf2\xc4\x17\x2c\x69\x2a\xe6\xee\xc9\xe0\x55\x1b\x7d\x2c\x6d\x62\xe3\xe0\xd5\x53\xa5\x68\xfe\xfa\xff\xbe\x99\x66\x68\x0e\xe1\xa7\x9a\x1c\xd1\xc8\x5f\xde\x68\xfd\x84\xf1\x88\x8a\xb8\x59\xe6\xcc\x87\xf0\x47\xc9\x14\x2d\x12\x96\xd7\xff\xc9\x9e\xb7\xd7\x72\x4e\x9c\x62\xac\xc8\xf5\x77\x00\x53\x76\xdd\xd6\xf1\xe5\x42\x65\x79\xa1\x0c\x95\xb0\xaf\xe4\xe4\xd0\x5c\xe1\x5b\xbf\x14\x34\x0f\x65\x5b\x40\xb7\xc9\xa3\x7c\x0e\x4a\xd5\x47\x42\x2d\x16\x73\x77\xf5\x06\x99\x50\x59\x50\xaa\x98\x5c\x2a\xc8\x36\x54\x9b\x6e\xdf\x20\xcb\xe0\xbc\x05\x4a\x24\xa5\xea\x2e\x30\x7e\x71\x74\x70\xb4\x6d\xf1\xb3\xa3\x3c\xf5\xe5\xe1\x9d\xee\x7b\x2f\x57\x2e\xf9\xa0\x68\xbd\xc7\x0c\xd4\x3d\xd7\x75\xcc\x2f\xcb\xc9\xfc\x61\x5d\xd7\xeb\xcc\x95\xae\xf4\xce\xd1\xed\x8b\x59\x28\x9f\x39\xf7\xc3\x1d\x08\xca\x9b\x58\x7f\x69\x93\x27\x29\xaa\x51\x35\xd6\x1d\xad\x26\xaa\xec\x20\x12\x15\x15\xd1\x97\xaa\x02\x1d\x11\xb5\x3e\xf3\x59\x80\x6b\x3c\xf3\x59\xf8\xa3\x9b\x6d\x59\x97\x3b\xaa\x98\x1c\x23\x21\x3d\xb2\xbd\x0e\x79\x27\xb1\xd4\x70\x91\x81\x7a\x29\x85\x1e\x7e\x30\x15\xc6\x9f\xa5\xcc\x3a\xde\x53\x80\x35\x46\x2e\x7c\x45\x37\x14\xb7\x8e\xf6\xa8\x72\xdd\x76\x87\x1f\xa8\x1b\x21\x2d\x83\xe9\xe6\xf3\xbd\x79\x43\x9a\xf9\xcc\xd9\x5e\x07\x50\xbd\x3a\x95\xc4\x45\x2e\x7d\xb1\x6b\x47\x34\x5d\x67\x4e\xde\xef\xa9\xc2\xef\x6a\x64\xeb\xbb\x97\x39\xb9\x7a\xfd\xb3\x88\xa4\xd1\x48\x4e\x93\xa4\x20\x04\xd5\x68\x00\x5b\xbc\xeb\x1d\x98\x39\x10\xcd\x76\xe5\x3b\x5f\xee\x93\xe6\x3b\xb2\x3d\x2c\xbe\x8d\x57\x84\xaf\x5e\xff\xac\x22\xa9\xff\x2c\x13\xdd\xd0\xb2\x3f\xe7\x7d\x0c\x24\xff\x00\xa6\xb7\x16\x74\x30\x72\xfe\xa3\xe7\xf3\x6a\x5a\xf3\x73\xdb\x69\x22\x5f\x9c\xa4\x18\x7e\x67\x5f\x40\xc1\xeb\xbf\xe5\x3a\xe6\x00\x01\xd9\x99\x6f\xb2\x5f\xf1\x00\x91\xd3\x13\x88\x61\x90\xe0\x22\x57\xfd\x60\x63\x8e\x66\x6d\x34\xa2\x19\x8c\xbd\x90\x4f\xa5\x28\x4a\xfc\x10\x27\x28\x4f\x21\x06\xf5\x29\x91\x19\xe4\xbd\x1b\xba\xe4\x32\xde\xe9\x83\xa7\x30\x77\x94\xfb\x87\x84\x15\xf4\xc8\x2b\x6a\xb6\x5a\x90\x4b\x88\x28\x94\xa3\xc2\x97\x2c\x90\x41\xc3\x06\x39\xbb\x9e\x0c\x51\xa8\xc8\x77\x6d\xf5\x64\xa6\x07\x6a\x04\x58\x80\xaa\x32\x5e\xf8\x62\xa7\xda\x7a\xa8\x4b\xae\xa5\x80\x51\xe3\xab\x3f\x50\xf1\xf7\x40\x3c\xf2\xdc\x2e\x5b\x5e\x83\x08\x13\x63\xd1\x40\xa9\x39\xbb\xa2\x5c\xaf\xf2\xd2\x0f\x59\x1d\x30\x35\xc7\x97\x45\xd6\x65\x96\x51\x65\x60\xa1\xa7\xcd\xdd\x9f\x38\x88\x09\x35\xd1\x08\x41\x35\xd3\x7f\xeb\x04\xf0\xc0\xe9\xea\xbe\x87\x3c\x81\x03\xdf\x9f\x58\xa2\x3a\x4b\xdb\x5e\x90\xf7\x79\x98\x0c\x37\xc4\xdb\x12\x7e\x30\x98\x48\x54\xab\x59\xa7\x34\xa0\x61\x08\xe4\x42\x09\xa9\x23\xd0\x8b\xc0\x08\x34\x15\xe0\x1a\x3f\xf8\x65\xc6\xc2\xa4\xa9\x40\x2d\xb5\x33\xde\x49\xdb\x76\x69\x2d\xbc\x85\xd9\x1e\x22\x2a\xa6\xb6\xe3\x71\x57\xc6\x56\x8e\x92\x5b\x2a\x74\x66\xdc\x98\x78\x6f\x93\x7a\x85\x3f\xfb\x11\x63\x71\xfb\xe6\x59\xf6\xca\x26\xeb\x1d\xb0\x38\x29\x06\x1f\xd6\xba\x00\x55\x55\xe4\x73\x9e\x4a\x3e\xd1\xab\x87\x70\x53\x9d\x6c\x3d\x36\xe5\xa7\x9f\x0c\xdf\x3a\x52\x58\x78\xaa\xfc\x7d\xda\x29\x24\x5c\xf2\x00\xd5\x58\xb4\xb4\x66\x62\x9b\x48\x33\x61\x3a\x85\xee\x1e\xa9\xa6\x06\xd4\x4d\x3e\xc8\xbc\x51\x7f\xe8\xc1\x82\x06\x26\x9c\x6d\xf0\x0a\x7f\x19\x74\x1c\x66\xf3\x39\xb9\x2d\x9f\xf9\x68\xd3\xf2\xa6\x14\xa5\x88\xf9\xc4\x48\x3b\x4d\x37\x2d\x39\x83\x3e\x27\xe7\x63\x83\x6c\xda\x1a\x44\x4e\x76\xea\x0a\x3d\xf7\x96\x73\x8b\xd4\x7b\x81\x94\x69\x53\xdf\x5d\x7a\xd6\xec\xad\xe5\x18\x49\x63\x16\x48\xf2\x40\x5f\xff\x79\x3a\xd5\x30\x22\x4c\x5b\x61\x77\x0f\x8e\xff\x1d\x42\xaa\xdf\xab\xd0\xc0\xf1\xb3\xb9\xbf\x03\x05\x9c\x53\x9e\xf7\xc4\x3b\x1d\xb2\xef\x78\x7b\xbf\x53\x6c\x5f\xd5\x3b\xfc\x64\xde\x1a\x2e\x62\x4d\x3f\xca\x44\x88\xaa\x97\x0d\x5f\xc9\xb8\xf5\xc9\xd7\xb9\x70\xb6\x38\x1d\x6a\x9c\x93\xf7\x3c\x06\x35\x68\x12\xdd\x69\x77\xe8\x49\xfd\xff\x5d\xc4\x98\xd6\x47\x96\xe8\xd4\x64\xcd\x00\x7e\x8e\x5a\xb2\x6c\xd9\x33\x5c\x5b\xce\x2c\x97\x3c\x42\x96\xd7\xdf\xd8\xd0\xaf\x36\x29\xb7\xbf\xfe\xf2\xeb\x2f\xc1\xaf\xbf\x44\xd3\xed\x22\xae\x2e\xef\xca\x22\xa9\x8e\x27\x94\x3e\xb0\x3d\x6d\x8b\xbc\xed\x70\x52\x66\xf9\xf5\xc1\x2d\x24\xcb\x9b\xa3\x6c\xee\xd9\xb6\x4d\x2e\x41\x44\x4c\xc4\xa7\xdd\x94\x5b\x29\xe2\x2d\x9b\xac\x84\x81\xf8\xd2\x4b\x55\x86\x94\x5c\xb2\x3c\x91\xbb\x37\x63\x06\x36\x01\x5e\xca\xec\x11\xa7\xb2\x99\x43\x2e\x65\x35\x0a\x59\xf9\x4e\x39\x7b\x31\xa6\x86\x53\x7c\x62\xc8\xed\xa0\x45\x51\x99\xc2\xbc\x7e\x6c\x47\x93\x07\xc1\xce\x5c\xc9\x1c\xbf\x02\x11\xd1\xe7\xc6\xe4\xa5\x8e\xa6\x86\xb3\x3d\x0b\x67\x65\x2f\x0d\x33\x18\x8b\x6b\x5f\x81\x37\x05\xc9\xf3\x6b\xa9\xbc\xd9\xac\x2b\x40\xdd\x28\x53\xb9\xf9\xb9\x19\xb6\xcd\xe2\x7a\x29\x73\x8f\x03\x2b\xcf\xad\xff\xd5\x90\x62\x64\x35\x38\xb5\x7f\xcf\xf4\xfb\x38\xc9\xdb\xe8\xcd\xe6\x5d\x40\x7f\x6f\x6a\xa0\x0f\xe5\xb3\xa9\xf2\x4a\x11\x67\xde\xa9\xbe\x6f\xbe\xec\x41\x3c\x46\x44\xb6\x0f\xf0\x02\x7f\xf9\x5f\xc2\x09\x11\xd6\x1e\xe2\x4b\x3f\x52\x45\xb3\x8a\x7c\x61\x54\x99\xfa\x35\x05\x1e\x94\x66\x60\x64\x87\xcb\xd9\xca\xe7\x25\x6f\xe5\xa7\xd6\xdc\x9a\xf5\xa9\xce\xa7\xe1\xe2\xe7\xfa\xfc\xca\x60\x32\x07\x62\x0f\xb1\xa5\xb7\xa0\x54\x55\x1f\xcf\x32\x30\xc0\x48\x93\x7c\x25\x5b\x5c\xcb\xc0\x3f\x48\x15\xed\xea\x9f\xdb\x3e\x34\xc7\x72\x57\xe4\xb3\xc8\x63\x50\x63\xaa\xcc\xaf\x2c\xd2\x3f\xe3\x92\x72\x50\xe7\x9d\xed\xf1\x90\x55\x7a\x09\x2a\x00\x85\x5e\xd1\x55\xb3\xd9\x87\x89\x16\x0c\x07\xb1\x82\x2a\x0b\xfc\x9c\x09\xec\x54\x1a\xec\xc5\xc2\x59\xad\x6c\xf2\x59\x50\xc6\xf9\x68\x81\x77\x4d\x53\x50\x05\x6c\xa7\xeb\xe7\x79\xc8\x2d\xbd\xa6\x82\xe5\xf4\xb8\xd3\xb0\x4e\xd9\xce\x54\x39\xa2\xb6\xd1\x90\x85\xc3\xb2\x94\x67\xac\x0c\x4e\xc3\x8f\x4f\x8c\x72\x96\xbf\x4c\xf5\x89\x39\x2d\xe6\xff\x5b\x42\xa9\xc1\xb1\xa2\x3c\x5f\x47\x53\x07\xbd\x9a\x45\xbe\x82\x2c\xe0\x54\x99\x7d\x7e\x4e\xd6\x89\xcc\x13\x29\xe8\xe8\x23\xbb\x6c\x36\xd5\xf3\xeb\xd7\x3d\x24\x97\x7e\x64\x59\x56\x91\x6f\xa6\x4b\xda\x27\x8b\x1f\x40\x45\x19\xf5\xeb\x80\x68\x23\x3b\x5d\xe2\xd2\x9b\x79\x73\x72\x91\x51\x85\x62\xed\xb1\x29\x33\x7c\x7c\xb2\x98\x68\x8b\xf7\x3a\x9e\x7f\x6b\x0b\x75\x11\x9b\xda\x46\xa1\x3d\xa1\xb2\x8d\x9f\x41\xa8\x64\x46\x23\xd6\x8a\x01\xac\xd9\x6a\x45\x2e\x29\xe3\x9b\x32\xcf\x47\x1b\xe6\x07\x28\xf0\x00\x30\x1b\xe2\x04\xe0\x26\x6f\xb6\x6a\x47\x88\x6f\xa1\xd2\x45\xf5\xbe\x01\x1b\x4f\xea\xd5\x2c\x3e\x95\x93\x5a\x7d\x6b\xcd\x53\xb2\x11\x88\x78\x3a\x33\x6f\x0f\xa1\xa5\x8f\xb2\xea\xe6\x79\x4e\x06\x28\x14\xce\xf4\x64\xc9\xe0\x5d\xb4\x66\xab\xe5\x42\x8b\x6a\x07\xfb\xc5\x77\x98\x68\x12\xd0\x43\x52\x69\xc3\x81\xd7\xc1\x93\xce\x9f\xb4\xa2\x49\x69\x5f\xd7\x8c\xf9\x11\xcb\x43\xb9\xa7\xaa\xf3\xc9\x20\x0f\x94\xcb\x68\xbc\x7d\x77\x2d\x45\xfc\x83\x81\x48\xa6\xd1\x8a\x7a\x08\x27\xd5\xe2\xca\x7b\x56\x14\x8d\x0d\x49\x69\xf2\x49\xc1\x71\x31\xdb\xfa\x87\x5d\xa7\x67\xc0\xe9\x0f\x3a\xe2\xe6\xfa\x53\xcc\xd4\x5b\x16\x33\x35\xdd\x59\x85\x48\xd2\x7b\x8c\x2e\x9a\xfd\xef\x86\xc5\x8d\x44\x83\xe3\xfe\x97\x8e\x1c\x50\xd6\x6c\xb1\x22\x5f\x19\xe7\x10\x9b\x4e\xf3\x48\x71\xea\xf5\x5f\x54\xca\x72\x33\xdb\xf2\x81\x89\xb3\x9f\x58\x48\x28\xfd\x28\xf3\xa4\x04\xb4\xeb\x30\x72\x51\xe3\xdf\x0d\xf5\x4a\xc6\x4f\x6d\x23\x96\xb6\x67\x91\x27\x99\xe5\xa1\x2c\x86\x4a\xa8\x27\x59\x48\xa5\x65\x50\xe7\x6e\x31\x78\x88\x27\xd5\x63\x9a\xb7\x90\x31\x6e\x2a\xb3\x4f\x10\xb7\xda\xc9\xc4\xac\x67\x59\x7f\x44\xce\xf5\x74\xfe\x78\x1a\xdc\xfe\x9e\xfd\xed\x1f\xff\x30\xd9\x96\xe7\xf4\xc0\xda\xc7\xc2\xa7\x9f\x84\x90\x8d\xed\x64\xd1\x49\x9f\x32\xd1\x2b\x6b\x38\x86\xfc\x38\x72\x46\xa1\xf7\x29\x3a\x01\x26\x54\xc4\x71\x09\xe2\x25\xa1\x53\xdd\xa5\xdb\xb6\x5d\x5b\x2a\xc9\xb1\x43\x9e\x42\x2c\x49\x26\xfd\x8c\x15\xcd\xa6\xbe\x30\xad\x93\xfd\xa8\xb7\xeb\x95\x01\x07\xbd\x0f\xcb\x73\x23\x2e\x3c\xa4\x8f\x6a\xb1\x5a\x37\x02\x70\xe4\x1c\x07\x7a\x06\x20\xdb\x8d\xd9\xcc\xac\xdc\xb9\xe5\x92\xaf\x2c\x2c\xa4\x62\x30\xfa\xf0\xb6\x32\x31\xf1\xf2\x14\x93\xc3\x1e\x92\x48\xd1\xc6\xaa\xab\xae\xf5\xbe\xb0\x8d\x2e\xb0\xfd\xf0\x0b\xb9\xeb\xd4\xc9\x8b\xe5\xd2\x25\x5f\xe0\xc0\xf1\xad\x1b\xf3\x85\x78\xa9\x52\x36\x51\xdc\x8e\x18\xd2\x35\xba\x33\xd5\x71\x02\x37\x23\x53\x2d\x73\x25\xaf\x1f\x57\x9e\x29\xbf\x92\x65\xda\x25\xc6\xce\x6a\x76\x22\x5a\x1b\xbb\xb1\x07\x88\x21\x9a\x8a\xae\xe5\x21\x8f\x54\x9f\xc7\x5a\xab\xfc\xa6\x57\x0b\x15\x09\x2e\x65\xb9\x2f\xa0\x28\x55\x4f\x7e\x62\xeb\xbd\xa3\x7e\x58\x4d\xc8\xe4\x2e\x66\x73\xdb\x14\x9d\xb0\xf9\x75\x4f\xc9\x83\xf1\x2c\x99\xa0\xe8\x84\x6c\xd2\xa6\x74\xad\xad\xe2\xcd\x9b\xd8\x0c\x79\x6f\x8d\x83\x7c\x9e\x15\xbe\x48\xf2\xc6\x17\x7a\x61\x93\x2b\x50\x68\x49\x30\x3c\x91\xbf\xc8\x40\xcf\xe4\x5f\x52\xc1\xce\xfb\x59\x21\x8a\x14\x07\xf2\x6f\xca\x82\x51\x65\x80\xcc\x3d\x0d\x6f\x16\x37\x17\xb2\x12\x25\xae\x3d\x34\xce\x05\xcf\xe4\x40\xda\xfa\x1e\x2d\x62\xcd\xa4\xcd\xba\x3c\xb3\x29\xb9\x87\x10\x52\xed\x7d\xda\xa9\xfe\x9f\x54\xa9\x8f\xad\x48\x4b\xfe\xb3\x7d\xaf\x91\x37\x47\xfa\x20\xce\x8d\x0e\x1e\xcd\x63\x99\xd2\x6c\xba\x38\x10\x11\xa4\xcd\xe4\x4f\xbb\x49\x34\x0c\x08\xa5\x77\x88\xc3\x20\xf9\xb0\xc9\x65\xa9\x4d\x77\xbf\x49\x19\x8d\x6e\x10\x4f\x25\x88\x2d\xa3\xd3\x9c\xbe\x88\x21\x35\x0a\x8b\x07\xa9\xa8\xd6\x0e\x37\x9c\x9f\x28\xc3\xa5\xec\xd9\xcf\xe4\x4b\x1d\xcb\x76\x1a\xff\x13\xa4\xe5\x20\x0c\xbc\x63\x39\x28\x38\xe8\xf8\x1f\xc4\x0b\x9c\x19\xd8\xe9\x2d\xfa\x2d\x93\x8e\xbf\xf7\x81\xf7\x94\xc8\x91\x46\xf0\x65\x95\xcf\x82\xac\x7b\x09\x1f\x28\xdf\xb3\x11\x18\xf3\x15\xa8\x32\x2f\xc3\x49\xe9\x38\x1e\x82\x48\x3f\x52\x10\x3d\x01\xde\x25\x04\x01\xa7\xc6\xdf\xd4\x68\xf0\xb2\x17\xbf\x48\x68\x5c\x82\x8a\x18\xb4\x8d\xbb\x85\x47\x2e\x69\x98\xd2\x61\xe7\xee\x7d\xbe\x7b\xfd\x23\xee\xe3\xe4\x49\xbd\xfe\x31\x28\xc3\x64\x42\xb6\x91\x87\x54\x52\xe3\xd5\xa4\xd3\x63\x23\xab\x29\x1b\x86\xa7\x59\x14\x33\xbf\xfe\xf9\xa6\x92\x66\xd9\x0b\x72\x0b\x90\x8f\x34\xf2\x3e\x95\x8a\xeb\x52\xc1\x04\xe7\xb0\xe6\x91\xca\x28\xea\x69\xba\x3e\x42\x10\x50\xd5\x86\xf1\x5a\xd6\x25\xe6\xbe\xc8\x0f\x75\x0c\x6f\xba\xe4\x33\x77\xf9\x9f\x86\x83\x77\xd8\xfd\x07\x51\xc1\x84\x59\x32\xc2\x49\x75\xd0\xfb\x0d\x8a\x66\xea\xfc\xbb\xd4\x84\x66\x38\xe8\x35\xb1\xf0\xc3\xe4\xb8\x5c\x3d\x73\xac\xb9\x47\x6e\xa1\x4c\x07\x05\xa7\x7b\x16\xd0\x89\x8a\x33\xcb\x45\x87\x05\xef\xbf\x7c\x4f\x10\x87\xa6\xda\xd4\xbe\x7e\xcb\x11\x19\x9e\x3d\xb7\x56\x33\x72\x0b\x2f\x94\x87\x8a\xe6\x3d\x17\xf9\xc5\xcc\x76\x1d\x7c\x6c\x25\x70\x7c\x9e\x75\x02\x06\x62\x32\xb4\x91\x67\x20\xa5\xfa\x60\xeb\x26\x36\x1e\x93\xca\x08\x6e\x8c\x0d\xb4\xb0\x7c\x51\x65\xd0\x55\x01\x66\xe8\x6f\x21\x05\x25\x57\x52\x89\x91\x61\x94\x1b\x1a\x4f\x54\x85\x5a\x36\xf8\x73\x20\xef\xa3\x3a\xc4\xd5\xb4\x37\x05\x4c\xec\xa9\xd2\x93\xd8\x19\xd5\x57\x84\xed\x07\xe5\xcb\x4b\xdf\x84\x70\x36\x9b\x93\xf5\x01\x38\x97\x87\x81\xdc\xff\x56\x8a\xf8\x99\x4d\xd4\x28\xf7\x96\x3d\x71\x68\x17\x53\x7d\x6d\x90\xb2\x85\x8e\xa9\x84\xe3\xef\xa0\x08\x93\x0e\xfe\xbb\x5a\x7a\x64\x1d\x26\x9c\x65\x31\x15\xc3\x81\xc3\xa7\xbc\x54\x65\x3c\x8d\x4b\x8e\x87\x64\x52\x1c\x2f\xbf\x2d\xe3\x44\x9f\xd2\x9f\x2b\xa3\x9c\xde\x24\xb8\x26\x5c\xbf\xa2\xf5\xf3\xd8\x41\xdc\x75\x5d\x3d\xb2\x2e\xfb\x22\x14\xc7\x9e\x2f\xb0\x1f\xfe\x85\xe6\x21\x18\x67\xbe\x73\x7b\x50\x79\x08\x25\x35\xed\xf0\xf7\x9c\x33\x83\x0b\xfb\x5d\xd9\xba\xf2\x51\xbd\x2a\xbc\x91\xad\x63\x65\xad\x96\x2b\xf2\x0f\x82\xc9\x91\x7a\xe8\x25\x88\xc9\x24\x94\xde\xd2\xeb\x4e\xe4\xfe\x08\xd4\x47\xc3\x23\x2e\x9a\x01\x28\xb1\xf2\x21\x0c\xcb\x03\x45\xa6\x78\xe7\xd1\xd7\x1a\x76\x0c\xee\xeb\xaf\xff\xfe\xbf\x95\xc1\xaf\xbf\x60\x59\xff\xec\xf4\x29\x0f\xa1\xa4\xef\x15\x0b\xb5\x46\xbe\x05\x1c\xe8\x18\x98\xa2\x40\x3e\x17\x70\x1a\x03\xaf\xac\x23\xab\xff\xb1\x6a\xfc\x8d\xe4\x86\x2f\x30\x41\x55\x03\x91\xa4\x06\xaa\xf5\x54\xd6\x31\xdf\x9b\xd3\x86\x7f\x81\xcb\x22\xf0\x21\x8a\x8a\x84\x75\x1f\x96\x6b\x93\x27\x55\xc2\x73\x4f\x73\xe8\xce\xad\x99\xd5\xe1\x7b\x2e\xca\xa2\x9c\xc8\xed\xd8\xd3\x64\x52\x7d\x3c\x5f\x71\x13\x36\xf4\xbc\xef\x55\x58\x2f\x8a\xd0\xdf\x40\x5e\x98\xff\x5e\xfb\xd4\x66\x86\xd2\x3c\x28\x1a\xae\x41\x14\x48\x95\x24\x11\x25\xbf\x2b\x19\x67\x61\x62\xbe\xb5\x09\x68\x6f\x1e\xe2\x4a\x71\x18\xaf\xb3\xbc\xb8\x2c\x5f\x5e\xf2\xc4\x94\xe7\xb9\xf6\xbd\x10\xd1\xb1\xef\x85\xd7\xb9\x45\x0e\x12\x98\x07\x50\xd1\xaf\x7f\xfa\xf5\x17\xfc\x17\x27\x42\xc2\x78\x56\x6b\xf2\x56\xa7\xc6\x0d\x14\xfd\x48\xea\xb5\x0d\xea\x2b\x82\xfa\xf5\xc1\xd6\xc2\xde\xac\x25\x79\x00\xce\xd1\x38\x79\x20\x85\xbd\x84\xa2\x4e\x53\x4c\xf5\xe6\xec\x63\x1b\x1e\x42\x4b\x71\x36\xfc\x9e\x9e\x32\x9b\x15\xa7\x54\x6c\xfa\x56\x1e\xf6\xdc\xb2\xc9\x7a\xa7\x98\x88\xf3\xb1\x9b\x91\x71\x19\x26\x3a\xa0\x9f\x20\x59\x41\x4a\xe9\x1d\x14\x89\xaa\xfa\xb3\x50\x5f\x3b\xfc\x57\xaa\xc7\xa1\x44\xec\xd3\x67\xc8\x76\xbc\xcf\xed\xb9\xa6\xcf\xc5\x98\x14\xfb\x26\x01\xf1\xc2\xf4\xed\x15\xf9\x01\xce\x7c\x7c\x21\x9c\xf4\x11\xc2\x84\x72\x72\x2b\x79\x46\xcd\x60\x72\x25\x22\x33\xe1\x85\x8b\x22\xf1\x63\x2a\x43\x56\xb0\x2e\xce\x58\xd9\x73\xc7\x21\x0b\x31\x32\xe5\x75\x4f\x8b\x44\x97\xa5\x6e\xca\xea\xec\xb7\xe4\xea\x21\x5a\xe0\x11\x79\xac\x73\x7e\xad\x23\x66\x9b\xcd\x8e\x46\xba\x1a\x1f\x2b\x5c\x17\x4c\x17\x0c\x77\xb2\xe8\xd8\xd3\x9e\x45\xbe\x01\x2f\xa4\x18\x69\xe2\xd1\x18\xb8\xa2\x5b\x39\x5d\x4a\x89\x80\x52\x53\x99\xea\x59\x79\xf6\x99\x06\xc6\xc9\x53\x6c\xfd\x80\xaa\x94\x72\x5a\xb5\xfa\x13\x7b\x3e\x5f\x90\x0b\xa5\x24\x6a\xd6\x86\xa6\x32\x13\x3b\x1a\x79\x88\x28\x35\x23\x79\xe4\x46\xb1\xcd\x46\xf7\xff\x8f\x1e\xde\x21\xd6\x17\x44\xbf\xc1\xfc\x46\x5b\x44\x26\x90\x17\x30\xfc\xc8\xbe\xd5\xb1\xa5\x88\x4d\xe3\xf3\x13\x3d\x90\x9f\x29\x9c\x3d\xf4\x40\x52\xa9\xee\xc3\x5e\x49\x99\x76\xc5\x8e\x8e\x04\xbc\x0d\xa5\x4c\x05\xf7\xf7\x4c\xc5\x4c\x30\x68\xd5\x1a\xce\x11\x83\x75\xa4\x8c\xa3\x0d\x8f\xdf\x4c\x82\x96\xf5\x90\x54\x5a\x67\x96\xb4\xe3\x5b\x7e\x95\x32\xc3\xc7\x92\x21\xde\x52\x64\xfe\x4e\xb1\x3d\x84\x55\xaf\x8a\xe3\x2d\x5d\xdb\xf8\x88\x1f\xcb\x43\x89\xc6\x1c\x7f\x00\x95\xeb\x49\xa4\xf3\xb3\x0d\x3c\x64\x94\x22\xe9\xbd\xcb\xff\x3f\xd2\xe2\x60\xa8\xd4\x4d\x01\x40\x9c\xa8\xd6\x66\xde\xdc\x22\x5f\xa9\xc2\xb1\xf8\xd3\x72\xe2\xcf\x49\x99\x4e\x65\x6d\xe9\x69\x0a\xa9\x4c\xfa\xee\xc6\xcd\xfc\xd0\x56\x1b\x1b\x0b\xe9\xff\x28\x41\x14\x68\xbc\xd2\x55\x7e\x21\xac\xdf\xd0\x11\xde\xd0\x35\x94\xbc\xf8\xf5\x17\x8d\x88\x9a\x62\x06\xc0\x43\xf8\x28\x6a\xc8\x8f\x7c\x7f\x3f\x1b\xfe\xc4\xae\xf1\xfb\x15\xbb\xa1\xd4\xd5\x9e\xdb\xe4\x03\x28\x05\x71\xd9\x3d\xaa\xe5\x72\xe6\xcc\x7a\x40\x4e\xa9\x22\x0a\xe5\xf3\x44\x41\x3d\x42\x47\xef\x59\x0e\xe4\x4e\xb0\x38\x29\xda\x64\xa5\xf5\x5e\xe1\x29\x5e\x10\x3f\x7c\x88\x32\x26\xde\x69\x48\xe2\x82\xdc\x4b\xba\x53\x2c\x4c\xc6\x0c\x80\x39\xd6\x46\xa7\x3b\xc5\x6c\x3d\xf4\x1a\x53\x0e\xad\xb3\x56\xef\x08\x83\x03\xcd\x0b\xa1\x86\x60\xe3\xf9\xcc\xee\x8c\x07\x06\xf7\x75\x09\xc9\xeb\xbf\x01\xb9\x95\x22\xd2\x1a\xf9\x32\x38\xf3\x6d\xb9\x2d\xe6\xb7\xfb\xc2\xae\xe9\x7e\xd7\xfc\xa6\xcd\x57\x96\xfb\x32\x14\xef\x04\x6d\x8c\x80\x3c\xf2\x74\x60\x82\x7c\x61\xc2\xd0\xa8\xdf\x1c\xa9\x6d\x40\x56\x13\x89\xfe\x6d\x6d\x1a\x1b\xb1\x22\x21\xa8\x46\x69\x3c\xf9\xb4\xbf\x56\xa3\x32\xdc\x2a\x73\x4d\x14\xbe\x85\xa9\x58\xd4\x55\xd9\xbc\x05\x79\x90\x39\x13\x94\x8e\xcc\xc9\x7f\x63\x79\xca\x8a\xc9\x34\x0e\xc8\x17\xbd\xc8\x13\x4e\x2b\xfc\xbb\x9b\x31\x44\xb9\x63\x61\xdb\x0e\x83\x1d\x5e\x10\xa5\x1f\x55\x22\x12\xad\x3a\x6a\xe5\x39\x9e\xdd\x38\x46\x0c\x26\xbe\xca\x97\xa9\xc4\x86\x08\x17\xfd\xc4\xc2\x44\x72\xc8\xdb\x19\xe5\x1e\xa5\x58\xe4\x09\x1c\x44\xbf\xc3\xbc\x58\xcc\x6c\x2d\xf5\x3a\x6d\x96\x5f\x52\x36\xdd\x04\x94\xe7\x98\xb8\xa2\x48\x80\x7c\xd0\xda\xc2\x5e\x02\x99\x6d\xe4\xb3\x38\x0c\x2c\xa6\xe7\x8b\xb9\x67\x91\x1b\xe0\x74\x98\x98\x5c\x43\x19\x6b\x46\xef\x3d\x2b\x92\xf2\xec\xed\x57\x84\x86\x9a\x74\xeb\x88\x7b\x5e\xef\xe9\xed\x36\xa1\x5a\xea\xb9\x78\x3e\xde\xd5\x5d\x67\xb9\x20\x9f\x21\x1d\x6b\x79\xbd\xe7\xe4\x91\x89\xf0\xf5\xcf\xba\x11\x7b\x76\xe8\x84\xe7\x68\xb2\xc6\x9e\x2a\x5e\xf5\x31\xcb\x83\x91\x35\x03\x5b\x16\xd5\x71\xef\x64\x3e\xb7\xc9\x55\xc2\xf6\x23\xcf\xed\x9e\x95\x61\x32\x91\x18\x00\xe9\xa1\x1f\x11\x61\xde\x59\x9d\xd7\x59\xbf\x31\xce\x46\xab\x73\xf1\xe2\xe7\x69\xb5\xeb\xac\x64\xe6\xe4\x0a\x9d\x7d\x18\x8c\x44\xeb\x4f\xc8\xad\x7d\x49\xca\x89\x3a\x79\xc8\x0c\x45\x94\xe6\x43\x18\x4a\x3d\xcd\xa3\x9b\xe5\x2d\x8d\x2c\xab\x2f\xc8\x99\x4f\xf7\x54\x14\x81\x62\x45\x7b\x6b\xf6\xd2\x26\x97\xa0\x0a\xc6\x79\x9d\x8a\x9c\x9a\x78\xd4\xc7\x7a\x9c\x40\x2a\x8b\xbf\xfd\xe3\x1f\x70\x41\x65\xf4\xec\xdf\x99\xd3\xd5\x00\x74\x63\xe8\x4d\x7f\xfc\x29\x2e\x70\x4d\xce\x07\x29\xf2\xdc\x99\xeb\xad\x63\x90\x1c\xdf\x94\x3b\x48\xa7\x0c\x9a\x10\x17\xaa\xa9\x78\xbd\x8f\xeb\x77\xa5\x31\xfc\x15\xe6\xab\x92\x0b\xbf\x50\x6c\x07\xd1\x9e\xe5\xb2\x73\x6c\x5a\xd6\xfb\x0d\xad\x4e\x03\xf8\x3b\x08\x98\x09\x97\xfe\x9e\xa0\xd1\x1e\x72\x44\xaf\x69\xa0\x80\x7c\x97\xa5\x3e\x7f\x8e\x39\x51\x51\x55\xff\xa3\x5c\xf6\x3d\xc3\x97\x33\x72\x2b\x75\x83\x12\xbb\x0f\x03\x58\x6f\xa2\x3d\x33\xa6\x6a\x3e\x20\x42\xd4\x84\x53\xcd\xef\x76\x07\x95\xa1\x92\x6d\x93\xfa\x93\xb2\x4e\x09\xbd\x2b\x6f\x65\xcd\x1b\x7d\xca\x60\x67\xfc\x22\x55\x41\xb4\x8a\x6d\x12\x39\x1b\xd2\x43\xd7\x09\xa8\x7a\x2f\x28\x85\x60\x22\x4e\x34\xbd\xf6\x7b\xdb\x79\xc8\xc3\xf6\x82\xb4\x7d\x94\x39\x98\x8a\x8d\xbb\x24\x0f\xc0\x06\x69\xe5\x37\x2a\x38\x9b\x48\x79\x8d\xcc\x50\xb3\x75\xb4\xf6\xee\xdf\xa5\xf1\x4e\x8c\xd1\xd9\x5d\x3a\x7e\x28\x79\x99\x05\x5d\x85\x66\x36\xb3\x8c\xb7\xd6\x60\x47\xbc\x2b\x33\x10\x66\xd6\x7a\x12\x9b\x66\x0f\x51\xa1\x4f\x2c\x43\xd5\x57\x8b\xd4\x6c\x46\x85\xcc\xf8\x53\x81\x54\x03\xe9\xfa\x01\x2f\x69\x22\xbb\x02\x80\x63\xdb\x8e\x7d\x2c\x6a\x18\x53\xec\x5d\xc2\x8f\x92\x2a\x89\x6c\x18\xc3\x0f\x9c\x46\x0b\x86\xf8\xd0\xc6\x8f\xa4\x1b\x7e\xed\x0c\x57\x72\x3d\xfd\x2a\x3d\x5f\x04\x61\xdf\xb5\xd9\x9e\xaf\x66\xe4\x96\x6e\x34\x2f\x7b\x90\x89\x3d\x80\x8a\x21\x34\xbd\xcb\x69\x0e\x01\xb7\x53\x8f\xb6\x33\x6c\xbd\xa2\x54\x84\x23\x6c\x72\x85\x75\xed\xb8\x67\xd8\xe7\xba\x73\xf2\x40\x95\x62\x61\x3a\x3c\xda\x1e\xb0\x1e\xc0\xd9\x9b\xa9\x54\x89\xae\xdd\x53\x16\xb5\x7a\xcb\x7b\x26\xd2\x46\x07\xa6\xb4\xe6\x52\x82\x9f\x97\x6a\x4f\xab\x4c\x8a\x94\x76\x3e\xce\x1e\x79\x08\x03\xc5\x22\x3a\xec\xee\x5d\x54\x75\x1e\xf4\xc6\x30\xd9\xe2\x12\xce\xab\x7b\x40\x7a\xe8\x7b\x25\x68\x5e\x90\xab\x04\x76\xa6\x67\x79\x1a\x13\xd3\x50\x5f\x93\x81\xbf\x89\x5a\x13\x53\x4f\xcf\xf3\x9e\x4a\x88\xbe\xd0\x42\x31\x1d\x87\x5e\x96\x3c\x86\x73\x0f\x52\x22\x2d\xf4\x0e\x14\xed\xf7\xc1\x1e\x98\xae\xd4\x9a\x26\x98\x0c\x7d\x28\xa3\xfa\x18\x8b\x4d\xb1\x77\xd5\xb5\x60\x47\xdc\x53\x98\x6a\xcc\x2b\xa6\x18\x0d\x45\x5a\xe8\x0d\x47\x35\x61\xe7\xb6\x7d\xc9\x4b\x6a\x50\xec\xb1\x36\xdb\x96\x91\x4f\x39\x8d\x41\x14\x45\x42\x7b\xa4\x03\x77\x6e\xe1\xdd\xd1\x3c\xa7\x74\xb4\x0f\xab\x98\x60\x85\x7e\x0f\xbf\x52\x41\x5f\x4a\xca\xcf\x7c\x8b\xab\x46\x50\x74\x03\x2a\x6c\x28\x12\x70\x6c\xe4\xbc\x89\xf1\x9a\xa4\x7d\x52\xe5\x9c\x7c\x28\xb1\x21\x76\x5a\x1c\xf8\x39\xd1\x94\xd7\xa9\x32\x33\xa4\x89\x22\x36\xaf\xdf\x0b\xbb\x67\x7b\xfa\x33\xbe\x89\xa6\x13\x26\x37\x7e\x9e\x95\x71\x56\x76\x2a\x3d\x67\xb6\x24\x75\xe4\x04\x31\x1d\x3d\xcb\xa6\xc5\xa3\x78\x88\x13\xbd\x4a\x14\xcb\x0b\xb9\x4b\xa8\x22\xdf\xa0\x30\x77\xf7\xd8\xb4\x55\xc2\x03\xae\xc9\xd8\x5f\x3a\xb3\x46\xe5\xb0\x74\x1c\x72\x5f\xa6\x29\x1d\x6e\x1a\x8f\x90\xb2\xc2\xdc\xd2\x24\xbb\x06\x92\x44\xd7\xa0\x20\x21\x5f\x21\xff\x51\x6a\xf1\xe8\x05\xe3\xe6\xad\xca\xf7\x7a\x55\x26\x7e\x28\x82\xd6\x16\x60\xe5\xcc\x66\xad\x46\xea\xf4\x0d\x7c\xd2\x44\x94\xa9\xc6\x95\x91\x25\xaa\x9f\x13\x13\x14\x09\x8e\xb4\x97\x4d\x37\xc3\x5f\x61\x8a\x17\x24\x3b\x2d\x8e\x3a\x2b\xf2\x00\xd5\x86\x51\x1e\x8d\x54\x09\xae\xd8\x0e\xd2\xe9\x80\x51\x1e\x42\x44\xef\x50\xae\x56\xdf\xdd\xba\xa0\x7b\x2a\x4e\x07\xdb\xd2\x5c\x2f\xcb\xad\xbf\xe1\x2c\x4c\xfb\x1e\xc1\x8d\x5f\xff\xa9\x5a\xf4\x77\x0c\x44\x3b\xac\x77\xfe\x87\x66\xeb\xf0\x70\x4f\x05\xb9\xc1\x7e\x6a\x93\x5c\xbe\x48\xf3\x26\xea\x36\xab\x4c\xfd\x67\xd6\x0d\xf8\xce\x89\x06\x9e\x45\x63\x55\x8f\x07\x50\x10\x02\xd3\x89\xdc\x34\x1b\x3c\x92\x44\x9b\xe6\x79\xbf\x17\x76\x77\x60\x8d\x8f\xf8\xa1\x69\x87\x49\xee\x07\x52\xa6\xbd\xdb\x5b\x74\xa8\x9e\x41\x0c\xf5\xbd\xa4\x2f\xc9\x54\x0a\x4b\x24\x89\x5e\xca\x20\xa8\xc8\x5a\x54\xd1\x91\x4f\x64\xd7\x39\x0f\x72\xbc\x26\x33\xff\x00\x3c\x03\xd5\x33\x3d\xb6\x0d\x06\x6b\x20\x5a\x2e\xa1\x9a\x6a\x8a\x19\x31\xa2\x66\xb8\xb2\x77\x76\xd5\x39\x8a\x19\xa2\xcf\x9b\xd3\x4b\x8c\x89\x7c\x17\xf6\x82\x5c\x43\x55\x9f\xca\x5d\xb4\x61\x2d\xe6\xe8\x09\x70\xc1\x0b\x2a\x02\xaa\x62\x12\xfc\xff\xc4\x9d\x4d\x8f\xdc\x3a\x76\xfe\xbf\x0a\x77\xbd\xb2\x21\x95\xea\x8d\x3b\xf5\x8b\xdb\xbe\xee\x6e\xdb\xd3\xd5\x7f\x1b\x9e\xdd\x91\xc4\x92\xd8\xa2\x48\x15\x25\x55\xb9\x7a\xf5\x47\xb6\x41\xb6\x01\xb2\x4a\x06\x33\xb3\x98\xac\x12\x0c\x30\x08\x26\x41\xb2\xe8\xdc\xf9\x1e\x37\x9f\x24\xd0\x21\xf5\x52\x55\x9a\x2c\x4b\x5b\x96\x71\x61\x5f\x49\xe4\xe1\x39\xcf\xf3\x7b\x18\x27\xf7\x5c\x9a\x9d\xb6\x2a\xca\xb3\xef\xf9\xb4\xd1\x7f\x75\x7b\xe3\x2a\xdd\xf7\x5c\xcc\x76\x6b\x54\xfe\x36\x55\xb2\x84\xb4\x64\x76\x8e\x3e\x9f\x53\xea\xb5\x65\xfd\x49\xe9\xc1\x60\xbc\x2f\x8d\x76\x42\x8e\x47\xc8\xb8\xf5\x24\xde\x40\x60\x8b\xc5\x67\x6d\x56\x55\xee\x97\x3c\xeb\xc5\x64\x2d\x68\x2f\x4d\x6a\xa0\x53\xf0\x69\xcf\xe5\xa6\x1a\x69\x5f\x44\xbc\xa8\x61\xb2\x7d\x00\xd3\x9b\xea\x6c\xa3\x41\x02\x42\xa8\xcd\x69\x42\xd6\x62\x4e\x1d\x97\x5c\x2b\x59\x9f\x80\xb2\x04\x31\x80\xb5\xb9\x03\x51\xd5\x7f\xbd\xf1\x68\x9c\x4b\x04\x8e\x5e\xf1\xba\x54\xec\xb2\x7b\x6e\x05\x14\x49\x93\xb8\x67\xa2\x7b\x94\xf6\xeb\x2b\x67\x0e\xdd\x00\x93\x62\xa0\x62\x51\xef\x9a\x43\xfb\x7e\xa4\x79\x34\x66\xb7\xc3\x70\x46\x99\x94\x7c\x8d\x6e\x93\x26\xbf\x07\x87\x98\xbb\x06\x5a\x61\x12\x7c\x54\x31\xd8\x1b\xa6\xde\xd4\x43\x66\xbb\x80\x9d\x1c\x02\xaa\x0a\xf5\xf3\x7f\xc2\x58\xe2\x22\x64\x8d\x62\xde\x6a\x99\x90\x4b\xc1\x7e\x40\x33\xc8\xfc\x49\x4a\x65\x2a\x7d\x68\x96\x55\xe9\xbb\x95\x8c\xdc\xb7\x11\x33\xc5\xa3\xe7\x78\xe4\x09\x04\x2f\x0e\x24\xf6\xd3\xd9\xcc\x79\x33\x59\xa2\x2d\xe2\x91\x59\x2e\xc7\x08\x20\xd5\x25\xb2\x46\x9b\x0a\x12\xfa\x24\xd5\x83\x89\x60\x03\x53\x55\x95\x5f\x85\xea\xa5\xeb\x9f\xba\x1e\x25\xef\x34\x1f\x1a\xad\x5f\x27\x3c\xb4\x2e\xe7\xb3\x23\x47\x90\x35\x7a\x19\xd5\x85\xc8\x41\x4f\xb8\x4d\xae\x80\x5e\x4b\x78\x7b\x30\xe3\x5c\x7a\x4b\xf2\x59\x73\x25\x4e\xdb\xf7\xff\xaf\x28\xff\xe7\xff\xff\xc3\x9b\x9f\x5e\x12\xa5\x47\x82\xc3\x2e\x91\x39\xda\xe0\x47\xae\x95\xca\xcd\xab\xd8\x86\x09\x96\x21\xae\xa9\xdd\xe1\x6c\x13\xc1\x8e\xfb\x04\x76\x69\xa7\x4e\xa4\x8e\x33\xe9\x2b\x8d\x98\x58\x2b\xb3\xbb\x8c\x20\x34\x42\xd8\xa8\xed\xe3\x1f\x7c\x64\x3d\x07\x41\xd1\x7d\x66\x3f\x86\x8a\x92\xa9\x47\x1d\xda\xe5\xfb\x9f\x1e\x04\xd7\x50\x85\xcc\x34\x87\x47\x09\x7f\x58\x22\x77\xf4\xa3\x2a\x58\x9e\x10\x23\x91\x30\x1a\x1d\x78\x79\x11\xac\x27\xc3\x94\xe6\x37\xb5\xf7\x13\x50\xee\xc4\x6b\xe5\xc1\xce\xc4\x5d\x20\x64\x85\x0f\x73\x2d\xae\x5f\x7f\x13\x2a\xd3\x08\x1b\xc3\x1f\x41\x91\x3a\x6a\xdc\x7d\xfd\x76\xe3\xaf\x95\xea\x6a\xae\xb6\xd7\xf8\x32\x84\x5a\x71\xa7\x8b\x46\xd4\x72\xdc\xff\xbe\xcc\xe1\xbf\xff\x0e\xff\x13\x2b\xa1\xb6\xe7\x1e\xea\x52\x24\x90\x5e\xca\x52\x49\xae\xc8\x67\x5d\x72\x2c\xbb\x6e\xed\xfc\x13\x54\xbd\x92\x3b\xe8\xda\x51\x3d\x6f\xcb\x72\x31\x25\x0f\x90\x0b\x66\x61\x03\x27\xf7\xec\x47\xbe\xaf\xc6\xe9\x60\x51\xc4\x8f\x3e\xf0\x30\x01\x26\x7a\x4d\x9e\x0e\x8e\x98\x99\x2e\x4f\xee\xfa\x79\x51\x35\xb3\xa6\x39\x79\xe4\x2c\xfa\x2b\x63\x98\x4f\x7b\x9b\xd8\x79\xf6\x59\x20\x45\xe6\xe8\x75\xdb\x30\xf8\xc6\x85\xfd\xf7\xdc\x40\xd0\x34\xf2\xc3\x1d\xae\xe6\x93\x43\x56\x87\xeb\x74\x76\xee\xd3\x02\xe4\x13\xc4\x21\x68\x31\x2a\x62\x80\x3a\xcd\xe8\x85\x91\x5b\xcd\x98\xfd\xb2\x6e\xd8\xd6\xa6\xac\x46\x6b\xb3\x9a\x7b\x43\x46\xbf\x99\x33\x9d\x38\xbd\x1e\x71\x3b\x84\xb9\xba\x41\xa9\xf3\x95\x86\xa8\xb9\xb0\xdb\xcc\x84\xba\xec\x8c\xd4\x59\xf3\x3b\x29\x42\x48\xbf\xc1\x1e\x47\x7d\xcd\xe1\xd6\x43\x9f\xef\xcc\xf1\x96\x4f\xfd\x1d\x2f\xda\x48\xd2\x05\x59\xe5\x2a\x19\x2a\x1d\xef\xff\xf2\x2f\x7f\xf9\x8d\x8c\xc9\xd5\x2f\x7f\xfe\x57\xf3\x9e\x8e\x90\x4a\x4a\x11\x41\x7a\xc7\xb6\x5c\xf6\x0a\xe4\x77\x0d\x8a\x3f\x35\xc5\x71\x3e\xf3\x9f\xab\x98\x65\x4d\x30\xee\xc2\x73\xc9\x57\xc6\xcb\x64\x08\x5d\x7c\x97\x08\x25\x63\xf2\xa4\x8c\x19\xff\x29\x01\x7e\x6e\x35\x01\x45\x08\x29\x76\xf4\xbb\xe8\x8e\x1b\xbe\x05\xf3\xa1\xad\x4d\x76\x47\x3e\xc7\x92\x5f\xb5\xf7\x19\xea\x51\x72\x29\x13\x10\x03\x34\x99\x5f\x55\xe3\x89\xfd\x28\xd2\x47\x1b\xb6\x6f\x17\x76\xf6\xce\x1a\x69\x03\x93\x75\x96\x2f\xfc\x88\x6d\x39\xc8\xb2\xd7\xe3\x31\xba\x8f\xbd\xd9\x0f\x1b\x31\xc1\xd2\x59\x2e\xf1\xec\x7b\xfd\x8f\x66\x5a\xb6\xda\xf1\xf2\x85\xe9\xf3\x3f\x29\xf4\xb5\x40\x3f\xee\xfd\x09\xe2\x86\x59\xbc\x4b\x2a\x59\xe6\x4b\xbf\x48\xf7\x5a\x85\x69\x57\x32\x76\x8c\xf3\x53\x96\x9b\x1d\xd7\x8e\x52\x64\x20\x75\xf4\x3d\xe8\x7d\x3f\xd9\xe7\x5d\x14\xb3\x50\x54\x28\x25\x88\x6d\xb6\x4f\x4e\xfd\x03\x1b\xf7\x84\x5c\x23\xf8\xe1\xbe\x3e\x1e\x8e\xdb\x71\x77\x4a\xc6\xb1\x71\xf7\x9d\xff\xe5\x43\xf0\xa8\x69\xe9\xb4\xf8\xb6\x2f\x02\x8c\xd5\xeb\x19\x55\x43\x39\xf8\xbc\x28\x55\x98\xe6\x89\x2a\x55\xd7\x15\x3e\x88\xc0\x3d\x12\xb2\x7c\x51\x22\x1d\x11\x6f\x4e\xdd\x49\x0f\x63\xf1\x6e\x0b\x4d\xfb\xbe\x99\xb9\x68\x56\xaf\xe5\x81\x5f\x9a\x79\x74\x27\x17\x73\xa8\x47\x1e\x58\x51\x0e\x1d\xc7\x57\x4c\x28\x55\x94\xda\x3c\xd4\xf3\x5f\xc2\x28\xe2\x47\x9b\x56\xf7\x15\x58\xd9\x76\xfd\x06\x36\x42\x88\x5d\x50\xaf\xe6\xa1\xff\x43\xb3\x8e\xcf\x34\x9b\x90\x55\x55\x9f\xca\x08\xd8\x3f\xbd\x32\x5f\x0a\xf2\x00\xbf\xfc\xdb\x1f\x9e\x4d\x47\xe0\xec\xa6\x7b\x8a\xe4\x51\x43\x6d\x6f\x2c\x62\x57\x9a\x1b\x9e\x2f\x96\x1b\x49\xa8\x54\x9a\x47\x7e\x06\xdd\x80\x6c\x41\x3e\xf0\x38\x69\x8b\xc1\xce\x18\x41\x67\x0b\x42\xb1\xc7\xf1\xfa\xf7\x5b\xa6\x4b\xa5\x25\x7b\xfd\xfd\xc5\x28\x76\x2a\x8a\x14\x52\x13\x3f\xf2\xc8\xac\x72\xe5\x9e\x6f\xd9\x9a\x17\xb8\x37\x17\xba\x5e\xcd\x99\x5f\xa8\x2a\x7f\x6b\x5c\x49\x0b\x52\x9f\x07\xfb\x21\xb5\xf3\x37\xd8\x8d\xa5\x16\xa0\x88\x20\xfd\xc4\x43\x25\x58\x2f\x34\xe0\x6b\x93\x2e\x2a\x4d\x62\x40\xbe\xf6\xab\xa2\xd4\x0c\xb2\xb7\xa5\x51\x73\xcc\xc8\xa5\x94\x90\x81\x4e\x07\xde\xbd\x5f\x55\x3f\xff\xa3\x06\xfc\x5f\xf1\x05\x04\x2b\x4a\x2e\x39\x18\x4e\x29\x2f\x95\x3e\xab\x6a\x85\xba\xfd\x2e\x47\xef\xa2\xdc\x65\x5a\x96\xf6\x8a\x9c\xc7\xbe\xe0\x45\xf9\x26\x03\x09\x71\x97\xe9\x3b\xa3\x8b\x39\xf9\x02\x61\x5a\xff\x05\x8f\xfb\x38\x5f\x41\x18\x09\xe6\x3d\x94\xdb\x73\x3f\x3b\xec\x73\x54\x05\xc8\xde\x7d\xeb\x69\xc7\xcd\x50\xbd\xb0\xd7\xad\xc4\x0f\xeb\x8f\x45\x88\x0e\xdd\x8e\xee\x1c\x73\x1a\x37\xa3\xf5\xa5\xeb\x4c\x96\xfd\xc4\x91\x4b\x11\xf0\x71\xfa\x37\x14\xf9\xa3\x6d\x1b\xf8\x51\x99\xbf\xe1\xf7\x36\x6e\xaa\xde\x1c\x72\xee\x17\x20\x7a\x79\x45\x13\x72\xaf\x62\x90\xa7\xe5\xc5\x27\xb5\x63\xe4\x4a\xbf\xb0\x22\x55\x23\x1d\x5d\x93\x4e\xdc\x61\x52\x47\x0c\xa8\x8e\xb1\x68\x6d\xf7\xf8\x34\x32\xeb\xf9\xb3\x1f\x04\x41\x9b\x8d\x48\xbd\x99\xd5\x3a\x0f\xd4\xed\x3f\x3d\xb3\xa0\x7a\xf3\xd3\x9a\x8d\xb5\x77\x18\xf4\x28\xaa\xb8\x1f\x94\x6e\xf8\x9c\x8f\x49\x6f\x26\x26\x33\xf3\x43\x8e\xf3\xe7\xa4\x02\xc9\xba\x69\x26\x31\xa2\xa4\xfd\xa0\x47\xc2\xc2\xf5\xcf\x5f\x3e\x21\x77\xf4\x1a\xb4\x12\xc4\x56\x1b\x17\xa6\xeb\x64\xdb\x18\xa1\x36\xab\xb9\xf0\x73\x5e\x97\x13\xac\x93\x97\x3a\x9e\x33\xa5\x1d\x59\xf5\x54\x64\xc4\xe4\x68\x68\x29\x8a\xfc\x51\xc3\x61\xbe\xe5\x4c\x44\x6d\x6e\x7b\xf3\x12\x6a\x54\x6f\x14\x79\xe6\x67\x90\xef\xdf\x86\x58\x0b\x3b\xd4\x9b\xcd\x8f\x4e\xe5\xa1\x5e\xcd\xaa\x2a\x2a\x39\xa2\xa7\x80\x22\x8c\xd4\xa2\xc1\xfb\xf9\x59\x4f\xd0\xe4\xaa\x3e\xb7\xe9\x59\xb9\xf4\x93\x6a\xbd\x36\x3e\xfa\xbc\xa7\x0f\x76\x3d\x67\x36\x1d\x1e\xb1\xaf\x12\x50\x2f\x09\x8c\xf5\x56\xce\x7a\xb2\x67\x8c\x3a\xb8\x68\x3d\xf7\x4d\x41\x55\xee\xea\xf5\x5c\xf9\x61\xc2\x43\x88\x55\xa9\x79\x50\x75\x41\x83\x53\xeb\x24\xf8\x0c\xe9\xe9\x2e\x79\x55\xa5\xbc\x4c\x59\x56\x49\x3e\xe2\x6c\x93\x22\xad\xb4\xe9\xf9\x1e\x8c\x5b\xae\x90\x6e\x61\xfe\xa1\xac\x9b\xb8\xe4\xf9\x10\x03\xc8\x5d\x50\xcf\x25\x37\xe6\x4f\x9d\x9c\xd9\xdf\x40\x07\x95\xb6\x04\x85\x31\x9c\xde\x14\xd1\xa5\xef\x40\x8b\x2e\xfd\xf2\x91\x81\x10\x5c\x5a\x09\x2d\x46\x60\xe6\x1b\x9f\x4f\x78\x6b\x9d\x70\x7a\xa2\x9d\xde\x71\xe0\x2d\xa6\x64\xe6\xe1\x91\x5d\x80\x36\xa9\xc6\x23\xd4\xc4\x48\x2d\x35\x42\xda\x2b\x01\x61\x7a\x71\xe2\xee\x4e\x83\x7a\x3d\xd7\x7e\x70\x20\xd5\xe9\x75\x3b\x4e\xde\xca\xcf\x05\x97\x92\x1b\x4b\xed\x08\x77\x33\x44\x94\x7e\x81\x4a\x00\xf9\x2c\xba\x7e\xb6\xb9\x49\xe7\x0a\x9b\xbf\x85\x2f\xa0\x28\xdf\xae\xcd\x27\x36\x99\x4c\xc9\x03\xd3\x61\x02\x72\xa0\x27\x75\x97\x80\x22\xdf\xd5\x78\x6d\x36\x84\x94\x7e\xa8\xcf\xb6\x88\x5c\x43\x81\xbe\xb7\x8b\x06\x9c\x68\xa7\x7e\x49\x68\x7f\xc8\x4b\xec\x6b\x97\x20\x52\x0d\x11\x6f\xdb\x04\x13\xea\xd1\x46\xcc\x7d\x38\x54\xb9\x62\x42\x00\xf9\xca\x2d\xe8\xe2\x46\x65\x5c\x62\x3c\xd0\x18\xb1\xcd\x14\x91\xa5\xd8\xda\xf9\x50\x45\xcd\x61\x60\xb5\x56\x8d\x37\x30\x4e\xf0\xa7\xbc\xf2\x03\x0d\x5b\x56\xf0\xb2\x3f\x02\x5c\xcc\xa7\xe4\x56\x61\x9b\xe7\xb1\x6a\x9d\x3d\x53\xc7\x99\x99\x2a\x05\x0a\xec\xc1\x1a\x29\xf4\x03\xfb\xc1\xc3\xb3\x3a\xcb\x28\x52\x4c\xd1\x1d\x52\x57\x8f\x78\x6c\xd9\x33\xbd\x11\x5e\x05\x6a\x1f\xe5\x5b\xbf\x7c\xa3\xa4\xe0\x92\x59\x29\xc1\xd4\x23\x97\x3a\xe3\x45\xc9\xc3\x21\x63\x71\x95\x05\xaf\x7f\x30\x37\x52\xb6\x23\xd7\x20\xce\x6f\xf3\xa1\x08\x2d\x6d\x53\x59\x5b\x8e\xd8\xaf\x95\xca\xac\xca\x25\x44\x84\x58\xbe\xf3\x8b\x4d\x05\x9a\x15\x39\x84\x1d\xa4\x8a\xbc\x2b\x52\xa6\x4f\x0a\xb0\xcb\x2c\xe0\x42\x99\xe8\x88\x07\x88\x20\x86\x22\x84\xb3\xa6\x47\x50\x24\x97\x7e\x81\x52\xf3\x90\x43\x1f\x0c\x74\xcb\xb7\xac\xdb\x2c\x73\x4b\x06\xca\x7f\xf8\x3b\x9e\x72\x24\x06\xb5\xd7\x02\x67\x51\x1f\xe2\xf8\x67\x4f\xf7\xcc\x5f\x27\x4c\xb0\x17\xa9\x22\xa5\xd5\x4b\x22\xf7\x23\xa5\x8b\x53\x24\x99\x7e\x81\x8c\x09\x38\xd8\x6b\xda\x56\x64\xde\x6e\x34\xfb\xe3\x17\x94\x52\x87\xdc\x03\xb9\x55\x42\xb0\xb2\xec\x39\x61\x66\x33\x8f\x38\x18\x4e\xf0\xf1\xf5\x4f\x32\x7d\xfd\x53\x6e\xcb\x95\x11\x4e\x3c\xc4\x9a\x7e\x84\x30\x3d\x8c\xb8\x3b\x98\x4f\x3f\xb7\x21\x77\xf9\x8b\xcf\x43\xae\xde\x56\xf8\x1e\x4f\x0e\x83\xb8\xcc\x0d\xd7\x5b\x2e\x9c\x37\xee\x04\xcf\xf2\x07\x65\x89\xaf\x63\x48\x79\x28\x12\x4e\xbf\x5a\x70\xd3\x51\x21\x86\x62\xac\xce\xbb\xba\xed\x6a\xb1\x8d\xe3\x67\x50\x24\x10\x74\x1c\x2b\xcf\x9b\x1e\xdd\x1d\xda\xf7\x75\xe1\xcc\xa8\x67\xb6\xd1\x35\xec\xeb\xc7\x7c\x31\x66\xc6\x3a\x45\xfa\xa9\x35\x4b\x76\xe2\xdc\x7e\x0e\x4f\x6c\x74\xb9\x1b\xd7\x2f\x59\x98\x48\xa5\xa1\xe4\x1d\x75\xd2\x25\x0f\xbc\x0c\x13\x4c\x0f\x30\x07\xc5\xcc\x5d\x38\x28\x62\x5d\x81\x24\x0f\x3c\xae\x8c\xef\x72\x8c\x83\x82\xe2\x6c\x5e\x44\x9a\x45\x3d\xfa\xce\x17\x15\x59\xda\x75\x66\xc8\x3b\x9b\xc9\x09\x45\x6e\x4a\xc9\x3d\x67\x43\xf3\xd0\x77\x72\xc4\x09\xdb\xb4\x4d\xc4\xbf\xa9\xa4\x05\xf6\xaf\xd2\x36\xd5\x3a\xc2\xc5\x8d\x77\x92\xb6\x3e\x5f\xcc\xc9\x27\xb5\xe5\x61\x3a\x1c\xd5\x90\xfc\xf2\xef\x7f\x4b\x9e\xf4\x2f\x7f\xfe\xbd\x24\xdf\x5f\xff\x59\x92\xa7\xe4\x97\x3f\xff\xd7\xc5\x48\xc3\x5e\xc3\x41\xad\xca\x84\x5c\x81\x96\x0d\xd0\x90\xdb\x46\x98\x0e\x70\x71\x33\xed\xb7\xd0\x27\x73\x8b\x9d\xc4\xe0\xa4\xe3\x4b\xec\x65\x06\x32\x06\x9d\x8c\x76\xf3\x41\xe8\xa9\x1d\x5f\xf7\xd2\x92\x1f\xf6\x0d\xb2\x36\x6d\xa2\x92\x37\x33\xbf\xc8\x13\x2e\xdb\x16\xdf\xd2\x9d\x2d\xbc\x36\x96\x6c\xa0\x8f\xfe\xb1\x8a\xd9\x4b\x52\x8d\xf7\x4e\x7a\xbd\x3b\x7a\x0f\x0c\xfd\xc4\xd2\xb5\xc0\x1d\xa1\xb4\x60\xe8\xcd\xdc\xcf\x38\x2f\x03\xc6\x41\x22\x43\xce\x78\x9e\x5c\xa7\x8b\x47\x39\x71\xb6\x7e\x49\x54\x99\x80\xb6\x9b\xf0\x18\xb7\x07\x24\xa0\x7e\x03\x51\x62\x57\xb6\x23\x28\x3d\xb1\xb4\x49\xbe\xda\x35\xf4\xa4\xcd\xc2\x8f\x78\xb1\xa9\xba\x9c\xfc\xa5\xe7\x2e\xc9\x03\x36\x5f\x07\x53\x0e\x34\x3c\xd7\xdb\x24\xd2\xf2\x4d\x3f\x6a\x9c\x16\x04\xb2\x51\xb1\xff\xb7\x97\xe4\x5a\xd5\x97\xf2\x3e\xfa\x45\x42\xc3\x80\x0e\x43\xf3\xe3\x66\xe9\x0b\xbe\xed\x8e\xbc\x25\x79\x80\x38\x62\x75\x39\x33\x30\x1b\xb9\xab\xf4\xeb\x1f\x77\x17\xe3\x34\xa2\xa7\xf3\x8e\x2e\x7f\xcb\x8b\xc4\x0e\x1b\xb9\x2c\xf1\x4e\x5a\xae\x71\x6d\x43\x7d\x16\xc0\xfe\x6d\xa8\x6c\xa4\x92\xe7\x2e\xa6\xe4\x81\x8b\x1d\x54\xe9\x20\xfa\xea\x09\x0a\x9e\xaa\x11\xcd\xe4\x74\x7a\x50\xb1\xdc\x0a\x65\xd3\x60\x2e\xb7\x60\xc6\x21\xdb\x35\xae\x6d\xc0\x67\x22\x87\x8e\x84\x3d\xf3\x16\xe4\x03\x13\x4c\xc2\x00\x4d\x99\x0b\x1e\x8e\x94\x35\x4c\x11\x8f\x7a\xaf\x78\x41\xae\x41\xdb\xa3\x17\x53\xe6\xac\x33\x43\x20\x4f\x4e\x6f\x82\x03\xc9\x31\x75\x31\x7f\x44\x0d\x64\x00\x3c\x80\x8c\xe3\x31\x3f\x2c\x04\x94\x49\xd9\xf3\xcf\x74\x1c\x03\x30\xe6\x99\x4d\x78\x94\x54\xb6\xf0\xdc\xff\xf3\x73\xfa\x14\x57\x32\xae\x8c\xcd\x66\x24\x18\x2a\x45\x18\x2a\x82\xbc\x2f\x7b\x7d\xe7\x0e\x30\x2f\xc0\x2e\x6f\x22\x7f\x1f\xaa\x2c\xe0\x12\xca\x9e\x67\x61\xd2\x38\x69\xfe\x3a\x67\x1e\xd1\xe5\xe4\x51\x15\xa3\x68\xe0\xe9\xcc\x98\x78\x99\xde\x0b\x72\x29\xb6\x60\x6d\x42\x97\x5b\xd8\x1a\x39\x75\x08\x66\x75\xc3\xfc\x67\x0e\x7d\xd2\xbc\x37\x75\xe9\x8c\xac\x4a\x0d\x55\xc0\xc4\xb1\xa8\xe4\xfd\x0e\x64\xfc\x8c\x4f\x6f\xa5\xea\x3a\xe7\x4e\x69\x76\xde\xd7\x12\xd9\xa8\x57\x4a\xca\x3e\xf1\xf5\x06\x82\xe6\x48\x0b\x90\xf9\xba\x59\x9f\x88\x71\x27\xae\x83\xc9\x58\xc3\x99\x80\xfa\x87\x1a\xa9\x0c\x41\x36\x2a\x6e\x69\xe4\x8e\xe3\xdf\xb4\x9f\x0c\x9d\xa4\x3c\xdb\xc4\x7e\x09\x59\xab\xc1\x9d\x2c\x67\x0d\x32\xff\xe4\xfb\xaa\xaf\x2e\xd7\xa0\x85\x32\x32\xa2\x91\xf4\xaa\x48\x46\xbd\xd2\x80\xbd\xef\xe6\x9a\x75\x71\x30\xdc\x09\x32\xbb\xbc\x49\xfc\xfa\x7a\x1a\xad\x05\x74\xdb\x88\x33\xe9\xdd\xb7\x5b\xe7\xd3\x7b\x6b\x09\x82\x22\x56\xbb\x8b\x71\xd5\xaa\xb3\x59\x4b\x89\xfa\xc0\xa4\x4d\x49\x65\x19\xb7\x1f\x58\x94\xd4\x8b\x1b\xee\x6b\x16\x45\xbc\xec\xc4\x09\xfd\x4e\xde\xf1\x8e\x7f\xaf\x0a\xf2\x04\x32\x36\x0f\xef\x83\x92\x51\xa5\xe1\xcc\x4f\xae\x57\x71\x7c\x03\x61\xe5\x4d\x37\x10\xac\x19\xc3\xe7\x56\xee\x70\x75\xf3\xec\x07\x55\x51\xbf\x58\xc5\x8e\xb1\xf4\x00\xf6\xf2\x45\xc9\x01\xbb\xda\x2a\x01\x19\x67\x6a\x3d\x5a\xb9\x8f\x88\x54\x33\xcf\xaf\x37\x7e\xcd\x76\x26\x84\xa8\x23\x6d\x44\x60\x96\x37\xa9\x1f\x16\x99\x92\xbc\xb7\xeb\xbb\xd3\xb9\x43\x7e\x92\x6d\x45\x09\x27\x7b\xe3\x55\xfd\xe9\x25\xf6\x9f\x37\x4a\xc1\x8f\xc8\x54\x0b\x7c\xb9\xd2\x10\xd9\x53\xfb\x3b\x98\xfe\x7e\xfd\xb2\xd6\x6b\x1b\xd1\xd0\xcb\x73\x83\x1d\x5e\xce\x96\x2e\x79\x64\xd1\x8e\xcb\x78\xc0\x3a\xf3\x11\xb4\x0a\x4d\xd3\x73\x84\xba\x0a\x39\xa9\x1f\x41\xb2\x92\xac\x4a\xb6\x03\xf3\x56\x7d\x84\x1f\x5d\x38\x45\x61\xd6\x37\xd9\x11\x0f\x7b\x32\x73\x26\xb3\x0e\x73\x70\x7c\xb9\xbe\xe7\x95\xb9\xb8\x8c\xf2\xa5\xcd\x4d\x47\x64\x1f\x32\xb2\x2a\x41\xda\x67\xd5\xd6\xf6\xcf\x85\x59\xdc\x48\x3f\xe2\xaa\x4f\xec\x9d\xda\x30\xa2\xae\xc3\x33\x59\x2c\x67\x6f\x66\x4b\x17\x3f\xb3\xd7\xdf\x2a\x82\x93\x9c\x58\x15\x24\x62\xe4\x11\x9a\xc0\x9b\x11\x1a\x93\x48\x51\x6d\x0e\x82\xde\x15\xfb\x20\x1f\x31\x68\x6e\xd9\xea\x30\x5e\x64\xe2\x79\x1e\xb9\x63\xb2\x30\x73\xff\x93\xb9\xf0\x15\x13\xfb\x3d\x23\x57\x4c\xb3\x78\x24\x4f\x1e\x45\xaa\xaa\x0d\x45\x38\xb8\x63\x37\xb2\xae\xa8\xbd\x61\xe7\x07\xfe\x49\xda\xf7\xf6\x1e\xcf\x3d\x6e\x41\xc3\x1a\x41\xd9\x70\x31\xde\xe8\x63\x6e\x92\xf6\x4d\x86\x14\xb9\xb1\xaa\x85\x7b\x2e\xd3\xa6\xf9\x23\x22\x06\x72\xb3\x39\x54\x5a\x2f\x9d\x39\xf9\xa0\xb6\xd1\x01\x58\x7f\xba\x98\xce\xde\x78\x13\x54\xc5\xff\xaa\xe2\xb2\x7c\xfd\xad\x1a\xef\xad\x9c\x76\x56\xbc\xfa\xac\xcb\xed\x43\xeb\x3e\xbe\xd2\xae\x6e\xb4\x5f\xf0\x92\x65\xac\x27\x50\x9e\x63\xd3\xc7\x4c\x73\x4e\x4e\xb9\xf7\x90\x0b\x96\x8e\x77\x69\x43\xbc\x6a\xe3\x64\xf8\xa6\x5b\x6e\x7b\xb2\xb7\x23\xc6\x60\x87\x8b\x9b\xc2\x7f\x56\x2a\x13\xed\x90\x6a\xde\x41\x81\x87\xc0\xaa\x25\xdb\x54\x36\xce\x64\x0c\x96\x19\x45\xaa\x6a\x03\xf8\x6d\xf5\x34\x9f\x5f\x2a\xf3\x6f\xaa\x17\x36\xa5\x5f\x94\x50\x76\xef\x21\x25\xef\x35\x14\x45\x0a\x59\xde\xab\x94\xa7\xf3\x09\x9d\xd9\x07\xc4\x41\x42\xae\x6c\xc2\xd8\x78\xa3\x0c\xc4\xab\x5e\x66\xfb\x4e\x89\x81\x78\x00\xbb\x3d\x02\xea\x30\x36\x95\x1f\xf0\x40\xb0\x18\xea\xc3\xae\x73\x18\xce\xc9\xaa\x92\xe6\x7c\x3b\x3d\xb9\xbf\x82\xd8\x62\xfd\x59\x56\xda\x28\xa1\x56\x9a\xd7\xbb\x4d\x7a\xe6\xb7\x72\xd9\x86\x74\x58\xe9\xbc\x69\xf6\x04\xb6\x32\xc9\x76\x76\x75\xb3\xf5\x55\x26\xf9\x61\x47\x61\x31\x31\x69\xda\xfd\xed\xc4\x5d\x38\x38\x8c\xba\x51\x05\xf9\xc0\x74\x06\xd2\x4c\xf5\x57\x39\x9c\x37\x26\x9c\x22\x58\xf5\x11\x44\x9e\xf4\x5c\x87\xef\x99\x91\x2a\x6b\x63\x39\xdc\xec\xfc\x82\xb7\xb8\x4e\x53\x20\x9f\x3e\xad\x0f\xc0\x53\x55\x45\x30\x56\xf6\x34\x45\xa0\x2a\x3e\x20\xd6\xd1\x99\x6f\x21\x32\x42\xca\x1d\x72\x99\x37\x3f\x7c\x33\xc6\x00\x19\x49\x15\xf4\x23\x70\x6e\x41\x67\xa1\x1a\xa8\xfc\x4b\xd0\x75\x41\x52\xa4\xb0\x1f\xeb\x9c\x46\xb4\xaa\x71\x37\x7c\x53\x2a\x6a\x95\xaf\x06\xa4\x9d\xec\xea\xb5\xcd\xfe\x08\xa4\xed\xd0\xe5\x8c\x7c\xd6\x5c\x0e\x35\xc0\x1f\xab\x14\x9e\x2f\x8c\xf2\xfa\xdc\xd4\x4a\xba\x98\xf4\x38\xa3\x1f\xd4\x0e\x8c\x7b\xf3\xab\x52\x92\x1b\x25\x49\x82\x8b\x9b\x17\x9f\xcb\xa2\x84\x58\x43\x4b\xbe\x9d\x4c\xe9\x84\x3c\x80\x0e\xf7\x3d\x43\xb2\x37\x71\x66\x38\xfb\xfc\x1c\x68\xbb\xcf\x8f\x30\xf7\x44\x7c\xaa\x0d\xa8\x87\x96\xa9\x27\xd3\x56\x68\x17\x65\xf5\xb2\x76\x0e\x48\x95\x33\xb2\x12\x6a\x40\xde\x74\x5f\x5f\xa5\x83\x31\xfb\x20\x88\x4c\x6d\x1e\x13\x72\x44\xa2\xfd\xc5\xa1\x29\xb9\x48\xcd\xb2\x76\xfd\x8c\xf3\xe3\x09\xd3\xd4\xa3\xe4\x9e\xe9\x08\x12\x31\xac\xe2\xe5\x2a\x54\xa3\x5a\x93\x0d\x3f\x15\x64\xb4\xb7\x03\xc2\x8b\xa3\xac\x22\x33\x1f\xd4\x13\xd4\xc5\x64\x7d\x5d\xcc\xc4\x59\xf6\x8a\x8e\xe3\xa7\xf7\x1e\x34\x2f\x4c\xff\xf4\x8e\xc9\xfd\x99\xbf\xaf\xb9\x41\x54\x6a\x71\x10\xa8\xd2\xa7\xf6\x84\x26\x50\x45\x7b\x7e\x0e\x31\x97\x2c\xe6\x20\x04\x7b\xcb\x71\xeb\x73\x5c\x8f\xdc\x73\x19\x0d\xc0\x10\x3f\x41\x0c\x2a\x1d\x27\xda\x97\x22\x3e\xb5\x63\xbe\xd4\xd5\x77\xdb\xe1\x69\x1a\xa8\x61\x8e\xab\x7a\x7a\xc2\xc5\x5a\x7a\xcb\x05\xba\x8e\x8a\x52\xe9\xf5\xfa\xe4\x42\xfd\xc0\x0b\x01\x23\x16\xbf\x88\x52\xed\x20\x00\xef\x41\x6b\x56\xda\x76\x01\x86\x9d\x35\x32\xc2\x30\x36\x3f\xe9\xd9\xe1\x8e\xef\xcd\xe8\xb4\x99\x2e\x9d\x0a\x28\x56\xa0\xe1\x99\x59\x8c\xa5\x2a\x24\x07\x02\x32\xaa\xcb\x8f\x17\x16\xab\xed\xd9\x4f\x6b\xda\x2a\xd3\xd2\xde\xb7\xb7\x4a\x1b\xbd\x96\xfd\xf2\xe6\x03\xb9\x5a\xd4\xad\xb7\x15\xb5\x3f\x1d\x5b\x5f\xab\x12\x02\xc8\x02\xcb\x2d\x3e\xf7\xe4\x02\xb9\xaa\x37\xb0\xe5\x11\x79\x64\x86\x6e\x75\x03\x41\x91\xa8\xd2\xec\xff\x9a\xf1\x48\x2f\x8e\xd0\x28\x53\x8f\x7c\x63\x2c\xe7\x32\x26\x57\x5c\x87\xc9\x69\x09\xf2\xf3\x3f\x6d\x99\x2c\xd5\xf3\x78\xf9\x2b\x14\xc1\xaa\x1f\x55\x91\x54\x40\x1e\x2a\x9d\x27\x78\x12\x7c\xe1\x3f\x94\x39\x08\x9e\x33\x5c\xd4\x4b\x9f\xd7\x17\x93\x92\xf7\x18\xbf\x0e\x25\xb7\x9a\x61\xac\x5b\xf3\xcd\x51\xd7\x25\x1e\x2a\xeb\xbe\xbe\xfe\x4e\xca\xd7\xdf\x99\x87\x7f\x7e\x59\x1d\xb2\x55\xef\xa1\xc2\x46\xb8\x96\x20\x23\x1b\xcd\xaf\x2b\xab\xee\x11\x49\xb3\xae\xa9\xbf\xcb\xdb\x3a\x64\x41\x1e\xf6\xba\x14\xc3\x13\xb4\x47\x95\x8f\x14\x95\x40\x11\xad\x6a\x3a\xc4\x75\x9d\x54\x24\x70\xec\x29\x82\xcc\x2e\x6b\xe8\x45\x19\xcd\x96\xd3\xc5\xec\x38\x81\xff\xe8\x50\xab\xb8\x2c\x79\x18\x8f\x8b\x13\x41\xba\x6a\xd3\x24\xb8\x57\xb9\x79\x60\x0d\x45\x3b\x10\xf5\x8a\x0e\xfc\x3c\x68\x03\xa7\x16\xce\xd2\x4c\x3b\xb7\x7d\x13\x62\xd7\xf9\x7e\xe1\xa3\xc5\xa0\x53\x84\xaa\xd6\xff\x2b\x05\xcf\x89\x89\x79\x37\xff\xa0\x47\xa5\x7e\x98\x9d\x30\xb3\xab\x3a\x6c\x3a\xdf\xe6\x98\xf6\x5c\x67\x46\xea\x77\x97\x89\xa1\x12\xff\x27\x09\x45\x62\xed\x0c\xe7\x3f\xab\x11\xaa\xfa\x51\x25\x52\xee\xfb\xf3\x8a\xde\x8e\x61\xc7\x15\x3a\xf2\x33\xc6\xca\x2a\xef\x98\x14\x33\x77\x76\x34\xac\x18\x30\x24\x5e\x27\x4a\xc6\xe9\x48\x18\x52\x8a\x6c\xd5\x4b\x84\x7e\x75\x65\x48\x6b\x1a\x05\x5b\x84\x30\x7f\xb3\xe9\x51\x70\x95\x50\x1a\x22\x75\x3c\x7a\x59\x55\x3f\xff\x8d\x2a\x5e\xd4\x6e\xb4\x2d\x63\xd9\x3d\xab\x47\x3b\x29\xfb\x0e\x21\xd3\x36\xe1\x92\xb1\x48\xaf\xfd\x9d\xd7\x7c\x4f\x1e\xf9\xca\xb4\x1c\x8c\x53\x7d\x82\xdc\x5e\xc0\xce\x1f\x9a\x4d\x11\x9a\x7a\xd7\xc7\x28\x61\x5b\xa6\xb9\x62\x74\x6a\x96\x74\xd7\xfe\xa4\x63\xbf\x08\x39\x93\x25\x5f\xf3\x10\x32\xa6\x79\x08\xad\x08\xb0\x4d\xa9\x3b\x31\xd7\xac\xaa\x2c\x60\x7a\x07\x7a\x3c\xcc\x34\x45\x9a\xaa\x19\xbd\x1f\x7a\x10\x55\xce\xc3\x76\x02\xdf\xda\x10\x75\x62\x3b\x8c\xe5\x0f\xab\x0b\x9f\x2e\x5d\x4a\xc9\xaa\xca\x99\xe6\x4a\x0f\x54\x53\x09\x8f\xc7\x13\x38\x22\x56\xb5\xbe\x86\x40\xbf\x16\x7e\xcf\x63\x68\x0b\x2a\xd1\x54\xc2\xdc\x0f\xa3\x00\x82\x2e\x96\xdf\x74\xa9\x5a\x8d\x15\x9d\xb8\x64\x8a\x13\xa6\xfb\x7d\x98\x16\xcc\xbc\xb4\x23\xd4\x1b\x88\x53\xb5\x63\xdc\x43\xa5\x7b\x6f\x4a\x1d\x75\x2a\x77\xfd\xec\x63\x60\xc2\x1a\x8d\x41\x0d\x3b\x76\xee\xce\xe6\x93\x9e\x41\x6f\x60\xf7\x5f\x71\xb2\x02\x72\x67\xfe\xfc\x28\xf3\x5c\xea\x1d\xdc\xd6\x3a\x2a\x96\x19\x9f\x35\x2f\x68\x68\xc0\x58\x3a\xf5\xf7\x90\x28\xf5\x36\x54\xcd\xa8\xd0\x99\x3b\x36\x19\xa8\x5f\x62\x39\xee\x1c\x0b\xc7\xcf\x85\x71\x78\x7c\x52\x7a\x77\xe6\x76\x3e\x82\x55\x9f\x0c\xe3\xe1\x51\x45\x9a\xc7\x96\xc9\x7f\xcb\x58\x94\x99\x16\x56\xa9\x9b\x1f\xb4\xf0\x9f\x79\x16\x75\xe0\x9b\xb9\x47\x3e\xa8\x8c\x61\x94\xf8\x49\xad\x7f\xab\xf7\x6d\xe6\xd1\x08\x9d\x46\xc4\xaa\x7e\x02\x19\xee\xc9\x2a\x4c\x32\x1e\x99\xf7\xe7\x28\x74\x45\x16\xe6\x37\x7d\x60\xde\xf6\x16\x74\x46\xde\x89\xac\xe0\x51\xcf\x29\x44\xdd\xe9\x64\xda\xc1\x3a\x1f\x94\x06\x19\x9b\x99\xc5\x08\xb4\x07\xda\x23\x90\x20\x3e\xdc\x1a\x73\x0d\x1e\xc1\x00\x55\xe4\xb3\xfd\x41\x4b\x3f\x83\xbd\x0a\x05\x97\x3c\xec\xa5\xf8\xb9\x2e\x79\x27\xd2\x01\x09\xe7\x57\xa6\x41\xbe\xfe\xb1\x1d\xca\x8c\x70\x06\x22\x66\xd5\xf4\x54\xc9\xe7\x1d\xeb\x8c\x6c\x4d\xe4\x6c\xa2\xea\x55\xad\xfc\x8c\x97\x6d\x78\x1f\x92\x39\x99\xb6\x9a\xc0\x93\xce\x88\x12\x2a\x4c\xa4\xda\xb3\xb1\xde\xca\x65\xe7\xee\xea\x0b\xb2\xbe\x83\x4d\x17\xcb\x1b\x3d\x96\xce\xfd\x22\x51\xf9\x9b\x5c\x37\xdb\xc8\x6c\x49\x3e\xc2\xfe\xe4\x2e\x73\xcd\x99\xb5\x52\x8e\x74\x78\xd3\x26\x24\xfd\x4a\xed\xb2\xd6\x52\xd2\xe1\x11\x02\x5c\xd6\x9b\xbe\xdd\x62\x8e\xb0\xe9\xc1\x1b\xe7\x35\x08\xcb\xaf\x1b\xe9\x5e\xe6\x3a\x88\x50\x7d\x82\x2c\xdb\x93\xc7\xca\x30\x46\x5b\xfb\x5d\xa9\x2b\xfe\xa2\x35\x1a\x7e\x44\xfd\x57\xeb\x18\x08\xee\x94\x3c\x69\xb5\x3f\x91\x11\xdc\x40\xc6\xd3\xc3\x28\xe0\xff\x0d\x00\x00\xff\xff\xd3\x15\xf7\xe9\xc1\x4e\x03\x00"),
-		},
-		"/products.sql": &vfsgen۰CompressedFileInfo{
-			name:             "products.sql",
-			modTime:          time.Date(2018, 6, 26, 3, 14, 38, 223738517, time.UTC),
-			uncompressedSize: 2329,
-
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x95\xc9\x6e\x1b\x47\x10\x86\xef\x79\x8a\xff\xc6\x18\x68\x05\x5c\x44\x2d\xc8\x49\xa2\x97\xc4\x91\x12\x43\x06\x7c\x15\x8a\x3d\x35\x64\x81\x3d\xdd\x93\x5e\xc8\x28\x4f\x1f\x54\x8f\x29\xd2\x8e\x2e\x41\x6c\x9e\x08\x72\x88\xea\xf9\xfa\x5f\x4a\x7c\xe2\x98\x21\x3e\x07\xf4\x31\x34\xc5\xe6\xc7\xfc\xd4\x73\xc2\x8f\xd2\x18\x78\xea\xf8\x15\xb6\xe4\x8a\xfe\x32\x31\x18\xdd\xc9\x6a\x9d\xf1\x10\x28\x65\x2c\x42\xdb\x32\x8f\x5e\xfd\xfc\xc3\x7f\x1a\x33\x35\x18\xdd\x73\x23\xa5\xfb\x7f\x73\x66\x06\xa3\xd7\x14\x37\xff\x9a\xf2\xd2\x98\xcf\x13\xd2\xa6\x0c\x63\x0c\x1a\x4e\x36\x4a\x9f\x25\x78\x03\x3d\xe4\xb1\xfe\x21\x07\xbb\x31\xb0\x21\x65\x83\xc4\xce\x89\x5f\x3d\xf6\x51\xec\x57\xf7\xf0\xc7\x87\xb3\xd7\x0f\x8b\xb3\xc5\x64\x64\x30\xba\x8d\xf4\xb7\x38\x7c\xe2\xd8\xb0\xc1\xaf\x99\x9c\x90\x1f\x5e\x4b\x1f\x7f\x0c\x6d\x36\xf0\x25\xe7\x27\x03\x17\x76\x20\xab\x47\xed\x24\xaf\xe1\xc5\x32\x96\x92\x33\xc7\xb3\xb4\x63\xce\xb0\xeb\x60\x83\xa3\xcc\xc8\x94\x32\xa7\x9f\x46\x06\x8a\x7a\x35\x1e\x19\x4c\xe6\xe3\xb1\xc1\x6c\x3a\x1e\xbf\x7c\x5b\xdf\x12\x73\x3a\x60\xde\x2b\xe6\x54\x39\xde\x53\x47\x62\x09\xb7\xae\x30\xee\x43\xf1\x99\xc4\x1b\x7c\x12\xf6\x9e\x0e\xb8\x0f\x62\xd7\x68\x1d\x6d\x43\x34\x88\xfa\x85\x62\xe8\xc8\xa0\x0b\x0d\x47\x05\x53\x7e\xd1\xcb\x20\xdf\x80\x3c\x78\xcb\x1e\x4b\x72\xe4\x2d\x2b\xae\x9e\xac\xb4\xd3\xf1\x58\x71\xcf\xf5\xf3\xfb\xf3\xce\x06\xde\x3b\xe5\x9d\x29\xc8\x22\xb8\xd0\x2d\x55\xca\x8f\xa5\x8f\xdc\x05\x83\x85\x78\x4f\x5d\x38\x12\xf7\x6d\x71\x0e\xcb\xd0\x08\x37\x83\xa2\x04\x57\x33\xf2\x19\x12\x1d\x6d\xc4\xaf\x40\x7b\xc0\x06\xb6\xf4\x4a\xa9\x36\x9a\xcc\xf7\x9c\x03\xe6\xf7\xa7\x3c\x3f\xa2\x3c\x57\x80\x77\x85\x32\x77\xe4\x08\x37\x3e\xcb\xaa\x90\xc1\xef\xbc\xc3\x1b\xbf\x72\x2a\xcf\x33\xe8\x9d\x6c\xd9\x3d\x1d\xb4\xb3\xa1\xeb\x1d\xff\x85\xd4\x8b\x15\xcf\x29\x0d\x72\x1e\xec\xeb\x48\x61\xa9\xcd\x1c\xab\x93\xf7\xcc\xd5\xc7\x33\x25\x9e\x5e\x9f\x82\x78\x7e\xe4\xe3\xb9\x92\xbc\xc9\x6b\x09\xbd\xea\x7a\x1f\x36\x84\xf7\xb4\x25\x83\xdb\xc8\xb4\x69\xb5\x48\x9e\x89\x3f\x84\x94\x38\x25\x75\xa8\xf8\xcc\x3e\x31\x5a\x17\x22\xa9\xde\xe5\xcf\xc2\x79\x00\x8e\x5c\x3d\x8d\xde\x31\x25\xf2\x59\xf5\x45\x68\x61\x6b\x21\xed\xfd\x3c\x99\x55\xee\xab\x9a\xdf\x93\x70\x5f\x1c\xd5\xd4\x45\xe5\x2e\x31\xf4\x5c\x9b\xe9\x89\x1c\x1b\xbc\x8d\xec\xed\xfa\x00\x7c\xcb\x2b\xf1\x09\x6d\x71\xce\xa0\x63\xe7\xc2\x2e\x81\x12\x24\x43\x0f\xe0\x98\x2a\x71\x5e\xb3\x47\x2b\x5e\xd2\x9a\xd3\xde\xf4\xa9\x0b\x21\xaf\x31\x74\xd8\x97\xa2\x6b\xb0\x2e\x2b\xfd\xb4\xaa\x3e\x3f\x05\xfd\xe5\x91\xea\x97\x0a\xf7\x0b\xed\x48\x54\xf4\xdf\x82\x27\x83\xe3\x0d\xa4\x8f\x6f\x6a\x55\x19\xc4\x50\x7c\x33\x84\x74\x40\x4b\xa5\xe7\xb8\x44\x1b\x69\x15\x35\xbf\xf5\x0a\x86\x7a\x53\x95\x75\xda\x5e\xe3\x8b\x1a\xe6\xc9\xe9\x2a\xfa\xea\x28\xcc\x57\xd5\xb2\xd4\x17\xaa\xf9\x7d\x57\xc4\x33\xe1\x26\x86\x0d\x45\x32\x18\x16\xf7\x42\x1b\xe9\xb0\x98\x06\xb1\x86\x76\xae\xe0\xda\x64\xba\xa4\xbe\xc8\xfa\x3e\xb8\xd7\x55\xc3\xf3\xd3\x25\xf7\xfa\xc8\xc1\xd7\xd5\xa0\xe4\x64\xbf\x7e\x74\xda\xd7\x06\xbe\x81\x75\x94\x92\x58\x58\xa7\x46\x7f\x16\x71\xa5\x21\xad\x70\x55\xbe\x4e\x5c\xa3\x95\xf5\x92\x3b\x07\xe1\xfe\x09\x00\x00\xff\xff\xeb\xcb\xec\x07\x19\x09\x00\x00"),
-		},
-		"/schema_postgres.sql": &vfsgen۰CompressedFileInfo{
-			name:             "schema_postgres.sql",
-			modTime:          time.Date(2018, 6, 26, 5, 47, 23, 119823188, time.UTC),
-			uncompressedSize: 1830,
-
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x94\xc1\x6f\x9b\x30\x14\xc6\xcf\xe5\xaf\x78\xe2\x14\xa4\x45\xda\xce\xd5\x0e\x1e\x98\x0d\x8d\x40\x06\x8e\xda\x9e\x90\x65\xdc\xd6\x0a\x60\x64\x4c\xa5\xfc\xf7\x13\x23\x24\x50\x0c\x9d\x94\x5e\xfd\x3d\xf9\xf7\xbe\xef\xf9\x79\xbb\x05\x4f\xc9\x1a\xf8\x1b\x57\x27\xfd\x2a\xaa\x17\xcb\x4b\xe2\x3d\x10\xf4\x23\xc4\x10\xf8\x80\x1f\x83\x94\xa4\x60\xd7\x4a\xe6\x2d\xd3\x8d\x0d\x2e\x4a\x5d\xe4\xe1\xfb\xf5\xc2\x4c\x9f\x6a\xfe\x71\x35\x6b\x1b\x2d\x4b\xae\x3e\xae\x94\x2a\xff\xef\xb2\xac\x10\xd5\x04\x6e\x59\xdb\x2d\xb8\x8a\x53\xcd\xc7\x56\xdd\x04\x23\x82\xcf\x97\x8c\x2c\x6e\xac\x3b\x5b\xe4\x36\x34\x5c\x09\x5a\x40\x14\x13\x88\x0e\x61\xf8\xc5\xba\xb3\x9b\x63\x6b\xc3\x1b\x55\xec\x95\xaa\x8b\x00\x87\x28\xf8\x73\xc0\x9d\x5e\xd1\x92\xcf\x0b\x3a\x25\xe7\x0d\x53\xa2\xd6\x42\x56\x36\x10\xfc\x48\x26\x6a\x17\x57\xd6\x31\x45\xa5\xa7\x40\x2d\xd9\x71\x7e\xcc\x64\xa3\x0d\xc5\xbc\x28\x44\xf5\x92\xd5\x4a\x30\x3e\x93\xdd\x38\x4a\x49\x82\x82\x88\xc0\xe0\x35\xab\x8f\xb0\x4f\x82\x1d\x4a\x9e\xe0\x37\x7e\x82\x4d\xe7\xdb\xb1\x1c\x78\x08\xc8\x2f\xd8\x58\x00\x71\xe0\xa5\xdf\x7d\x14\xa6\xd8\x72\xba\x20\x8d\x99\x0d\xd3\x5e\x09\xce\x1c\xcc\x35\xb9\x79\x73\xfd\xa5\xb7\x76\x38\x7a\x61\x2b\xdd\x3d\xb7\x45\x91\x2d\xcf\x8e\xc9\xb2\xa6\xd5\x69\xa5\x82\x97\x54\x14\x66\x89\xe6\xb9\xe2\x4d\x63\x16\x6b\xd9\x68\x5a\x64\x4c\xe6\x4b\x68\xa1\x4f\x4b\x4d\xb5\x95\x56\x66\x71\x94\xe6\x25\x80\x5b\x93\x1c\x36\x70\x21\xc6\xd1\x12\x0c\x48\xe3\x83\x66\xff\xd6\x30\xcf\xa8\xb6\x81\x04\x3b\x9c\x12\xb4\xdb\x5f\x6f\xf1\xb0\x8f\x0e\x61\xb7\x1c\x0f\x1b\x67\xea\xa4\x6f\xe0\x53\x6c\x0c\x3f\x44\xe7\xa5\x3f\x30\xb5\x3a\x3c\x44\x93\x46\xcb\x2e\xfe\xe9\xf9\x5a\x13\x28\x24\x38\x99\x7f\x35\xc8\xf3\x60\x64\xf1\xa2\x64\xcf\xc7\xaf\x36\xf8\x71\x82\x83\x9f\xd1\xd9\xe7\xf0\x47\x38\x90\x60\x1f\x27\x38\x72\xf1\xec\xc3\xed\xd3\xb8\x9f\xe2\x86\xc9\xbd\x87\x9d\x03\x35\xa0\xc6\x13\x9c\xe2\xae\xfb\xb4\x8c\x1a\xd2\x35\xf2\x7a\xd1\x04\xbd\x0c\x62\x4a\x3c\x77\x7f\x2b\xee\xdb\x7b\xdc\x68\xba\xc6\x44\xaf\xc8\xbf\x01\x00\x00\xff\xff\xa4\xf2\xf8\x4a\x26\x07\x00\x00"),
-		},
-		"/schema_sqlite3.sql": &vfsgen۰CompressedFileInfo{
-			name:             "schema_sqlite3.sql",
-			modTime:          time.Date(2018, 6, 26, 7, 6, 35, 623377168, time.UTC),
-			uncompressedSize: 1360,
-
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x53\xc1\x6e\xa3\x30\x10\x3d\x87\xaf\x18\x71\x6a\xa4\xe6\x0b\xf6\xc4\x92\x49\x85\x36\x71\xb2\x8e\x91\xda\x13\xb2\x8c\xb7\xb5\x0a\x18\xd9\xa6\x52\xfe\x7e\x45\x42\x09\x08\x43\x7a\x9d\xf7\xec\xf7\xe6\xcd\xcc\x66\x03\x5b\xa3\x6b\x90\x5f\xd2\x5c\xdc\x87\xaa\xde\x83\x2d\x3d\x9e\x80\x45\xbf\xf7\x08\xc9\x0e\xf0\x35\x39\xb3\x33\x84\xb5\xd1\x79\x23\x9c\x0d\x7f\x2d\x13\x32\x77\xa9\xe5\x3c\x4b\x34\xd6\xe9\x52\x9a\x79\x86\x36\xf9\x43\x38\x2b\x54\x75\x15\x09\x82\xcd\x06\x62\x23\xb9\x93\xc3\x16\x62\x8a\x11\xc3\xee\xf1\xdd\x3a\x3c\x05\xab\x50\xe5\x21\x58\x69\x14\x2f\x80\x1c\x19\x90\x74\xbf\x7f\x0e\x56\xa1\xfd\x6c\x42\xf8\xe2\x46\x7c\x70\xd3\x03\x90\x92\xe4\x6f\x8a\x2d\x5e\xf1\x52\x4e\x09\x2d\x92\x4b\x2b\x8c\xaa\x9d\xd2\x55\x08\x0c\x5f\xd9\x08\x6d\xe3\xc8\x5a\x4d\x55\xb9\xb1\xa0\xd3\xe2\x73\x5a\x16\xda\x3a\x0f\x59\x16\x85\xaa\xde\xb3\xda\x28\x21\x27\xf0\x89\x26\x87\x88\xbe\xc1\x1f\x7c\x83\xa7\xb6\xc1\xf5\x73\xb0\xda\x1d\x29\x26\x2f\xa4\x2b\x7e\xdb\x58\x03\xc5\x1d\x52\x24\x31\x9e\x61\x34\xb2\xdb\xc3\x60\xdd\x86\xea\xcd\xaf\x9b\xec\x52\x88\xfe\x90\xee\x29\x4e\x8d\xfa\xf4\xee\x3b\xb2\xa4\xf5\xaf\x29\x8a\x6c\x7e\x2a\x42\x97\x35\xaf\x2e\x0b\x0c\x59\x72\x55\xf8\x21\x9e\xe7\x46\x5a\xeb\x07\x6b\x6d\x1d\x2f\x32\xa1\xf3\x39\x69\xe5\x2e\x73\xa6\x9a\xca\x19\x3f\xf8\xb3\x6c\xba\xeb\xe8\x83\x49\x08\xc3\x17\xa4\x30\x7c\x1d\xa5\xec\x98\x90\x98\xe2\x01\x09\xbb\xca\x76\x81\x7a\xf7\x50\x5c\xaf\x27\xcf\xb8\x0b\x81\x25\x07\x3c\xb3\xe8\x70\xba\xcf\x6e\x8b\xbb\x28\xdd\x33\x88\x53\x4a\x91\xb0\xac\xa7\x4c\x56\x6c\xa8\x32\x5a\xb3\x7e\x9e\x0f\xda\xea\xae\xfa\xda\xdb\xad\xe0\xf3\xfb\xbd\x8d\x3e\x8c\x97\x6d\xc0\x93\xfa\xd8\x67\xff\xf5\xc8\xe4\x2d\xd8\x99\xeb\x19\x68\xfa\x0e\x68\xd0\xd8\xff\x00\x00\x00\xff\xff\xb6\xb9\xac\xee\x50\x05\x00\x00"),
-		},
-	}
-	fs["/"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
-		fs["/customers.sql"].(os.FileInfo),
-		fs["/products.sql"].(os.FileInfo),
-		fs["/schema_postgres.sql"].(os.FileInfo),
-		fs["/schema_sqlite3.sql"].(os.FileInfo),
-	}
-
-	return fs
-}()
-
-type vfsgen۰FS map[string]interface{}
-
-func (fs vfsgen۰FS) Open(path string) (http.File, error) {
-	path = pathpkg.Clean("/" + path)
-	f, ok := fs[path]
-	if !ok {
-		return nil, &os.PathError{Op: "open", Path: path, Err: os.ErrNotExist}
-	}
-
-	switch f := f.(type) {
-	case *vfsgen۰CompressedFileInfo:
-		gr, err := gzip.NewReader(bytes.NewReader(f.compressedContent))
-		if err != nil {
-			// This should never happen because we generate the gzip bytes such that they are always valid.
-			panic("unexpected error reading own gzip compressed bytes: " + err.Error())
-		}
-		return &vfsgen۰CompressedFile{
-			vfsgen۰CompressedFileInfo: f,
-			gr: gr,
-		}, nil
-	case *vfsgen۰DirInfo:
-		return &vfsgen۰Dir{
-			vfsgen۰DirInfo: f,
-		}, nil
-	default:
-		// This should never happen because we generate only the above types.
-		panic(fmt.Sprintf("unexpected type %T", f))
-	}
-}
-
-// vfsgen۰CompressedFileInfo is a static definition of a gzip compressed file.
-type vfsgen۰CompressedFileInfo struct {
-	name              string
-	modTime           time.Time
-	compressedContent []byte
-	uncompressedSize  int64
-}
-
-func (f *vfsgen۰CompressedFileInfo) Readdir(count int) ([]os.FileInfo, error) {
-	return nil, fmt.Errorf("cannot Readdir from file %s", f.name)
-}
-func (f *vfsgen۰CompressedFileInfo) Stat() (os.FileInfo, error) { return f, nil }
-
-func (f *vfsgen۰CompressedFileInfo) GzipBytes() []byte {
-	return f.compressedContent
-}
-
-func (f *vfsgen۰CompressedFileInfo) Name() string       { return f.name }
-func (f *vfsgen۰CompressedFileInfo) Size() int64        { return f.uncompressedSize }
-func (f *vfsgen۰CompressedFileInfo) Mode() os.FileMode  { return 0444 }
-func (f *vfsgen۰CompressedFileInfo) ModTime() time.Time { return f.modTime }
-func (f *vfsgen۰CompressedFileInfo) IsDir() bool        { return false }
-func (f *vfsgen۰CompressedFileInfo) Sys() interface{}   { return nil }
-
-// vfsgen۰CompressedFile is an opened compressedFile instance.
-type vfsgen۰CompressedFile struct {
-	*vfsgen۰CompressedFileInfo
-	gr      *gzip.Reader
-	grPos   int64 // Actual gr uncompressed position.
-	seekPos int64 // Seek uncompressed position.
-}
-
-func (f *vfsgen۰CompressedFile) Read(p []byte) (n int, err error) {
-	if f.grPos > f.seekPos {
-		// Rewind to beginning.
-		err = f.gr.Reset(bytes.NewReader(f.compressedContent))
-		if err != nil {
-			return 0, err
-		}
-		f.grPos = 0
-	}
-	if f.grPos < f.seekPos {
-		// Fast-forward.
-		_, err = io.CopyN(ioutil.Discard, f.gr, f.seekPos-f.grPos)
-		if err != nil {
-			return 0, err
-		}
-		f.grPos = f.seekPos
-	}
-	n, err = f.gr.Read(p)
-	f.grPos += int64(n)
-	f.seekPos = f.grPos
-	return n, err
-}
-func (f *vfsgen۰CompressedFile) Seek(offset int64, whence int) (int64, error) {
-	switch whence {
-	case io.SeekStart:
-		f.seekPos = 0 + offset
-	case io.SeekCurrent:
-		f.seekPos += offset
-	case io.SeekEnd:
-		f.seekPos = f.uncompressedSize + offset
-	default:
-		panic(fmt.Errorf("invalid whence value: %v", whence))
-	}
-	return f.seekPos, nil
-}
-func (f *vfsgen۰CompressedFile) Close() error {
-	return f.gr.Close()
-}
-
-// vfsgen۰DirInfo is a static definition of a directory.
-type vfsgen۰DirInfo struct {
-	name    string
-	modTime time.Time
-	entries []os.FileInfo
-}
-
-func (d *vfsgen۰DirInfo) Read([]byte) (int, error) {
-	return 0, fmt.Errorf("cannot Read from directory %s", d.name)
-}
-func (d *vfsgen۰DirInfo) Close() error               { return nil }
-func (d *vfsgen۰DirInfo) Stat() (os.FileInfo, error) { return d, nil }
-
-func (d *vfsgen۰DirInfo) Name() string       { return d.name }
-func (d *vfsgen۰DirInfo) Size() int64        { return 0 }
-func (d *vfsgen۰DirInfo) Mode() os.FileMode  { return 0755 | os.ModeDir }
-func (d *vfsgen۰DirInfo) ModTime() time.Time { return d.modTime }
-func (d *vfsgen۰DirInfo) IsDir() bool        { return true }
-func (d *vfsgen۰DirInfo) Sys() interface{}   { return nil }
-
-// vfsgen۰Dir is an opened dir instance.
-type vfsgen۰Dir struct {
-	*vfsgen۰DirInfo
-	pos int // Position within entries for Seek and Readdir.
-}
-
-func (d *vfsgen۰Dir) Seek(offset int64, whence int) (int64, error) {
-	if offset == 0 && whence == io.SeekStart {
-		d.pos = 0
-		return 0, nil
-	}
-	return 0, fmt.Errorf("unsupported Seek in directory %s", d.name)
-}
-
-func (d *vfsgen۰Dir) Readdir(count int) ([]os.FileInfo, error) {
-	if d.pos >= len(d.entries) && count > 0 {
-		return nil, io.EOF
-	}
-	if count <= 0 || count > len(d.entries)-d.pos {
-		count = len(d.entries) - d.pos
-	}
-	e := d.entries[d.pos : d.pos+count]
-	d.pos += count
-	return e, nil
-}
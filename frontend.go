@@ -0,0 +1,33 @@
+package main
+
+import (
+	"embed"
+	"io/fs"
+	"net/http"
+	"os"
+
+	"github.com/gin-gonic/gin"
+)
+
+//go:embed frontend/build
+var embeddedFrontend embed.FS
+
+// frontendFS returns the frontend assets: those in the
+// -frontend directory if specified, or else those
+// embedded in the binary.
+func frontendFS() (fs.FS, error) {
+	if *frontendDir != "" {
+		return os.DirFS(*frontendDir), nil
+	}
+	return fs.Sub(embeddedFrontend, "frontend/build")
+}
+
+// frontendDirFS returns an http.FileSystem for serving the
+// files in the named frontend directory, without listings.
+func frontendDirFS(frontend fs.FS, dir string) (http.FileSystem, error) {
+	sub, err := fs.Sub(frontend, dir)
+	if err != nil {
+		return nil, err
+	}
+	return gin.OnlyFilesFS{FileSystem: http.FS(sub)}, nil
+}
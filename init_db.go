@@ -2,8 +2,6 @@ package main
 
 import (
 	"context"
-	"math/rand"
-	"time"
 
 	opbeansdb "github.com/elastic/opbeans-go/db"
 	"github.com/jmoiron/sqlx"
@@ -11,33 +9,48 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-func initDatabase(db *sqlx.DB, driver string) error {
-	if orders, err := getOrders(context.Background(), db); err == nil {
-		if len(orders) != 0 {
-			return nil
-		}
+// initDatabase applies any pending schema migrations, and then
+// seeds the database as described by cfg if it is empty.
+func initDatabase(db *sqlx.DB, driver string, cfg opbeansdb.SeedConfig) error {
+	ctx := context.Background()
+	if err := opbeansdb.MigrateUp(ctx, db, driver, 0); err != nil {
+		return errors.Wrap(err, "migrating database")
 	}
-
-	filenames := []string{
-		"schema_" + driver + ".sql",
-		"customers.sql",
-		"products.sql",
+	empty, err := isDatabaseEmpty(ctx, db)
+	if err != nil || !empty {
+		return err
 	}
-	logrus.Infof("initializing %q database", driver)
-	for _, filename := range filenames {
-		logrus.Infof("executing %q", filename)
-		f, err := opbeansdb.SQL.Open(filename)
-		if err != nil {
-			return err
+	logrus.Infof("seeding %q database with %d orders (seed %d)", driver, cfg.Orders, cfg.Seed)
+	return opbeansdb.Seed(ctx, db, driver, cfg)
+}
+
+// isDatabaseEmpty reports whether the database contains no data at
+// all, so that we never mix sample data with real data.
+func isDatabaseEmpty(ctx context.Context, db *sqlx.DB) (bool, error) {
+	for _, table := range []string{"products", "customers", "orders"} {
+		var n int
+		if err := db.QueryRowContext(ctx, "SELECT COUNT(*) FROM "+table).Scan(&n); err != nil {
+			return false, errors.Wrapf(err, "counting %s", table)
 		}
-		defer f.Close()
-		if err := opbeansdb.ExecCommands(context.Background(), db, f); err != nil {
-			return errors.Wrapf(err, "executing %q", filename)
+		if n != 0 {
+			return false, nil
 		}
 	}
+	return true, nil
+}
+
+// uniqueViolationCheckers holds functions, registered by the
+// database driver init files, for identifying driver-specific
+// unique constraint violation errors.
+var uniqueViolationCheckers []func(error) bool
 
-	const numOrders = 5000
-	logrus.Infof("generating %d random orders", numOrders)
-	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
-	return opbeansdb.GenerateOrders(db, driver, numOrders, rng)
+// isUniqueViolation reports whether err is the result of
+// violating a unique constraint.
+func isUniqueViolation(err error) bool {
+	for _, check := range uniqueViolationCheckers {
+		if check(err) {
+			return true
+		}
+	}
+	return false
 }
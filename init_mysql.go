@@ -0,0 +1,15 @@
+package main
+
+import (
+	"github.com/go-sql-driver/mysql"
+	"github.com/pkg/errors"
+
+	_ "go.elastic.co/apm/module/apmsql/v2/mysql"
+)
+
+func init() {
+	uniqueViolationCheckers = append(uniqueViolationCheckers, func(err error) bool {
+		var mysqlErr *mysql.MySQLError
+		return errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 // ER_DUP_ENTRY
+	})
+}
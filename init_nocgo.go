@@ -0,0 +1,7 @@
+// +build !cgo
+
+package main
+
+// defaultDatabase is the default value of the -db flag. Without cgo
+// there is no SQLite driver, so the in-memory store is used.
+const defaultDatabase = memoryStoreURL
@@ -1,5 +1,15 @@
 package main
 
 import (
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+
 	_ "go.elastic.co/apm/module/apmsql/v2/pq"
 )
+
+func init() {
+	uniqueViolationCheckers = append(uniqueViolationCheckers, func(err error) bool {
+		var pqErr *pq.Error
+		return errors.As(err, &pqErr) && pqErr.Code == "23505" // unique_violation
+	})
+}
@@ -3,5 +3,18 @@
 package main
 
 import (
+	"github.com/mattn/go-sqlite3"
+	"github.com/pkg/errors"
+
 	_ "go.elastic.co/apm/module/apmsql/v2/sqlite3"
 )
+
+// defaultDatabase is the default value of the -db flag.
+const defaultDatabase = "sqlite3::memory:"
+
+func init() {
+	uniqueViolationCheckers = append(uniqueViolationCheckers, func(err error) bool {
+		var sqliteErr sqlite3.Error
+		return errors.As(err, &sqliteErr) && sqliteErr.ExtendedCode == sqlite3.ErrConstraintUnique
+	})
+}
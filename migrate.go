@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+
+	opbeansdb "github.com/elastic/opbeans-go/db"
+)
+
+const migrateUsage = "usage: opbeans [flags] migrate up [version] | down [steps] | status"
+
+// migrate implements the "migrate" command, which applies or
+// reverts schema migrations, or reports their status, for the
+// database specified by the -db flag.
+func migrate(args []string) error {
+	if len(args) == 0 || len(args) > 2 {
+		return errors.New(migrateUsage)
+	}
+	var n int
+	if len(args) == 2 {
+		var err error
+		if n, err = strconv.Atoi(args[1]); err != nil || n < 0 {
+			return errors.Errorf("invalid argument %q; %s", args[1], migrateUsage)
+		}
+	}
+
+	if *database == memoryStoreURL {
+		return errors.New("the in-memory store has no schema to migrate")
+	}
+	db, err := openDatabase()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	ctx := context.Background()
+	driver := db.DriverName()
+
+	switch args[0] {
+	case "up":
+		return opbeansdb.MigrateUp(ctx, db, driver, n)
+	case "down":
+		if len(args) == 1 {
+			n = 1
+		}
+		return opbeansdb.MigrateDown(ctx, db, driver, n)
+	case "status":
+		if len(args) != 1 {
+			return errors.New(migrateUsage)
+		}
+		migrations, err := opbeansdb.MigrationStatus(ctx, db, driver)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED")
+		for _, m := range migrations {
+			applied := "pending"
+			if m.AppliedAt != nil {
+				applied = m.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\n", m.Version, m.Name, applied)
+		}
+		return w.Flush()
+	default:
+		return errors.New(migrateUsage)
+	}
+}
@@ -2,36 +2,143 @@ package main
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
+
+	opbeansdb "github.com/elastic/opbeans-go/db"
 )
 
 type Order struct {
-	ID           int                `json:"id"`
-	CreatedAt    time.Time          `json:"created_at"`
-	CustomerID   int                `json:"customer_id"`
-	CustomerName string             `json:"customer_name,omitempty"`
-	Lines        []ProductOrderLine `json:"lines,omitempty"`
+	ID           int                 `json:"id"`
+	CreatedAt    time.Time           `json:"created_at"`
+	CustomerID   int                 `json:"customer_id"`
+	CustomerName string              `json:"customer_name,omitempty"`
+	Status       OrderStatus         `json:"status"`
+	History      []OrderStatusChange `json:"history,omitempty"`
+	Lines        []ProductOrderLine  `json:"lines,omitempty"`
+	LineCount    int                 `json:"line_count"`
+	Total        int                 `json:"total"`
+}
+
+// OrderStatus describes the stage of an order's lifecycle.
+type OrderStatus string
+
+const (
+	OrderPending   OrderStatus = "pending"
+	OrderPaid      OrderStatus = "paid"
+	OrderShipped   OrderStatus = "shipped"
+	OrderDelivered OrderStatus = "delivered"
+	OrderCancelled OrderStatus = "cancelled"
+)
+
+// orderStatusTransitions defines the statuses which
+// an order in a given status may transition to.
+var orderStatusTransitions = map[OrderStatus][]OrderStatus{
+	OrderPending: {OrderPaid, OrderCancelled},
+	OrderPaid:    {OrderShipped, OrderCancelled},
+	OrderShipped: {OrderDelivered},
+}
+
+// Valid reports whether s is a known order status.
+func (s OrderStatus) Valid() bool {
+	switch s {
+	case OrderPending, OrderPaid, OrderShipped, OrderDelivered, OrderCancelled:
+		return true
+	}
+	return false
 }
 
+// CanTransition reports whether an order in status s
+// may transition to status to.
+func (s OrderStatus) CanTransition(to OrderStatus) bool {
+	for _, next := range orderStatusTransitions[s] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// OrderStatusChange records an order entering a status.
+type OrderStatusChange struct {
+	Status    OrderStatus `json:"status"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+// invalidTransitionError is returned by transitionOrder when
+// the requested status is not reachable from the current one.
+type invalidTransitionError struct {
+	From, To OrderStatus
+}
+
+func (e *invalidTransitionError) Error() string {
+	return fmt.Sprintf("cannot transition order from %q to %q", e.From, e.To)
+}
+
+// ProductOrderLine describes an order for an amount of a product.
+// UnitPrice and UnitCost hold the product's selling price and cost
+// at the time the order was created.
 type ProductOrderLine struct {
 	Product
-	Amount int `json:"amount"`
+	Amount    int `json:"amount"`
+	UnitPrice int `json:"unit_price"`
+	UnitCost  int `json:"unit_cost"`
+	Total     int `json:"total"`
 }
 
-func getOrders(ctx context.Context, db *sqlx.DB) ([]Order, error) {
-	const limit = 1000
+// orderFilter restricts the orders returned by getOrders.
+type orderFilter struct {
+	// From and To, if non-zero, restrict orders to
+	// those created within the range [From, To).
+	From, To time.Time
+
+	CustomerID *int
+	ProductID  *int
+}
+
+// orderSortColumns holds the columns by which orders may be sorted.
+var orderSortColumns = []string{"created_at", "customer_id"}
+
+func getOrders(ctx context.Context, db *sqlx.DB, filter orderFilter, page pageRequest) ([]Order, error) {
+	var conds []string
+	var args []interface{}
+	if !filter.From.IsZero() {
+		conds = append(conds, "orders.created_at >= ?")
+		args = append(args, opbeansdb.TimeArg(db.DriverName(), filter.From))
+	}
+	if !filter.To.IsZero() {
+		conds = append(conds, "orders.created_at < ?")
+		args = append(args, opbeansdb.TimeArg(db.DriverName(), filter.To))
+	}
+	if filter.CustomerID != nil {
+		conds = append(conds, "orders.customer_id = ?")
+		args = append(args, *filter.CustomerID)
+	}
+	if filter.ProductID != nil {
+		conds = append(conds, "EXISTS (SELECT 1 FROM order_lines WHERE order_id=orders.id AND product_id=?)")
+		args = append(args, *filter.ProductID)
+	}
+	if cond, pageArgs := page.where("orders"); cond != "" {
+		conds = append(conds, cond)
+		args = append(args, pageArgs...)
+	}
+
 	queryString := `SELECT
-  orders.id, orders.created_at,
-  customers.id, customers.full_name
+  orders.id, orders.created_at, orders.status,
+  customers.id, customers.full_name,
+  (SELECT COUNT(*) FROM order_lines WHERE order_id=orders.id),
+  (SELECT COALESCE(SUM(amount*unit_price), 0) FROM order_lines WHERE order_id=orders.id)
 FROM orders JOIN customers ON orders.customer_id=customers.id
 `
-	queryString += fmt.Sprintf("LIMIT %d\n", limit)
+	queryString += whereClause(conds)
+	queryString += page.orderBy("orders")
 
-	rows, err := db.QueryContext(ctx, queryString)
+	rows, err := db.QueryContext(ctx, db.Rebind(queryString), args...)
 	if err != nil {
 		return nil, errors.Wrap(err, "querying orders")
 	}
@@ -41,29 +148,54 @@ FROM orders JOIN customers ON orders.customer_id=customers.id
 	for rows.Next() {
 		var o Order
 		if err := rows.Scan(
-			&o.ID, &o.CreatedAt,
-			&o.CustomerID, &o.CustomerName,
+			&o.ID, &o.CreatedAt, &o.Status,
+			&o.CustomerID, &o.CustomerName, &o.LineCount, &o.Total,
 		); err != nil {
 			return nil, err
 		}
 		orders = append(orders, o)
 	}
-	return orders, rows.Err()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	ids := make([]int, len(orders))
+	for i, o := range orders {
+		ids[i] = o.ID
+	}
+	histories, err := getOrderStatusHistories(ctx, db, ids...)
+	if err != nil {
+		return nil, err
+	}
+	for i, o := range orders {
+		orders[i].History = histories[o.ID]
+	}
+	return orders, nil
 }
 
 func getOrder(ctx context.Context, db *sqlx.DB, id int) (*Order, error) {
 	queryString := db.Rebind(`SELECT
-  orders.id, orders.created_at, customer_id
+  orders.id, orders.created_at, customer_id, status
 FROM orders WHERE orders.id=?`)
 
 	row := db.QueryRowContext(ctx, queryString, id)
 	var order Order
-	if err := row.Scan(&order.ID, &order.CreatedAt, &order.CustomerID); err != nil {
+	if err := row.Scan(&order.ID, &order.CreatedAt, &order.CustomerID, &order.Status); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
 		return nil, errors.Wrap(err, "querying order")
 	}
 
+	histories, err := getOrderStatusHistories(ctx, db, id)
+	if err != nil {
+		return nil, err
+	}
+	order.History = histories[id]
+
 	queryString = db.Rebind(`SELECT
-  product_id, amount,
+  product_id, amount, unit_price, unit_cost,
   products.sku, products.name, products.description,
   products.type_id, products.stock, products.cost, products.selling_price
 FROM products JOIN order_lines ON products.id=order_lines.product_id
@@ -79,18 +211,53 @@ WHERE order_lines.order_id=?`)
 	for rows.Next() {
 		var l ProductOrderLine
 		if err := rows.Scan(
-			&l.ID, &l.Amount,
+			&l.ID, &l.Amount, &l.UnitPrice, &l.UnitCost,
 			&l.SKU, &l.Name, &l.Description,
 			&l.TypeID, &l.Stock, &l.Cost, &l.SellingPrice,
 		); err != nil {
 			return nil, err
 		}
+		l.Total = l.Amount * l.UnitPrice
+		order.Total += l.Total
 		lines = append(lines, l)
 	}
 	order.Lines = lines
+	order.LineCount = len(lines)
 	return &order, rows.Err()
 }
 
+// stockShortage describes an order line for
+// which there is insufficient product stock.
+type stockShortage struct {
+	ProductID int `json:"product_id"`
+	Requested int `json:"requested"`
+	Available int `json:"available"`
+}
+
+// insufficientStockError is returned by createOrder when one
+// or more of the order lines cannot be satisfied from stock.
+type insufficientStockError struct {
+	Lines []stockShortage
+}
+
+func (e *insufficientStockError) Error() string {
+	return fmt.Sprintf("insufficient stock for %d order line(s)", len(e.Lines))
+}
+
+// unknownProductError is returned by createOrder when
+// an order line refers to a non-existent product.
+type unknownProductError struct {
+	ProductID int
+}
+
+func (e *unknownProductError) Error() string {
+	return fmt.Sprintf("unknown product %d", e.ProductID)
+}
+
+// createOrder creates an order for the customer, reserving stock
+// for each of the order lines. If there is insufficient stock for
+// any line, no order is created and createOrder returns an error
+// of type *insufficientStockError.
 func createOrder(ctx context.Context, db *sqlx.DB, customer *Customer, lines []ProductOrderLine) (int, error) {
 	tx, err := db.BeginTxx(ctx, nil)
 	if err != nil {
@@ -98,45 +265,257 @@ func createOrder(ctx context.Context, db *sqlx.DB, customer *Customer, lines []P
 	}
 	defer tx.Rollback()
 
-	driver := db.DriverName()
-	returningID := "RETURNING id"
-	if driver == "sqlite3" {
-		returningID = ""
+	if err := reserveStock(ctx, tx, lines); err != nil {
+		return -1, err
 	}
-	insertOrderStmt := db.Rebind("INSERT INTO orders (customer_id) VALUES (?) " + returningID)
 
 	insertOrderLineStmt, err := tx.PrepareContext(ctx, db.Rebind(
-		"INSERT INTO order_lines (order_id, product_id, amount) VALUES(?, ?, ?)",
+		"INSERT INTO order_lines (order_id, product_id, amount, unit_price, unit_cost) VALUES(?, ?, ?, ?, ?)",
 	))
 	if err != nil {
 		return -1, errors.Wrap(err, "failed to prepare insert order lines statement")
 	}
 	defer insertOrderLineStmt.Close()
 
-	var orderID int
-	if returningID == "" {
-		result, err := tx.ExecContext(ctx, insertOrderStmt, customer.ID)
-		if err != nil {
+	orderID, err := insertReturningID(ctx, tx, "INSERT INTO orders (customer_id) VALUES (?)", customer.ID)
+	if err != nil {
+		return -1, err
+	}
+	if err := insertOrderStatusChange(ctx, tx, orderID, OrderPending); err != nil {
+		return -1, err
+	}
+	for _, line := range lines {
+		if _, err := insertOrderLineStmt.ExecContext(
+			ctx, orderID, line.Product.ID, line.Amount, line.UnitPrice, line.UnitCost,
+		); err != nil {
 			return -1, err
 		}
-		rowID, err := result.LastInsertId()
-		if err != nil {
-			return -1, err
+	}
+	if err := tx.Commit(); err != nil {
+		return -1, err
+	}
+	return orderID, nil
+}
+
+// reserveStock decrements the stock of the products in lines,
+// failing if there is insufficient stock for any of them, and
+// records the products' current price and cost on the lines.
+//
+// On Postgres the product rows are locked with SELECT FOR UPDATE,
+// in ID order to avoid deadlocks. SQLite has no row locking; its
+// transactions are started with BEGIN IMMEDIATE (see newDatabase),
+// which takes the database write lock up front.
+func reserveStock(ctx context.Context, tx *sqlx.Tx, lines []ProductOrderLine) error {
+	requested := make(map[int]int)
+	var productIDs []int
+	for _, line := range lines {
+		if _, ok := requested[line.Product.ID]; !ok {
+			productIDs = append(productIDs, line.Product.ID)
+		}
+		requested[line.Product.ID] += line.Amount
+	}
+	if len(productIDs) == 0 {
+		return nil
+	}
+	sort.Ints(productIDs)
+
+	queryString, args, err := sqlx.In(
+		"SELECT id, stock, selling_price, cost FROM products WHERE id IN (?) ORDER BY id",
+		productIDs,
+	)
+	if err != nil {
+		return err
+	}
+	if tx.DriverName() != "sqlite3" {
+		queryString += " FOR UPDATE"
+	}
+	rows, err := tx.QueryContext(ctx, tx.Rebind(queryString), args...)
+	if err != nil {
+		return errors.Wrap(err, "querying product stock")
+	}
+	defer rows.Close()
+
+	stock := make(map[int]int)
+	prices := make(map[int][2]int)
+	for rows.Next() {
+		var id, n, price, cost int
+		if err := rows.Scan(&id, &n, &price, &cost); err != nil {
+			return err
+		}
+		stock[id] = n
+		prices[id] = [2]int{price, cost}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	rows.Close()
+
+	var shortages []stockShortage
+	for _, id := range productIDs {
+		available, ok := stock[id]
+		if !ok {
+			return &unknownProductError{ProductID: id}
+		}
+		if requested[id] > available {
+			shortages = append(shortages, stockShortage{
+				ProductID: id,
+				Requested: requested[id],
+				Available: available,
+			})
+		}
+	}
+	if len(shortages) > 0 {
+		return &insufficientStockError{Lines: shortages}
+	}
+	for i := range lines {
+		lines[i].UnitPrice = prices[lines[i].Product.ID][0]
+		lines[i].UnitCost = prices[lines[i].Product.ID][1]
+	}
+
+	updateStockStmt, err := tx.PrepareContext(ctx, tx.Rebind("UPDATE products SET stock=stock-? WHERE id=?"))
+	if err != nil {
+		return errors.Wrap(err, "failed to prepare update stock statement")
+	}
+	defer updateStockStmt.Close()
+	for _, id := range productIDs {
+		if _, err := updateStockStmt.ExecContext(ctx, requested[id], id); err != nil {
+			return errors.Wrap(err, "updating product stock")
+		}
+	}
+	return nil
+}
+
+// getOrderStatusHistories returns the status histories of the
+// orders with the given IDs, keyed by order ID.
+func getOrderStatusHistories(ctx context.Context, db *sqlx.DB, ids ...int) (map[int][]OrderStatusChange, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	queryString, args, err := sqlx.In(`SELECT order_id, status, created_at
+FROM order_status_history WHERE order_id IN (?) ORDER BY id`, ids)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := db.QueryContext(ctx, db.Rebind(queryString), args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying order status history")
+	}
+	defer rows.Close()
+
+	histories := make(map[int][]OrderStatusChange)
+	for rows.Next() {
+		var orderID int
+		var change OrderStatusChange
+		if err := rows.Scan(&orderID, &change.Status, &change.CreatedAt); err != nil {
+			return nil, err
+		}
+		histories[orderID] = append(histories[orderID], change)
+	}
+	return histories, rows.Err()
+}
+
+// transitionOrder moves the order with the given ID to a new status,
+// recording the change in the order's status history. If there is no
+// such order, transitionOrder returns false.
+func transitionOrder(ctx context.Context, db *sqlx.DB, id int, to OrderStatus) (bool, error) {
+	tx, err := db.BeginTxx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	var from OrderStatus
+	queryString := "SELECT status FROM orders WHERE id=?"
+	if db.DriverName() != "sqlite3" {
+		queryString += " FOR UPDATE"
+	}
+	if err := tx.QueryRowxContext(ctx, tx.Rebind(queryString), id).Scan(&from); err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, errors.Wrap(err, "querying order status")
+	}
+	if !from.CanTransition(to) {
+		return false, &invalidTransitionError{From: from, To: to}
+	}
+	if to == OrderCancelled {
+		// Return the order's reserved stock.
+		if _, err := tx.ExecContext(ctx, tx.Rebind(`UPDATE products SET stock=stock+(
+  SELECT SUM(amount) FROM order_lines WHERE order_id=? AND product_id=products.id
+) WHERE id IN (SELECT product_id FROM order_lines WHERE order_id=?)`), id, id); err != nil {
+			return false, errors.Wrap(err, "restoring product stock")
 		}
-		orderID = int(rowID)
-	} else {
-		err := tx.QueryRowContext(ctx, insertOrderStmt, customer.ID).Scan(&orderID)
+	}
+	if _, err := tx.ExecContext(ctx, tx.Rebind("UPDATE orders SET status=? WHERE id=?"), to, id); err != nil {
+		return false, errors.Wrap(err, "updating order status")
+	}
+	if err := insertOrderStatusChange(ctx, tx, id, to); err != nil {
+		return false, err
+	}
+	return true, tx.Commit()
+}
+
+func insertOrderStatusChange(ctx context.Context, tx *sqlx.Tx, orderID int, status OrderStatus) error {
+	_, err := tx.ExecContext(ctx, tx.Rebind(
+		"INSERT INTO order_status_history (order_id, status) VALUES (?, ?)",
+	), orderID, status)
+	return errors.Wrap(err, "inserting order status history")
+}
+
+// nullTime is an sql.Scanner for nullable timestamps, including
+// those computed by SQLite functions, which are returned as text.
+type nullTime struct {
+	Time  time.Time
+	Valid bool
+}
+
+var sqliteTimeFormats = []string{
+	"2006-01-02 15:04:05.999999999-07:00",
+	"2006-01-02T15:04:05.999999999-07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02",
+}
+
+func (t *nullTime) Scan(src interface{}) error {
+	switch src := src.(type) {
+	case nil:
+		*t = nullTime{}
+		return nil
+	case time.Time:
+		*t = nullTime{Time: src, Valid: true}
+		return nil
+	case []byte:
+		return t.Scan(string(src))
+	case string:
+		for _, format := range sqliteTimeFormats {
+			if parsed, err := time.ParseInLocation(format, src, time.UTC); err == nil {
+				*t = nullTime{Time: parsed, Valid: true}
+				return nil
+			}
+		}
+		return errors.Errorf("cannot parse %q as time", src)
+	}
+	return errors.Errorf("cannot scan %T as time", src)
+}
+
+// insertReturningID executes the given INSERT statement, returning
+// the ID of the inserted row. The query must use '?' bindvars.
+func insertReturningID(ctx context.Context, q sqlx.ExtContext, query string, args ...interface{}) (int, error) {
+	if opbeansdb.UsesLastInsertID(q.DriverName()) {
+		result, err := q.ExecContext(ctx, q.Rebind(query), args...)
 		if err != nil {
 			return -1, err
 		}
-	}
-	for _, line := range lines {
-		if _, err := insertOrderLineStmt.ExecContext(ctx, orderID, line.Product.ID, line.Amount); err != nil {
+		rowID, err := result.LastInsertId()
+		if err != nil {
 			return -1, err
 		}
+		return int(rowID), nil
 	}
-	if err := tx.Commit(); err != nil {
+	var id int
+	if err := q.QueryRowxContext(ctx, q.Rebind(query+" RETURNING id"), args...).Scan(&id); err != nil {
 		return -1, err
 	}
-	return orderID, nil
+	return id, nil
 }
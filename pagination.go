@@ -0,0 +1,216 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+const (
+	// defaultPageLimit is the page size used when no limit is
+	// specified, matching the fixed limit previously applied
+	// to orders so that existing clients are unaffected.
+	defaultPageLimit = 1000
+	maxPageLimit     = 1000
+)
+
+// pageRequest describes a page of results to return from a list query.
+//
+// Pages are defined by a sort column and the ID of the last row of the
+// previous page, rather than an offset, so that the dataset can be walked
+// reliably while rows are being inserted. Rows are ordered by the sort
+// column, and then by ID to break ties.
+//
+// The zero value requests all rows, ordered by ID.
+type pageRequest struct {
+	// Limit is the maximum number of rows to return, or zero for no limit.
+	Limit int
+
+	// Sort is the column by which to sort. If empty, rows are sorted by ID.
+	Sort string
+
+	// Desc controls whether rows are sorted in descending order.
+	Desc bool
+
+	// After, if non-nil, holds the ID of the last row of the previous page.
+	After *int
+}
+
+// pageCursor is the decoded form of the opaque "cursor" query parameter.
+type pageCursor struct {
+	Sort  string `json:"s"`
+	Desc  bool   `json:"d,omitempty"`
+	After int    `json:"a"`
+}
+
+// parsePageRequest parses the "limit", "sort" and "cursor" query parameters.
+// The sort parameter must be one of the given column names, optionally
+// prefixed with "-" to sort in descending order.
+func parsePageRequest(c *gin.Context, sortable ...string) (pageRequest, error) {
+	page := pageRequest{Limit: defaultPageLimit}
+	if limit := c.Query("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n <= 0 {
+			return page, errors.Errorf("invalid limit %q", limit)
+		}
+		page.Limit = n
+	}
+	if page.Limit > maxPageLimit {
+		page.Limit = maxPageLimit
+	}
+
+	if sort := c.Query("sort"); sort != "" {
+		page.Desc = strings.HasPrefix(sort, "-")
+		page.Sort = strings.TrimPrefix(sort, "-")
+	}
+	if cursor := c.Query("cursor"); cursor != "" {
+		decoded, err := decodePageCursor(cursor)
+		if err != nil {
+			return page, err
+		}
+		if c.Query("sort") != "" && (decoded.Sort != page.Sort || decoded.Desc != page.Desc) {
+			return page, errors.New("sort does not match cursor")
+		}
+		page.Sort = decoded.Sort
+		page.Desc = decoded.Desc
+		page.After = &decoded.After
+	}
+	if page.Sort == "id" {
+		page.Sort = ""
+	}
+	if page.Sort != "" {
+		var ok bool
+		for _, column := range sortable {
+			if column == page.Sort {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return page, errors.Errorf("cannot sort by %q", page.Sort)
+		}
+	}
+	return page, nil
+}
+
+func decodePageCursor(s string) (pageCursor, error) {
+	var cursor pageCursor
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return cursor, errors.Wrap(err, "invalid cursor")
+	}
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return cursor, errors.Wrap(err, "invalid cursor")
+	}
+	return cursor, nil
+}
+
+func (p pageRequest) cursor(after int) string {
+	data, _ := json.Marshal(pageCursor{Sort: p.Sort, Desc: p.Desc, After: after})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// where returns a condition selecting the rows of table which come after
+// the previous page, or an empty string if this is the first page.
+func (p pageRequest) where(table string) (string, []interface{}) {
+	if p.After == nil {
+		return "", nil
+	}
+	op := ">"
+	if p.Desc {
+		op = "<"
+	}
+	if p.Sort == "" {
+		return fmt.Sprintf("%s.id %s ?", table, op), []interface{}{*p.After}
+	}
+	// The sort value of the previous page's last row is looked up, rather
+	// than carried in the cursor, to avoid driver-specific value encoding.
+	last := fmt.Sprintf("(SELECT %s FROM %s WHERE id=?)", p.Sort, table)
+	cond := fmt.Sprintf(
+		"(%[1]s.%[2]s %[3]s %[4]s OR (%[1]s.%[2]s = %[4]s AND %[1]s.id %[3]s ?))",
+		table, p.Sort, op, last,
+	)
+	return cond, []interface{}{*p.After, *p.After, *p.After}
+}
+
+// orderBy returns the ORDER BY and LIMIT clauses for the page. One row
+// more than the limit is requested, to determine whether there is a
+// following page; see paginate.
+func (p pageRequest) orderBy(table string) string {
+	direction := ""
+	if p.Desc {
+		direction = " DESC"
+	}
+	clause := "ORDER BY "
+	if p.Sort != "" {
+		clause += fmt.Sprintf("%s.%s%s, ", table, p.Sort, direction)
+	}
+	clause += fmt.Sprintf("%s.id%s\n", table, direction)
+	if p.Limit > 0 {
+		clause += fmt.Sprintf("LIMIT %d\n", p.Limit+1)
+	}
+	return clause
+}
+
+// paginate trims items, the results of a query for the given page, to
+// the page limit. If there are more results, paginate adds a Link header
+// to the response referring to the next page.
+func paginate[T any](c *gin.Context, page pageRequest, items []T, id func(T) int) []T {
+	if items == nil {
+		// Encode empty pages as [] rather than null.
+		return []T{}
+	}
+	if page.Limit <= 0 || len(items) <= page.Limit {
+		return items
+	}
+	items = items[:page.Limit]
+	query := c.Request.URL.Query()
+	query.Del("sort")
+	query.Set("cursor", page.cursor(id(items[len(items)-1])))
+	query.Set("limit", strconv.Itoa(page.Limit))
+	c.Header("Link", fmt.Sprintf(`<%s?%s>; rel="next"`, c.Request.URL.Path, query.Encode()))
+	return items
+}
+
+// whereClause joins conds into a WHERE clause,
+// or returns an empty string if there are none.
+func whereClause(conds []string) string {
+	if len(conds) == 0 {
+		return ""
+	}
+	return "WHERE " + strings.Join(conds, " AND ") + "\n"
+}
+
+// queryInt parses the optional integer query parameter with the given key.
+func queryInt(c *gin.Context, key string) (*int, error) {
+	value := c.Query(key)
+	if value == "" {
+		return nil, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid %s", key)
+	}
+	return &n, nil
+}
+
+// queryTime parses the optional time query parameter with the
+// given key, which may be in RFC 3339 format or a date.
+func queryTime(c *gin.Context, key string) (time.Time, error) {
+	value := c.Query(key)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	for _, layout := range []string{time.RFC3339, "2006-01-02"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, errors.Errorf("invalid %s %q, expected RFC 3339 time or date", key, value)
+}
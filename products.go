@@ -26,8 +26,19 @@ type ProductType struct {
 	Name string `json:"name"`
 }
 
-func getProducts(ctx context.Context, db *sqlx.DB) ([]Product, error) {
-	return queryProducts(ctx, db, nil)
+// productFilter restricts the products returned by queryProducts.
+type productFilter struct {
+	ID       *int
+	TypeID   *int
+	MinStock *int
+	MaxStock *int
+}
+
+// productSortColumns holds the columns by which products may be sorted.
+var productSortColumns = []string{"sku", "name", "stock", "cost", "selling_price"}
+
+func getProducts(ctx context.Context, db *sqlx.DB, filter productFilter, page pageRequest) ([]Product, error) {
+	return queryProducts(ctx, db, filter, page)
 }
 
 func getTopProducts(ctx context.Context, db *sqlx.DB) ([]Product, error) {
@@ -56,25 +67,45 @@ FROM products JOIN order_lines ON id=product_id GROUP BY products.id ORDER BY so
 }
 
 func getProduct(ctx context.Context, db *sqlx.DB, id int) (*Product, error) {
-	products, err := queryProducts(ctx, db, &id)
+	products, err := queryProducts(ctx, db, productFilter{ID: &id}, pageRequest{})
 	if err != nil || len(products) == 0 {
 		return nil, err
 	}
 	return &products[0], nil
 }
 
-func queryProducts(ctx context.Context, db *sqlx.DB, id *int) ([]Product, error) {
+func queryProducts(ctx context.Context, db *sqlx.DB, filter productFilter, page pageRequest) ([]Product, error) {
+	var conds []string
 	var args []interface{}
+	if filter.ID != nil {
+		conds = append(conds, "products.id=?")
+		args = append(args, *filter.ID)
+	}
+	if filter.TypeID != nil {
+		conds = append(conds, "products.type_id=?")
+		args = append(args, *filter.TypeID)
+	}
+	if filter.MinStock != nil {
+		conds = append(conds, "products.stock>=?")
+		args = append(args, *filter.MinStock)
+	}
+	if filter.MaxStock != nil {
+		conds = append(conds, "products.stock<=?")
+		args = append(args, *filter.MaxStock)
+	}
+	if cond, pageArgs := page.where("products"); cond != "" {
+		conds = append(conds, cond)
+		args = append(args, pageArgs...)
+	}
+
 	queryString := `SELECT
   products.id, products.sku, products.name, products.description,
   products.stock, products.cost, products.selling_price,
   products.type_id, product_types.name
 FROM products JOIN product_types ON type_id=product_types.id
 `
-	if id != nil {
-		queryString += "WHERE products.id=?\n"
-		args = append(args, *id)
-	}
+	queryString += whereClause(conds)
+	queryString += page.orderBy("products")
 
 	rows, err := db.QueryContext(ctx, db.Rebind(queryString), args...)
 	if err != nil {
@@ -133,3 +164,109 @@ func queryProductTypes(ctx context.Context, db *sqlx.DB, id *int) ([]ProductType
 	}
 	return productTypes, rows.Err()
 }
+
+var (
+	errDuplicateSKU       = errors.New("a product with the same SKU already exists")
+	errUnknownProductType = errors.New("unknown product type")
+	errProductHasOrders   = errors.New("product is referenced by existing orders")
+)
+
+func createProduct(ctx context.Context, db *sqlx.DB, p *Product) (int, error) {
+	tx, err := db.BeginTxx(ctx, nil)
+	if err != nil {
+		return -1, err
+	}
+	defer tx.Rollback()
+
+	if err := checkProductType(ctx, tx, p.TypeID); err != nil {
+		return -1, err
+	}
+	id, err := insertReturningID(ctx, tx, `INSERT INTO products
+  (sku, name, description, type_id, stock, cost, selling_price)
+VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		p.SKU, p.Name, p.Description, p.TypeID, p.Stock, p.Cost, p.SellingPrice,
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return -1, errDuplicateSKU
+		}
+		return -1, errors.Wrap(err, "inserting product")
+	}
+	if err := tx.Commit(); err != nil {
+		return -1, err
+	}
+	return id, nil
+}
+
+// updateProduct updates the product with ID p.ID, returning
+// false if no such product exists.
+func updateProduct(ctx context.Context, db *sqlx.DB, p *Product) (bool, error) {
+	tx, err := db.BeginTxx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	if err := checkProductType(ctx, tx, p.TypeID); err != nil {
+		return false, err
+	}
+	result, err := tx.ExecContext(ctx, tx.Rebind(`UPDATE products SET
+  sku=?, name=?, description=?, type_id=?, stock=?, cost=?, selling_price=?
+WHERE id=?`),
+		p.SKU, p.Name, p.Description, p.TypeID, p.Stock, p.Cost, p.SellingPrice, p.ID,
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return false, errDuplicateSKU
+		}
+		return false, errors.Wrap(err, "updating product")
+	}
+	if n, err := result.RowsAffected(); err != nil || n == 0 {
+		return false, err
+	}
+	return true, tx.Commit()
+}
+
+// deleteProduct deletes the product with the given ID, returning
+// false if no such product exists. Products that have been ordered
+// cannot be deleted.
+func deleteProduct(ctx context.Context, db *sqlx.DB, id int) (bool, error) {
+	tx, err := db.BeginTxx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	var ordered bool
+	row := tx.QueryRowxContext(ctx, tx.Rebind(
+		"SELECT EXISTS (SELECT 1 FROM order_lines WHERE product_id=?)",
+	), id)
+	if err := row.Scan(&ordered); err != nil {
+		return false, errors.Wrap(err, "querying product order lines")
+	}
+	if ordered {
+		return false, errProductHasOrders
+	}
+	result, err := tx.ExecContext(ctx, tx.Rebind("DELETE FROM products WHERE id=?"), id)
+	if err != nil {
+		return false, errors.Wrap(err, "deleting product")
+	}
+	if n, err := result.RowsAffected(); err != nil || n == 0 {
+		return false, err
+	}
+	return true, tx.Commit()
+}
+
+func checkProductType(ctx context.Context, tx *sqlx.Tx, typeID int) error {
+	var exists bool
+	row := tx.QueryRowxContext(ctx, tx.Rebind(
+		"SELECT EXISTS (SELECT 1 FROM product_types WHERE id=?)",
+	), typeID)
+	if err := row.Scan(&exists); err != nil {
+		return errors.Wrap(err, "querying product type")
+	}
+	if !exists {
+		return errUnknownProductType
+	}
+	return nil
+}
@@ -0,0 +1,259 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"math/rand"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"go.elastic.co/apm/module/apmlogrus/v2"
+)
+
+// backendHealthTimeout bounds the time taken by an active health check.
+const backendHealthTimeout = 5 * time.Second
+
+var (
+	backendHealthPath     = flag.String("backend-health-path", "/api/stats", "Path requested to check the health of backends, or empty to disable active health checks")
+	backendHealthInterval = flag.Duration("backend-health-interval", 10*time.Second, "Interval between active health checks of backends")
+	backendMaxFailures    = flag.Int("backend-max-failures", 3, "Number of consecutive failures after which a backend is ejected")
+	backendCooldown       = flag.Duration("backend-cooldown", 30*time.Second, "Period for which an ejected backend is not proxied to")
+)
+
+// backendPool holds the opbeans services to which API requests may be
+// proxied, tracking their health with active health checks, and with
+// the outcomes of proxied requests.
+//
+// A backend which fails maxFailures consecutive times, either in checks
+// or proxied requests, is ejected for the cooldown period, after which
+// it is re-admitted on probation: a single further failure ejects it
+// again.
+type backendPool struct {
+	backends       []*backend
+	healthPath     string
+	healthInterval time.Duration
+	maxFailures    int
+	cooldown       time.Duration
+	client         *http.Client
+	now            func() time.Time
+}
+
+type backend struct {
+	url *url.URL
+
+	mu           sync.Mutex
+	healthy      bool
+	failures     int // consecutive
+	ejectedUntil time.Time
+	lastCheck    time.Time
+	lastError    error
+}
+
+// backendStatus describes the state of a backend, for the admin endpoint.
+type backendStatus struct {
+	URL                 string     `json:"url"`
+	Healthy             bool       `json:"healthy"`
+	ConsecutiveFailures int        `json:"consecutive_failures"`
+	EjectedUntil        *time.Time `json:"ejected_until,omitempty"`
+	LastCheck           *time.Time `json:"last_check,omitempty"`
+	LastError           string     `json:"last_error,omitempty"`
+}
+
+// newBackendPool returns a backendPool for the given URLs, configured
+// by the -backend-* flags. All backends are initially healthy.
+func newBackendPool(urls []*url.URL) *backendPool {
+	pool := &backendPool{
+		healthPath:     *backendHealthPath,
+		healthInterval: *backendHealthInterval,
+		maxFailures:    max(*backendMaxFailures, 1),
+		cooldown:       *backendCooldown,
+		client:         &http.Client{Timeout: backendHealthTimeout},
+		now:            time.Now,
+	}
+	for _, u := range urls {
+		pool.backends = append(pool.backends, &backend{url: u, healthy: true})
+	}
+	return pool
+}
+
+// run checks the health of the backends periodically until ctx is done,
+// if active health checks are enabled.
+func (p *backendPool) run(ctx context.Context) {
+	if p.healthPath == "" || p.healthInterval <= 0 || len(p.backends) == 0 {
+		return
+	}
+	ticker := time.NewTicker(p.healthInterval)
+	defer ticker.Stop()
+	for {
+		p.checkAll(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkAll checks the health of all backends concurrently.
+func (p *backendPool) checkAll(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, b := range p.backends {
+		wg.Add(1)
+		go func(b *backend) {
+			defer wg.Done()
+			err := p.check(ctx, b)
+			b.mu.Lock()
+			b.lastCheck = p.now()
+			b.mu.Unlock()
+			p.record(b, err)
+		}(b)
+	}
+	wg.Wait()
+}
+
+func (p *backendPool) check(ctx context.Context, b *backend) error {
+	checkURL := b.url.JoinPath(p.healthPath)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, checkURL.String(), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 400 {
+		return errors.Errorf("health check returned %s", resp.Status)
+	}
+	return nil
+}
+
+// record records the outcome of a health check or proxied
+// request, ejecting or re-admitting the backend as needed.
+func (p *backendPool) record(b *backend, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	p.readmit(b)
+	if err == nil {
+		b.failures = 0
+		b.lastError = nil
+		return
+	}
+	b.failures++
+	b.lastError = err
+	if b.healthy && b.failures >= p.maxFailures {
+		b.healthy = false
+		b.ejectedUntil = p.now().Add(p.cooldown)
+		logrus.WithError(err).Warnf(
+			"ejecting backend %s after %d consecutive failures, for %s",
+			b.url, b.failures, p.cooldown,
+		)
+	}
+}
+
+// readmit re-admits b on probation if it is ejected
+// and its cooldown has elapsed. b.mu must be held.
+func (p *backendPool) readmit(b *backend) {
+	if b.healthy || p.now().Before(b.ejectedUntil) {
+		return
+	}
+	b.healthy = true
+	b.failures = p.maxFailures - 1
+	logrus.Infof("re-admitting backend %s", b.url)
+}
+
+// pick returns a random healthy backend,
+// or nil if no backends are healthy.
+func (p *backendPool) pick() *backend {
+	healthy := make([]*backend, 0, len(p.backends))
+	for _, b := range p.backends {
+		b.mu.Lock()
+		p.readmit(b)
+		if b.healthy {
+			healthy = append(healthy, b)
+		}
+		b.mu.Unlock()
+	}
+	if len(healthy) == 0 {
+		return nil
+	}
+	return healthy[rand.Intn(len(healthy))]
+}
+
+// proxy proxies the request to b, recording the outcome. Requests without
+// bodies which cannot be sent to b are instead passed to the next handler,
+// so that they are served locally.
+func (p *backendPool) proxy(c *gin.Context, b *backend) {
+	logrus.WithFields(apmlogrus.TraceContext(c.Request.Context())).Infof("proxying API request to %s", b.url)
+	var proxyErr error
+	retryable := c.Request.Method == http.MethodGet || c.Request.Method == http.MethodHead
+	proxy := httputil.NewSingleHostReverseProxy(b.url)
+	proxy.ModifyResponse = func(resp *http.Response) error {
+		switch resp.StatusCode {
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			p.record(b, errors.Errorf("proxied request returned %s", resp.Status))
+		default:
+			p.record(b, nil)
+		}
+		return nil
+	}
+	proxy.ErrorHandler = func(w http.ResponseWriter, r *http.Request, err error) {
+		proxyErr = err
+		if r.Context().Err() == nil {
+			// The client did not go away, so the backend failed.
+			p.record(b, err)
+		}
+		if !retryable {
+			w.WriteHeader(http.StatusBadGateway)
+		}
+	}
+	proxy.ServeHTTP(c.Writer, c.Request)
+	if proxyErr != nil && retryable && !c.Writer.Written() {
+		logrus.WithFields(apmlogrus.TraceContext(c.Request.Context())).WithError(proxyErr).Warnf(
+			"failed to proxy API request to %s, serving locally", b.url,
+		)
+		c.Next()
+		return
+	}
+	c.Abort()
+}
+
+// status returns the state of the backends.
+func (p *backendPool) status() []backendStatus {
+	statuses := make([]backendStatus, len(p.backends))
+	for i, b := range p.backends {
+		b.mu.Lock()
+		p.readmit(b)
+		status := backendStatus{
+			URL:                 b.url.String(),
+			Healthy:             b.healthy,
+			ConsecutiveFailures: b.failures,
+		}
+		if !b.healthy {
+			ejectedUntil := b.ejectedUntil
+			status.EjectedUntil = &ejectedUntil
+		}
+		if !b.lastCheck.IsZero() {
+			lastCheck := b.lastCheck
+			status.LastCheck = &lastCheck
+		}
+		if b.lastError != nil {
+			status.LastError = b.lastError.Error()
+		}
+		b.mu.Unlock()
+		statuses[i] = status
+	}
+	return statuses
+}
+
+// handleStatus responds with the state of the backends.
+func (p *backendPool) handleStatus(c *gin.Context) {
+	c.JSON(http.StatusOK, p.status())
+}
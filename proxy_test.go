@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackendPoolPassiveEjection(t *testing.T) {
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer failing.Close()
+	pool, now := newTestBackendPool(t, failing.URL)
+	b := pool.backends[0]
+
+	r := gin.New()
+	r.GET("/api/stats", func(c *gin.Context) { pool.proxy(c, b) })
+	frontend := httptest.NewServer(r)
+	defer frontend.Close()
+	for i := 0; i < 3; i++ {
+		require.Same(t, b, pool.pick())
+		resp, err := http.Get(frontend.URL + "/api/stats")
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	}
+	assert.Nil(t, pool.pick())
+	status := pool.status()
+	require.Len(t, status, 1)
+	assert.False(t, status[0].Healthy)
+	assert.Equal(t, 3, status[0].ConsecutiveFailures)
+	assert.Equal(t, "proxied request returned 503 Service Unavailable", status[0].LastError)
+
+	// After the cooldown, the backend is re-admitted on probation.
+	*now = now.Add(time.Minute)
+	require.Same(t, b, pool.pick())
+	pool.record(b, assert.AnError)
+	assert.Nil(t, pool.pick())
+}
+
+func TestBackendPoolActiveChecks(t *testing.T) {
+	healthy := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/stats", r.URL.Path)
+		if !healthy {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+	pool, now := newTestBackendPool(t, server.URL)
+
+	healthy = false
+	for i := 0; i < 3; i++ {
+		pool.checkAll(context.Background())
+	}
+	assert.Nil(t, pool.pick())
+
+	// Checks during the cooldown do not re-admit the backend.
+	healthy = true
+	pool.checkAll(context.Background())
+	assert.Nil(t, pool.pick())
+	*now = now.Add(time.Minute)
+	pool.checkAll(context.Background())
+	assert.NotNil(t, pool.pick())
+	status := pool.status()
+	assert.True(t, status[0].Healthy)
+	assert.Equal(t, 0, status[0].ConsecutiveFailures)
+	assert.Equal(t, *now, *status[0].LastCheck)
+}
+
+func TestBackendPoolFallback(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close() // refuse connections
+	pool, _ := newTestBackendPool(t, server.URL)
+	b := pool.backends[0]
+
+	r := gin.New()
+	proxy := func(c *gin.Context) { pool.proxy(c, b) }
+	local := func(c *gin.Context) { c.String(http.StatusOK, "local") }
+	r.GET("/api/products", proxy, local)
+	r.POST("/api/products", proxy, local)
+	frontend := httptest.NewServer(r)
+	defer frontend.Close()
+
+	// Requests without bodies are served locally if they cannot be proxied.
+	resp, err := http.Get(frontend.URL + "/api/products")
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "local", string(body))
+
+	resp, err = http.Post(frontend.URL+"/api/products", "application/json", strings.NewReader("{}"))
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	assert.Equal(t, 2, pool.status()[0].ConsecutiveFailures)
+}
+
+func newTestBackendPool(t *testing.T, rawurl string) (*backendPool, *time.Time) {
+	u, err := url.Parse(rawurl)
+	require.NoError(t, err)
+	pool := newBackendPool([]*url.URL{u})
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	pool.now = func() time.Time { return now }
+	return pool, &now
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// RelatedProduct is a product which is frequently
+// ordered together with another product.
+type RelatedProduct struct {
+	Product
+
+	// Orders is the number of orders containing both products.
+	Orders int `json:"orders"`
+
+	// Support is the fraction of all orders containing both products.
+	Support float64 `json:"support"`
+
+	// Confidence is the fraction of orders containing the other
+	// product which also contain this one.
+	Confidence float64 `json:"confidence"`
+}
+
+// relatedProducts holds a precomputed "frequently bought together"
+// matrix, derived from the co-occurrence of products in orders.
+//
+// Computing the matrix requires a self-join over all order lines,
+// so it is refreshed periodically in the background, and requests
+// are served from memory. Product details are captured when the
+// matrix is computed, and so may be stale until the next refresh.
+type relatedProducts struct {
+	store Store
+
+	mu      sync.RWMutex
+	ready   bool
+	related map[int][]RelatedProduct
+}
+
+func newRelatedProducts(store Store) *relatedProducts {
+	return &relatedProducts{store: store}
+}
+
+// run refreshes the matrix immediately, and then every interval
+// until ctx is cancelled. If interval is not positive, the matrix
+// is only computed once.
+func (r *relatedProducts) run(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		r.refreshLogged(ctx)
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		r.refreshLogged(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// refreshLogged refreshes the matrix, logging the outcome.
+func (r *relatedProducts) refreshLogged(ctx context.Context) {
+	start := time.Now()
+	if err := r.refresh(ctx); err != nil {
+		logrus.WithError(err).Error("failed to refresh related products")
+	} else {
+		logrus.Debugf("refreshed related products in %s", time.Since(start))
+	}
+}
+
+// get returns up to limit products most frequently ordered together
+// with the product with the given ID, ranked by confidence. If the
+// matrix has not yet been computed, get returns false.
+func (r *relatedProducts) get(productID, limit int) ([]RelatedProduct, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if !r.ready {
+		return nil, false
+	}
+	related := r.related[productID]
+	if len(related) > limit {
+		related = related[:limit]
+	}
+	return related, true
+}
+
+func (r *relatedProducts) refresh(ctx context.Context) error {
+	products, err := r.store.getProducts(ctx, productFilter{}, pageRequest{})
+	if err != nil {
+		return err
+	}
+	productsByID := make(map[int]Product, len(products))
+	for _, p := range products {
+		productsByID[p.ID] = p
+	}
+
+	co, err := r.store.getProductCooccurrence(ctx)
+	if err != nil {
+		return err
+	}
+	related := make(map[int][]RelatedProduct)
+	for pair, n := range co.PairOrders {
+		productID, otherID := pair[0], pair[1]
+		other, ok := productsByID[otherID]
+		if !ok || co.ProductOrders[productID] == 0 {
+			continue
+		}
+		related[productID] = append(related[productID], RelatedProduct{
+			Product:    other,
+			Orders:     n,
+			Support:    float64(n) / float64(co.TotalOrders),
+			Confidence: float64(n) / float64(co.ProductOrders[productID]),
+		})
+	}
+	for _, products := range related {
+		sort.Slice(products, func(i, j int) bool {
+			if products[i].Confidence != products[j].Confidence {
+				return products[i].Confidence > products[j].Confidence
+			}
+			return products[i].ID < products[j].ID
+		})
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.related = related
+	r.ready = true
+	return nil
+}
+
+// productCooccurrence holds the number of orders containing
+// each product, and each ordered pair of distinct products.
+type productCooccurrence struct {
+	TotalOrders   int
+	ProductOrders map[int]int
+	PairOrders    map[[2]int]int
+}
+
+func getProductCooccurrence(ctx context.Context, db *sqlx.DB) (*productCooccurrence, error) {
+	co := productCooccurrence{
+		ProductOrders: make(map[int]int),
+		PairOrders:    make(map[[2]int]int),
+	}
+	if err := db.QueryRowContext(ctx, "SELECT COUNT(*) FROM orders").Scan(&co.TotalOrders); err != nil {
+		return nil, errors.Wrap(err, "querying orders count")
+	}
+
+	rows, err := db.QueryContext(ctx, `
+SELECT product_id, COUNT(DISTINCT order_id) FROM order_lines GROUP BY product_id`)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying product order counts")
+	}
+	for rows.Next() {
+		var productID, n int
+		if err := rows.Scan(&productID, &n); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		co.ProductOrders[productID] = n
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+
+	rows, err = db.QueryContext(ctx, `
+SELECT a.product_id, b.product_id, COUNT(DISTINCT a.order_id)
+FROM order_lines a
+JOIN order_lines b ON a.order_id=b.order_id AND a.product_id<>b.product_id
+GROUP BY a.product_id, b.product_id`)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying product co-occurrence")
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var productID, otherID, n int
+		if err := rows.Scan(&productID, &otherID, &n); err != nil {
+			return nil, err
+		}
+		co.PairOrders[[2]int{productID, otherID}] = n
+	}
+	return &co, rows.Err()
+}
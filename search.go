@@ -0,0 +1,176 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"html"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	searchHighlightStart = "<mark>"
+	searchHighlightEnd   = "</mark>"
+
+	// searchSnippetStart and searchSnippetEnd delimit the matches in
+	// snippets before they are HTML-escaped, and are then replaced
+	// with searchHighlightStart and searchHighlightEnd.
+	searchSnippetStart = "\x02"
+	searchSnippetEnd   = "\x03"
+)
+
+var searchSnippetReplacer = strings.NewReplacer(
+	searchSnippetStart, searchHighlightStart,
+	searchSnippetEnd, searchHighlightEnd,
+)
+
+// ProductSearchResult is a product matching a search query,
+// along with its relevance and a highlighted snippet of the
+// product description, as HTML.
+type ProductSearchResult struct {
+	Product
+	Rank    float64 `json:"rank"`
+	Snippet string  `json:"snippet"`
+}
+
+// productSearcher searches the product catalog.
+type productSearcher interface {
+	// searchProducts returns up to limit products matching query,
+	// in descending order of relevance.
+	searchProducts(ctx context.Context, query string, limit int) ([]ProductSearchResult, error)
+}
+
+// newProductSearcher returns a productSearcher for db, using any
+// driver-specific search index created by the migrations. The index is
+// maintained by the database (with triggers or generated columns), so
+// it is kept in sync with any changes to the catalog.
+//
+// If the database does not support full-text search, a productSearcher
+// which performs substring matching is returned.
+func newProductSearcher(ctx context.Context, db *sqlx.DB) (productSearcher, error) {
+	var searcher productSearcher
+	var err error
+	switch db.DriverName() {
+	case "sqlite3":
+		searcher, err = newSQLiteProductSearcher(ctx, db)
+	case "postgres":
+		searcher = newPostgresProductSearcher(db)
+	default:
+		return likeProductSearcher{sqlProductCatalog{db}}, nil
+	}
+	if err != nil {
+		if errors.Cause(err) != errFullTextSearchUnsupported {
+			return nil, err
+		}
+		logrus.WithError(err).Warn("falling back to substring product search")
+		return likeProductSearcher{sqlProductCatalog{db}}, nil
+	}
+	return searcher, nil
+}
+
+var errFullTextSearchUnsupported = errors.New("full-text search unsupported")
+
+// searchTerms splits a search query into its terms.
+func searchTerms(query string) []string {
+	return strings.FieldsFunc(query, func(r rune) bool {
+		return !(r == '-' || r == '\'' || r >= '0' && r <= '9' || r >= 'A' && r <= 'Z' || r >= 'a' && r <= 'z' || r >= 0x80)
+	})
+}
+
+// likeProductSearcher is a productSearcher that matches
+// search terms as case-insensitive substrings of the
+// product name or description.
+type likeProductSearcher struct {
+	catalog productCatalog
+}
+
+// productCatalog lists the products searched by likeProductSearcher.
+type productCatalog interface {
+	getProducts(ctx context.Context, filter productFilter, page pageRequest) ([]Product, error)
+}
+
+type sqlProductCatalog struct {
+	db *sqlx.DB
+}
+
+func (c sqlProductCatalog) getProducts(ctx context.Context, filter productFilter, page pageRequest) ([]Product, error) {
+	return getProducts(ctx, c.db, filter, page)
+}
+
+func (s likeProductSearcher) searchProducts(ctx context.Context, query string, limit int) ([]ProductSearchResult, error) {
+	terms := searchTerms(query)
+	if len(terms) == 0 {
+		return nil, nil
+	}
+	products, err := s.catalog.getProducts(ctx, productFilter{}, pageRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	patterns := make([]*regexp.Regexp, len(terms))
+	quoted := make([]string, len(terms))
+	for i, term := range terms {
+		quoted[i] = regexp.QuoteMeta(term)
+		patterns[i] = regexp.MustCompile("(?i)" + quoted[i])
+	}
+	highlight := regexp.MustCompile("(?i)" + strings.Join(quoted, "|"))
+
+	var results []ProductSearchResult
+	for _, p := range products {
+		var rank float64
+		for _, pattern := range patterns {
+			nameMatches := len(pattern.FindAllStringIndex(p.Name, -1))
+			descriptionMatches := len(pattern.FindAllStringIndex(p.Description, -1))
+			if nameMatches+descriptionMatches == 0 {
+				rank = 0
+				break
+			}
+			// Weight matches in the name above those in the description.
+			rank += float64(2*nameMatches + descriptionMatches)
+		}
+		if rank > 0 {
+			results = append(results, ProductSearchResult{
+				Product: p,
+				Rank:    rank,
+				Snippet: highlightSnippet(highlight.ReplaceAllString(p.Description, searchSnippetStart+"$0"+searchSnippetEnd)),
+			})
+		}
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Rank > results[j].Rank
+	})
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	return results, nil
+}
+
+func scanProductSearchResults(rows *sql.Rows) ([]ProductSearchResult, error) {
+	var results []ProductSearchResult
+	for rows.Next() {
+		var r ProductSearchResult
+		if err := rows.Scan(
+			&r.ID, &r.SKU, &r.Name, &r.Description,
+			&r.Stock, &r.Cost, &r.SellingPrice,
+			&r.TypeID, &r.TypeName,
+			&r.Rank, &r.Snippet,
+		); err != nil {
+			return nil, err
+		}
+		r.Snippet = highlightSnippet(r.Snippet)
+		results = append(results, r)
+	}
+	return results, rows.Err()
+}
+
+// highlightSnippet returns the HTML for a snippet with matches delimited
+// by searchSnippetStart and searchSnippetEnd, escaping the snippet text
+// so that product descriptions cannot inject markup.
+func highlightSnippet(snippet string) string {
+	return searchSnippetReplacer.Replace(html.EscapeString(snippet))
+}
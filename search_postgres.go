@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+// postgresProductSearcher is a productSearcher using the generated
+// tsvector column "products.search", with a GIN index, which is
+// created by a migration. The column is recomputed by Postgres
+// whenever a product is modified.
+type postgresProductSearcher struct {
+	db *sqlx.DB
+}
+
+func newPostgresProductSearcher(db *sqlx.DB) *postgresProductSearcher {
+	return &postgresProductSearcher{db}
+}
+
+func (s *postgresProductSearcher) searchProducts(ctx context.Context, query string, limit int) ([]ProductSearchResult, error) {
+	headlineOptions := fmt.Sprintf(
+		`StartSel="%s", StopSel="%s", MaxWords=24, MinWords=8`,
+		searchSnippetStart, searchSnippetEnd,
+	)
+	rows, err := s.db.QueryContext(ctx, `SELECT
+  products.id, products.sku, products.name, products.description,
+  products.stock, products.cost, products.selling_price,
+  products.type_id, product_types.name,
+  ts_rank(products.search, query) AS rank,
+  ts_headline('english', products.description, query, $1)
+FROM products
+JOIN product_types ON products.type_id=product_types.id,
+websearch_to_tsquery('english', $2) query
+WHERE products.search @@ query
+ORDER BY rank DESC
+LIMIT $3`, headlineOptions, query, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "searching products")
+	}
+	defer rows.Close()
+	return scanProductSearchResults(rows)
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+// sqliteProductSearcher is a productSearcher using the SQLite FTS5
+// external-content table "products_fts", indexing the product names
+// and descriptions, which is created by a migration and maintained by
+// triggers.
+//
+// FTS5 is only available when go-sqlite3 is built with the
+// "sqlite_fts5" build tag; otherwise the migration is not applied.
+type sqliteProductSearcher struct {
+	db *sqlx.DB
+}
+
+func newSQLiteProductSearcher(ctx context.Context, db *sqlx.DB) (*sqliteProductSearcher, error) {
+	var n int
+	if err := db.GetContext(ctx, &n,
+		"SELECT COUNT(*) FROM sqlite_master WHERE type='table' AND name='products_fts'",
+	); err != nil {
+		return nil, errors.Wrap(err, "checking for product search index")
+	}
+	if n == 0 {
+		return nil, errors.Wrap(errFullTextSearchUnsupported, "products_fts does not exist; build with the sqlite_fts5 tag")
+	}
+	return &sqliteProductSearcher{db}, nil
+}
+
+func (s *sqliteProductSearcher) searchProducts(ctx context.Context, query string, limit int) ([]ProductSearchResult, error) {
+	terms := searchTerms(query)
+	if len(terms) == 0 {
+		return nil, nil
+	}
+	// Quote each term to avoid interpreting FTS5 query syntax,
+	// and match prefixes so partial words find results.
+	for i, term := range terms {
+		terms[i] = `"` + strings.Replace(term, `"`, `""`, -1) + `"*`
+	}
+
+	// bm25 returns more negative values for better matches; the
+	// name column is weighted above the description.
+	rows, err := s.db.QueryContext(ctx, `SELECT
+  products.id, products.sku, products.name, products.description,
+  products.stock, products.cost, products.selling_price,
+  products.type_id, product_types.name,
+  -bm25(products_fts, 2.0, 1.0) AS rank,
+  snippet(products_fts, 1, ?, ?, '…', 16)
+FROM products_fts
+JOIN products ON products.id=products_fts.rowid
+JOIN product_types ON products.type_id=product_types.id
+WHERE products_fts MATCH ?
+ORDER BY rank DESC
+LIMIT ?`, searchSnippetStart, searchSnippetEnd, strings.Join(terms, " "), limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "searching products")
+	}
+	defer rows.Close()
+	return scanProductSearchResults(rows)
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	opbeansdb "github.com/elastic/opbeans-go/db"
+)
+
+// seedEpoch is the time at which seeded orders are created when a
+// seed is specified without -seed-time, so that the data is the
+// same no matter when it is generated.
+var seedEpoch = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+var (
+	seedFlag          = flag.Int64("seed", 0, "Random seed for generating sample data; if zero, a time-based seed is used")
+	seedTime          = flag.String("seed-time", "", "Time (RFC 3339) at which the last sample order is created; defaults to the current time, or "+seedEpoch.Format(time.RFC3339)+" if -seed is specified")
+	seedWindow        = flag.Duration("seed-window", opbeansdb.DefaultOrderWindow, "Period of time before -seed-time over which sample orders are spread")
+	seedOrders        = flag.Int("seed-orders", 5000, "Number of sample orders to generate")
+	seedCustomers     = flag.Int("seed-customers", 0, "Number of sample customers; if zero, all customers in the customers file are used")
+	seedProductsFile  = flag.String("seed-products", "", "JSON or CSV file defining the sample product catalog; defaults to the built-in catalog")
+	seedCustomersFile = flag.String("seed-customers-file", "", "JSON or CSV file defining the sample customers; defaults to the built-in customers")
+)
+
+// seedConfig returns the configuration for seeding
+// an empty store, as described by the -seed* flags.
+func seedConfig() (opbeansdb.SeedConfig, error) {
+	cfg := opbeansdb.SeedConfig{
+		Seed:          *seedFlag,
+		Window:        *seedWindow,
+		Orders:        *seedOrders,
+		Customers:     *seedCustomers,
+		ProductsFile:  *seedProductsFile,
+		CustomersFile: *seedCustomersFile,
+	}
+	if cfg.Orders < 0 || cfg.Customers < 0 || cfg.Window < 0 {
+		return cfg, errors.New("-seed-orders, -seed-customers and -seed-window must not be negative")
+	}
+	switch {
+	case *seedTime != "":
+		t, err := time.Parse(time.RFC3339, *seedTime)
+		if err != nil {
+			return cfg, errors.Wrap(err, "invalid -seed-time")
+		}
+		cfg.Time = t
+	case cfg.Seed != 0:
+		cfg.Time = seedEpoch
+	default:
+		cfg.Time = time.Now()
+	}
+	if cfg.Seed == 0 {
+		cfg.Seed = time.Now().UnixNano()
+	}
+	return cfg, nil
+}
+
+// seed implements the "seed" command, which migrates and seeds the
+// database specified by the -db flag, as described by the -seed*
+// flags. The database must be empty.
+func seed(args []string) error {
+	if len(args) != 0 {
+		return errors.New("usage: opbeans [flags] seed")
+	}
+	if *database == memoryStoreURL {
+		return errors.New("the in-memory store is seeded on startup")
+	}
+	cfg, err := seedConfig()
+	if err != nil {
+		return err
+	}
+	db, err := openDatabase()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+	driver := db.DriverName()
+	if err := opbeansdb.MigrateUp(ctx, db, driver, 0); err != nil {
+		return errors.Wrap(err, "migrating database")
+	}
+	empty, err := isDatabaseEmpty(ctx, db)
+	if err != nil {
+		return err
+	}
+	if !empty {
+		return errors.New("refusing to seed a database which contains data")
+	}
+	logrus.Infof("seeding %q database with %d orders (seed %d)", driver, cfg.Orders, cfg.Seed)
+	return opbeansdb.Seed(ctx, db, driver, cfg)
+}
@@ -2,9 +2,13 @@ package main
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
+
+	opbeansdb "github.com/elastic/opbeans-go/db"
 )
 
 type Stats struct {
@@ -18,6 +22,8 @@ type Stats struct {
 	} `json:"numbers"`
 }
 
+// getStats returns the numbers of products, customers and orders, and
+// the sales numbers of the orders which have not been cancelled.
 func getStats(ctx context.Context, db *sqlx.DB) (*Stats, error) {
 	var stats Stats
 	countParams := []struct {
@@ -38,8 +44,10 @@ func getStats(ctx context.Context, db *sqlx.DB) (*Stats, error) {
 	var revenue, cost, profit *int
 	row := db.QueryRowContext(ctx, `
 SELECT
-  SUM(selling_price), SUM(cost), SUM(selling_price-cost)
-FROM products JOIN order_lines ON products.id=order_lines.product_id
+  SUM(amount*unit_price), SUM(amount*unit_cost), SUM(amount*(unit_price-unit_cost))
+FROM order_lines
+JOIN orders ON orders.id=order_lines.order_id
+WHERE orders.status<>'cancelled'
 `)
 	if err := row.Scan(&revenue, &cost, &profit); err != nil {
 		return nil, errors.Wrap(err, "querying numbers")
@@ -56,3 +64,237 @@ func maybeInt(p *int) int {
 	}
 	return *p
 }
+
+// statsInterval is the width of the buckets in a sales time series.
+type statsInterval string
+
+const (
+	statsIntervalHour statsInterval = "hour"
+	statsIntervalDay  statsInterval = "day"
+	statsIntervalWeek statsInterval = "week"
+
+	// maxStatsBuckets is the maximum number of
+	// buckets that may be requested in a time series.
+	maxStatsBuckets = 5000
+)
+
+func (i statsInterval) Valid() bool {
+	switch i {
+	case statsIntervalHour, statsIntervalDay, statsIntervalWeek:
+		return true
+	}
+	return false
+}
+
+func (i statsInterval) Duration() time.Duration {
+	switch i {
+	case statsIntervalHour:
+		return time.Hour
+	case statsIntervalWeek:
+		return 7 * 24 * time.Hour
+	}
+	return 24 * time.Hour
+}
+
+// DefaultRange returns the time range covered by a
+// time series when no start time is specified.
+func (i statsInterval) DefaultRange() time.Duration {
+	switch i {
+	case statsIntervalHour:
+		return 48 * time.Hour
+	case statsIntervalWeek:
+		return 26 * 7 * 24 * time.Hour
+	}
+	return 30 * 24 * time.Hour
+}
+
+// Truncate returns the start of the bucket containing t, in UTC.
+// Weeks start on Monday, as with Postgres's date_trunc.
+func (i statsInterval) Truncate(t time.Time) time.Time {
+	t = t.UTC()
+	switch i {
+	case statsIntervalHour:
+		return t.Truncate(time.Hour)
+	case statsIntervalWeek:
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// truncateSQL returns an SQL expression truncating the given
+// timestamp column to the start of its bucket.
+func (i statsInterval) truncateSQL(driver, column string) string {
+	switch driver {
+	case "sqlite3":
+		switch i {
+		case statsIntervalHour:
+			return fmt.Sprintf("strftime('%%Y-%%m-%%d %%H:00:00', %s)", column)
+		case statsIntervalWeek:
+			// Advance to the following Sunday (or stay on Sunday),
+			// then go back six days to the start of the week.
+			return fmt.Sprintf("strftime('%%Y-%%m-%%d 00:00:00', %s, 'weekday 0', '-6 days')", column)
+		}
+		return fmt.Sprintf("strftime('%%Y-%%m-%%d 00:00:00', %s)", column)
+	case "mysql":
+		switch i {
+		case statsIntervalHour:
+			return fmt.Sprintf("DATE_FORMAT(%s, '%%Y-%%m-%%d %%H:00:00')", column)
+		case statsIntervalWeek:
+			// WEEKDAY returns 0 for Monday.
+			return fmt.Sprintf("DATE_SUB(DATE(%[1]s), INTERVAL WEEKDAY(%[1]s) DAY)", column)
+		}
+		return fmt.Sprintf("DATE(%s)", column)
+	}
+	return fmt.Sprintf("date_trunc('%s', %s)", i, column)
+}
+
+// TimeSeriesBucket holds the sales numbers for orders
+// created within the interval starting at Time.
+type TimeSeriesBucket struct {
+	Time    time.Time `json:"time"`
+	Orders  int       `json:"orders"`
+	Units   int       `json:"units"`
+	Revenue int       `json:"revenue"`
+	Profit  int       `json:"profit"`
+}
+
+// getStatsTimeSeries returns sales numbers for the orders created in
+// the range [from, to), bucketed by interval, excluding cancelled orders.
+// Buckets without orders are included, so the series is continuous.
+func getStatsTimeSeries(ctx context.Context, db *sqlx.DB, interval statsInterval, from, to time.Time) ([]TimeSeriesBucket, error) {
+	bucket := interval.truncateSQL(db.DriverName(), "orders.created_at")
+	rows, err := db.QueryContext(ctx, db.Rebind(`SELECT
+  `+bucket+` AS bucket,
+  COUNT(DISTINCT orders.id),
+  COALESCE(SUM(order_lines.amount), 0),
+  COALESCE(SUM(order_lines.amount*order_lines.unit_price), 0),
+  COALESCE(SUM(order_lines.amount*(order_lines.unit_price-order_lines.unit_cost)), 0)
+FROM orders LEFT JOIN order_lines ON order_lines.order_id=orders.id
+WHERE orders.created_at >= ? AND orders.created_at < ? AND orders.status<>'cancelled'
+GROUP BY bucket
+ORDER BY bucket`), opbeansdb.TimeArg(db.DriverName(), from), opbeansdb.TimeArg(db.DriverName(), to))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying time series")
+	}
+	defer rows.Close()
+
+	buckets := make(map[time.Time]TimeSeriesBucket)
+	for rows.Next() {
+		var t nullTime
+		var b TimeSeriesBucket
+		if err := rows.Scan(&t, &b.Orders, &b.Units, &b.Revenue, &b.Profit); err != nil {
+			return nil, err
+		}
+		b.Time = t.Time.UTC()
+		buckets[b.Time] = b
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return fillTimeSeries(interval, from, to, buckets), nil
+}
+
+// fillTimeSeries returns the buckets covering the range [from, to) in
+// time order, taken from the given buckets keyed by start time, or empty
+// where there is no such bucket.
+func fillTimeSeries(interval statsInterval, from, to time.Time, buckets map[time.Time]TimeSeriesBucket) []TimeSeriesBucket {
+	var series []TimeSeriesBucket
+	for t := interval.Truncate(from); t.Before(to); t = t.Add(interval.Duration()) {
+		b, ok := buckets[t]
+		if !ok {
+			b.Time = t
+		}
+		series = append(series, b)
+	}
+	return series
+}
+
+// SalesBreakdown holds the sales numbers for a segment of orders,
+// identified by either product type or customer location.
+type SalesBreakdown struct {
+	TypeID   int    `json:"type_id,omitempty"`
+	TypeName string `json:"type_name,omitempty"`
+	Country  string `json:"country,omitempty"`
+	City     string `json:"city,omitempty"`
+	Orders   int    `json:"orders"`
+	Units    int    `json:"units"`
+	Revenue  int    `json:"revenue"`
+	Cost     int    `json:"cost"`
+	Margin   int    `json:"margin"`
+}
+
+// salesDimension identifies how sales are segmented in a breakdown.
+type salesDimension string
+
+const (
+	salesByProductType salesDimension = "types"
+	salesByCountry     salesDimension = "countries"
+	salesByCity        salesDimension = "cities"
+)
+
+// getSalesBreakdown returns sales numbers segmented by the given
+// dimension, in descending order of revenue, excluding cancelled
+// orders. If limit is positive, only the top limit segments are
+// returned.
+func getSalesBreakdown(ctx context.Context, db *sqlx.DB, dimension salesDimension, limit int) ([]SalesBreakdown, error) {
+	var columns, joins string
+	switch dimension {
+	case salesByProductType:
+		columns = "product_types.id, product_types.name"
+		joins = `JOIN products ON products.id=order_lines.product_id
+JOIN product_types ON product_types.id=products.type_id`
+	case salesByCountry:
+		columns = "customers.country"
+		joins = "JOIN customers ON customers.id=orders.customer_id"
+	case salesByCity:
+		columns = "customers.country, customers.city"
+		joins = "JOIN customers ON customers.id=orders.customer_id"
+	default:
+		return nil, errors.Errorf("invalid sales dimension %q", dimension)
+	}
+
+	queryString := `SELECT
+  ` + columns + `,
+  COUNT(DISTINCT orders.id),
+  SUM(order_lines.amount),
+  SUM(order_lines.amount*order_lines.unit_price) AS revenue,
+  SUM(order_lines.amount*order_lines.unit_cost)
+FROM order_lines
+JOIN orders ON orders.id=order_lines.order_id
+` + joins + `
+WHERE orders.status<>'cancelled'
+GROUP BY ` + columns + `
+ORDER BY revenue DESC, ` + columns + `
+`
+	if limit > 0 {
+		queryString += fmt.Sprintf("LIMIT %d\n", limit)
+	}
+	rows, err := db.QueryContext(ctx, queryString)
+	if err != nil {
+		return nil, errors.Wrapf(err, "querying sales by %s", dimension)
+	}
+	defer rows.Close()
+
+	// Encode empty breakdowns as [] rather than null.
+	breakdown := []SalesBreakdown{}
+	for rows.Next() {
+		var b SalesBreakdown
+		var dest []interface{}
+		switch dimension {
+		case salesByProductType:
+			dest = append(dest, &b.TypeID, &b.TypeName)
+		case salesByCountry:
+			dest = append(dest, &b.Country)
+		case salesByCity:
+			dest = append(dest, &b.Country, &b.City)
+		}
+		dest = append(dest, &b.Orders, &b.Units, &b.Revenue, &b.Cost)
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		b.Margin = b.Revenue - b.Cost
+		breakdown = append(breakdown, b)
+	}
+	return breakdown, rows.Err()
+}
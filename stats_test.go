@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	opbeansdb "github.com/elastic/opbeans-go/db"
+)
+
+func TestSalesBreakdownEmpty(t *testing.T) {
+	ctx := context.Background()
+	db, err := sqlx.Open("sqlite3", filepath.Join(t.TempDir(), "opbeans.db"))
+	require.NoError(t, err)
+	defer db.Close()
+	require.NoError(t, opbeansdb.MigrateUp(ctx, db, "sqlite3", 0))
+
+	for _, dimension := range []salesDimension{salesByProductType, salesByCountry, salesByCity} {
+		breakdown, err := getSalesBreakdown(ctx, db, dimension, 10)
+		require.NoError(t, err)
+		encoded, err := json.Marshal(breakdown)
+		require.NoError(t, err)
+		assert.Equal(t, "[]", string(encoded), dimension)
+	}
+
+	breakdown, err := newMemoryStore().getSalesBreakdown(ctx, salesByProductType, 10)
+	require.NoError(t, err)
+	assert.NotNil(t, breakdown)
+}
+
+func TestStatsExcludeCancelledOrders(t *testing.T) {
+	cfg := opbeansdb.SeedConfig{Seed: 1, Time: seedEpoch, Orders: 100}
+
+	t.Run("memory", func(t *testing.T) {
+		store := newMemoryStore()
+		require.NoError(t, store.seed(cfg))
+		testStatsExcludeCancelledOrders(t, store)
+	})
+	t.Run("sqlite3", func(t *testing.T) {
+		ctx := context.Background()
+		db, err := sqlx.Open("sqlite3", filepath.Join(t.TempDir(), "opbeans.db"))
+		require.NoError(t, err)
+		defer db.Close()
+		require.NoError(t, opbeansdb.MigrateUp(ctx, db, "sqlite3", 0))
+		require.NoError(t, opbeansdb.Seed(ctx, db, "sqlite3", cfg))
+		store, err := newSQLStore(ctx, db)
+		require.NoError(t, err)
+		testStatsExcludeCancelledOrders(t, store)
+	})
+}
+
+func testStatsExcludeCancelledOrders(t *testing.T, store Store) {
+	ctx := context.Background()
+	from := seedEpoch.Truncate(time.Hour)
+	to := from.Add(time.Hour)
+	get := func() (*Stats, TimeSeriesBucket, int) {
+		stats, err := store.getStats(ctx)
+		require.NoError(t, err)
+		series, err := store.getStatsTimeSeries(ctx, statsIntervalHour, from, to)
+		require.NoError(t, err)
+		require.Len(t, series, 1)
+		breakdown, err := store.getSalesBreakdown(ctx, salesByCountry, 0)
+		require.NoError(t, err)
+		var revenue int
+		for _, b := range breakdown {
+			revenue += b.Revenue
+		}
+		return stats, series[0], revenue
+	}
+	statsBefore, bucketBefore, breakdownBefore := get()
+
+	order, err := store.getOrder(ctx, 1)
+	require.NoError(t, err)
+	require.NotNil(t, order)
+	var units, cost int
+	for _, l := range order.Lines {
+		units += l.Amount
+		cost += l.Amount * l.UnitCost
+	}
+	found, err := store.transitionOrder(ctx, order.ID, OrderCancelled)
+	require.NoError(t, err)
+	require.True(t, found)
+
+	stats, bucket, breakdownRevenue := get()
+	assert.Equal(t, statsBefore.Orders, stats.Orders)
+	assert.Equal(t, statsBefore.Numbers.Revenue-order.Total, stats.Numbers.Revenue)
+	assert.Equal(t, statsBefore.Numbers.Cost-cost, stats.Numbers.Cost)
+	assert.Equal(t, statsBefore.Numbers.Profit-(order.Total-cost), stats.Numbers.Profit)
+	assert.Equal(t, bucketBefore.Orders-1, bucket.Orders)
+	assert.Equal(t, bucketBefore.Units-units, bucket.Units)
+	assert.Equal(t, bucketBefore.Revenue-order.Total, bucket.Revenue)
+	assert.Equal(t, breakdownBefore-order.Total, breakdownRevenue)
+}
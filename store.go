@@ -0,0 +1,161 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// Store provides access to the shop's catalog, customers and orders.
+//
+// There are two implementations: sqlStore, backed by an SQL database,
+// and memoryStore, which holds everything in memory and requires no
+// database at all.
+type Store interface {
+	productSearcher
+
+	getStats(ctx context.Context) (*Stats, error)
+	getStatsTimeSeries(ctx context.Context, interval statsInterval, from, to time.Time) ([]TimeSeriesBucket, error)
+	getSalesBreakdown(ctx context.Context, dimension salesDimension, limit int) ([]SalesBreakdown, error)
+
+	getProducts(ctx context.Context, filter productFilter, page pageRequest) ([]Product, error)
+	getTopProducts(ctx context.Context) ([]Product, error)
+	getProduct(ctx context.Context, id int) (*Product, error)
+	createProduct(ctx context.Context, p *Product) (int, error)
+	updateProduct(ctx context.Context, p *Product) (bool, error)
+	deleteProduct(ctx context.Context, id int) (bool, error)
+	getProductTypes(ctx context.Context) ([]ProductType, error)
+	getProductType(ctx context.Context, id int) (*ProductType, error)
+	getProductCooccurrence(ctx context.Context) (*productCooccurrence, error)
+
+	getCustomers(ctx context.Context, filter customerFilter, page pageRequest) ([]Customer, error)
+	getProductCustomers(ctx context.Context, productID int, page pageRequest) ([]Customer, error)
+	getCustomer(ctx context.Context, id int) (*Customer, error)
+	getCustomerDetails(ctx context.Context, id int) (*CustomerDetails, error)
+	createCustomer(ctx context.Context, c *Customer) (int, error)
+	updateCustomer(ctx context.Context, c *Customer) (bool, error)
+	deleteCustomer(ctx context.Context, id int) (bool, error)
+	anonymizeCustomer(ctx context.Context, id int) (bool, error)
+
+	getOrders(ctx context.Context, filter orderFilter, page pageRequest) ([]Order, error)
+	getOrder(ctx context.Context, id int) (*Order, error)
+	createOrder(ctx context.Context, customer *Customer, lines []ProductOrderLine) (int, error)
+	transitionOrder(ctx context.Context, id int, to OrderStatus) (bool, error)
+
+	Close() error
+}
+
+// sqlStore is a Store backed by an SQL database.
+type sqlStore struct {
+	productSearcher
+	db *sqlx.DB
+}
+
+func newSQLStore(ctx context.Context, db *sqlx.DB) (*sqlStore, error) {
+	searcher, err := newProductSearcher(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return &sqlStore{productSearcher: searcher, db: db}, nil
+}
+
+func (s *sqlStore) Close() error {
+	return s.db.Close()
+}
+
+func (s *sqlStore) getStats(ctx context.Context) (*Stats, error) {
+	return getStats(ctx, s.db)
+}
+
+func (s *sqlStore) getStatsTimeSeries(ctx context.Context, interval statsInterval, from, to time.Time) ([]TimeSeriesBucket, error) {
+	return getStatsTimeSeries(ctx, s.db, interval, from, to)
+}
+
+func (s *sqlStore) getSalesBreakdown(ctx context.Context, dimension salesDimension, limit int) ([]SalesBreakdown, error) {
+	return getSalesBreakdown(ctx, s.db, dimension, limit)
+}
+
+func (s *sqlStore) getProducts(ctx context.Context, filter productFilter, page pageRequest) ([]Product, error) {
+	return getProducts(ctx, s.db, filter, page)
+}
+
+func (s *sqlStore) getTopProducts(ctx context.Context) ([]Product, error) {
+	return getTopProducts(ctx, s.db)
+}
+
+func (s *sqlStore) getProduct(ctx context.Context, id int) (*Product, error) {
+	return getProduct(ctx, s.db, id)
+}
+
+func (s *sqlStore) createProduct(ctx context.Context, p *Product) (int, error) {
+	return createProduct(ctx, s.db, p)
+}
+
+func (s *sqlStore) updateProduct(ctx context.Context, p *Product) (bool, error) {
+	return updateProduct(ctx, s.db, p)
+}
+
+func (s *sqlStore) deleteProduct(ctx context.Context, id int) (bool, error) {
+	return deleteProduct(ctx, s.db, id)
+}
+
+func (s *sqlStore) getProductTypes(ctx context.Context) ([]ProductType, error) {
+	return getProductTypes(ctx, s.db)
+}
+
+func (s *sqlStore) getProductType(ctx context.Context, id int) (*ProductType, error) {
+	return getProductType(ctx, s.db, id)
+}
+
+func (s *sqlStore) getProductCooccurrence(ctx context.Context) (*productCooccurrence, error) {
+	return getProductCooccurrence(ctx, s.db)
+}
+
+func (s *sqlStore) getCustomers(ctx context.Context, filter customerFilter, page pageRequest) ([]Customer, error) {
+	return getCustomers(ctx, s.db, filter, page)
+}
+
+func (s *sqlStore) getProductCustomers(ctx context.Context, productID int, page pageRequest) ([]Customer, error) {
+	return getProductCustomers(ctx, s.db, productID, page)
+}
+
+func (s *sqlStore) getCustomer(ctx context.Context, id int) (*Customer, error) {
+	return getCustomer(ctx, s.db, id)
+}
+
+func (s *sqlStore) getCustomerDetails(ctx context.Context, id int) (*CustomerDetails, error) {
+	return getCustomerDetails(ctx, s.db, id)
+}
+
+func (s *sqlStore) createCustomer(ctx context.Context, c *Customer) (int, error) {
+	return createCustomer(ctx, s.db, c)
+}
+
+func (s *sqlStore) updateCustomer(ctx context.Context, c *Customer) (bool, error) {
+	return updateCustomer(ctx, s.db, c)
+}
+
+func (s *sqlStore) deleteCustomer(ctx context.Context, id int) (bool, error) {
+	return deleteCustomer(ctx, s.db, id)
+}
+
+func (s *sqlStore) anonymizeCustomer(ctx context.Context, id int) (bool, error) {
+	return anonymizeCustomer(ctx, s.db, id)
+}
+
+func (s *sqlStore) getOrders(ctx context.Context, filter orderFilter, page pageRequest) ([]Order, error) {
+	return getOrders(ctx, s.db, filter, page)
+}
+
+func (s *sqlStore) getOrder(ctx context.Context, id int) (*Order, error) {
+	return getOrder(ctx, s.db, id)
+}
+
+func (s *sqlStore) createOrder(ctx context.Context, customer *Customer, lines []ProductOrderLine) (int, error) {
+	return createOrder(ctx, s.db, customer, lines)
+}
+
+func (s *sqlStore) transitionOrder(ctx context.Context, id int, to OrderStatus) (bool, error) {
+	return transitionOrder(ctx, s.db, id, to)
+}
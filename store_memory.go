@@ -0,0 +1,846 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	opbeansdb "github.com/elastic/opbeans-go/db"
+)
+
+// memoryStore is a Store which holds all data in memory, for running
+// without a database. It is selected with "-db=memory:", and has no
+// dependency on cgo.
+//
+// Queries are evaluated by scanning all rows, which is adequate for
+// the size of the sample data set.
+type memoryStore struct {
+	mu           sync.RWMutex
+	productTypes map[int]ProductType
+	products     map[int]Product
+	customers    map[int]Customer
+	orders       map[int]*memoryOrder
+
+	nextProductID  int
+	nextCustomerID int
+	nextOrderID    int
+}
+
+type memoryOrder struct {
+	ID         int
+	CustomerID int
+	CreatedAt  time.Time
+	Status     OrderStatus
+	History    []OrderStatusChange
+	Lines      []memoryOrderLine
+}
+
+type memoryOrderLine struct {
+	ProductID int
+	Amount    int
+	UnitPrice int
+	UnitCost  int
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{
+		productTypes:   make(map[int]ProductType),
+		products:       make(map[int]Product),
+		customers:      make(map[int]Customer),
+		orders:         make(map[int]*memoryOrder),
+		nextProductID:  1,
+		nextCustomerID: 1,
+		nextOrderID:    1,
+	}
+}
+
+func (s *memoryStore) Close() error {
+	return nil
+}
+
+func (s *memoryStore) searchProducts(ctx context.Context, query string, limit int) ([]ProductSearchResult, error) {
+	return likeProductSearcher{s}.searchProducts(ctx, query, limit)
+}
+
+func (s *memoryStore) getStats(ctx context.Context) (*Stats, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var stats Stats
+	stats.Products = len(s.products)
+	stats.Customers = len(s.customers)
+	stats.Orders = len(s.orders)
+	for _, o := range s.orders {
+		if o.Status == OrderCancelled {
+			continue
+		}
+		for _, l := range o.Lines {
+			stats.Numbers.Revenue += l.Amount * l.UnitPrice
+			stats.Numbers.Cost += l.Amount * l.UnitCost
+		}
+	}
+	stats.Numbers.Profit = stats.Numbers.Revenue - stats.Numbers.Cost
+	return &stats, nil
+}
+
+func (s *memoryStore) getStatsTimeSeries(ctx context.Context, interval statsInterval, from, to time.Time) ([]TimeSeriesBucket, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	buckets := make(map[time.Time]TimeSeriesBucket)
+	for _, o := range s.orders {
+		if o.CreatedAt.Before(from) || !o.CreatedAt.Before(to) || o.Status == OrderCancelled {
+			continue
+		}
+		t := interval.Truncate(o.CreatedAt)
+		b := buckets[t]
+		b.Time = t
+		b.Orders++
+		for _, l := range o.Lines {
+			b.Units += l.Amount
+			b.Revenue += l.Amount * l.UnitPrice
+			b.Profit += l.Amount * (l.UnitPrice - l.UnitCost)
+		}
+		buckets[t] = b
+	}
+	return fillTimeSeries(interval, from, to, buckets), nil
+}
+
+func (s *memoryStore) getSalesBreakdown(ctx context.Context, dimension salesDimension, limit int) ([]SalesBreakdown, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	type segment struct {
+		SalesBreakdown
+		orders map[int]bool
+	}
+	segments := make(map[SalesBreakdown]*segment)
+	for _, o := range s.orders {
+		if o.Status == OrderCancelled {
+			continue
+		}
+		customer := s.customers[o.CustomerID]
+		for _, l := range o.Lines {
+			var key SalesBreakdown
+			switch dimension {
+			case salesByProductType:
+				product, ok := s.products[l.ProductID]
+				if !ok {
+					continue
+				}
+				productType, ok := s.productTypes[product.TypeID]
+				if !ok {
+					continue
+				}
+				key.TypeID = productType.ID
+				key.TypeName = productType.Name
+			case salesByCountry:
+				key.Country = customer.Country
+			case salesByCity:
+				key.Country = customer.Country
+				key.City = customer.City
+			default:
+				return nil, errors.Errorf("invalid sales dimension %q", dimension)
+			}
+			seg, ok := segments[key]
+			if !ok {
+				seg = &segment{SalesBreakdown: key, orders: make(map[int]bool)}
+				segments[key] = seg
+			}
+			seg.orders[o.ID] = true
+			seg.Units += l.Amount
+			seg.Revenue += l.Amount * l.UnitPrice
+			seg.Cost += l.Amount * l.UnitCost
+		}
+	}
+
+	breakdown := make([]SalesBreakdown, 0, len(segments))
+	for _, seg := range segments {
+		b := seg.SalesBreakdown
+		b.Orders = len(seg.orders)
+		b.Margin = b.Revenue - b.Cost
+		breakdown = append(breakdown, b)
+	}
+	sort.Slice(breakdown, func(i, j int) bool {
+		a, b := breakdown[i], breakdown[j]
+		if a.Revenue != b.Revenue {
+			return a.Revenue > b.Revenue
+		}
+		if a.TypeID != b.TypeID {
+			return a.TypeID < b.TypeID
+		}
+		if a.Country != b.Country {
+			return a.Country < b.Country
+		}
+		return a.City < b.City
+	})
+	if limit > 0 && len(breakdown) > limit {
+		breakdown = breakdown[:limit]
+	}
+	return breakdown, nil
+}
+
+func (s *memoryStore) getProducts(ctx context.Context, filter productFilter, page pageRequest) ([]Product, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var products []Product
+	for _, p := range s.products {
+		if filter.ID != nil && p.ID != *filter.ID ||
+			filter.TypeID != nil && p.TypeID != *filter.TypeID ||
+			filter.MinStock != nil && p.Stock < *filter.MinStock ||
+			filter.MaxStock != nil && p.Stock > *filter.MaxStock {
+			continue
+		}
+		productType, ok := s.productTypes[p.TypeID]
+		if !ok {
+			continue
+		}
+		p.TypeName = productType.Name
+		products = append(products, p)
+	}
+	return memoryPage(products, page, func(p Product) int { return p.ID }, func(id int) (interface{}, bool) {
+		p, ok := s.products[id]
+		return p, ok
+	}, productSortValue), nil
+}
+
+func productSortValue(row interface{}, column string) interface{} {
+	p := row.(Product)
+	switch column {
+	case "sku":
+		return p.SKU
+	case "name":
+		return p.Name
+	case "stock":
+		return p.Stock
+	case "cost":
+		return p.Cost
+	case "selling_price":
+		return p.SellingPrice
+	}
+	return p.ID
+}
+
+func (s *memoryStore) getTopProducts(ctx context.Context) ([]Product, error) {
+	const limit = 3 // top 3 best-selling products
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	sold := make(map[int]int)
+	for _, o := range s.orders {
+		for _, l := range o.Lines {
+			sold[l.ProductID] += l.Amount
+		}
+	}
+	var products []Product
+	for id, n := range sold {
+		p, ok := s.products[id]
+		if !ok {
+			continue
+		}
+		products = append(products, Product{ID: p.ID, SKU: p.SKU, Name: p.Name, Stock: p.Stock, Sold: n})
+	}
+	sort.Slice(products, func(i, j int) bool {
+		if products[i].Sold != products[j].Sold {
+			return products[i].Sold > products[j].Sold
+		}
+		return products[i].ID < products[j].ID
+	})
+	if len(products) > limit {
+		products = products[:limit]
+	}
+	return products, nil
+}
+
+func (s *memoryStore) getProduct(ctx context.Context, id int) (*Product, error) {
+	products, err := s.getProducts(ctx, productFilter{ID: &id}, pageRequest{})
+	if err != nil || len(products) == 0 {
+		return nil, err
+	}
+	return &products[0], nil
+}
+
+func (s *memoryStore) createProduct(ctx context.Context, p *Product) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkProduct(p); err != nil {
+		return -1, err
+	}
+	product := *p
+	product.ID = s.nextProductID
+	product.TypeName = ""
+	product.Sold = 0
+	s.products[product.ID] = product
+	s.nextProductID++
+	return product.ID, nil
+}
+
+func (s *memoryStore) updateProduct(ctx context.Context, p *Product) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.productTypes[p.TypeID]; !ok {
+		return false, errUnknownProductType
+	}
+	if _, ok := s.products[p.ID]; !ok {
+		return false, nil
+	}
+	if err := s.checkProduct(p); err != nil {
+		return false, err
+	}
+	product := *p
+	product.TypeName = ""
+	product.Sold = 0
+	s.products[product.ID] = product
+	return true, nil
+}
+
+// checkProduct checks that p refers to an existing product type,
+// and that no other product has the same SKU.
+func (s *memoryStore) checkProduct(p *Product) error {
+	if _, ok := s.productTypes[p.TypeID]; !ok {
+		return errUnknownProductType
+	}
+	for _, other := range s.products {
+		if other.SKU == p.SKU && other.ID != p.ID {
+			return errDuplicateSKU
+		}
+	}
+	return nil
+}
+
+func (s *memoryStore) deleteProduct(ctx context.Context, id int) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, o := range s.orders {
+		for _, l := range o.Lines {
+			if l.ProductID == id {
+				return false, errProductHasOrders
+			}
+		}
+	}
+	if _, ok := s.products[id]; !ok {
+		return false, nil
+	}
+	delete(s.products, id)
+	return true, nil
+}
+
+func (s *memoryStore) getProductTypes(ctx context.Context) ([]ProductType, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var productTypes []ProductType
+	for _, pt := range s.productTypes {
+		productTypes = append(productTypes, pt)
+	}
+	sort.Slice(productTypes, func(i, j int) bool {
+		return productTypes[i].ID < productTypes[j].ID
+	})
+	return productTypes, nil
+}
+
+func (s *memoryStore) getProductType(ctx context.Context, id int) (*ProductType, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	pt, ok := s.productTypes[id]
+	if !ok {
+		return nil, nil
+	}
+	return &pt, nil
+}
+
+func (s *memoryStore) getProductCooccurrence(ctx context.Context) (*productCooccurrence, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	co := productCooccurrence{
+		TotalOrders:   len(s.orders),
+		ProductOrders: make(map[int]int),
+		PairOrders:    make(map[[2]int]int),
+	}
+	for _, o := range s.orders {
+		products := make(map[int]bool)
+		for _, l := range o.Lines {
+			products[l.ProductID] = true
+		}
+		for a := range products {
+			co.ProductOrders[a]++
+			for b := range products {
+				if a != b {
+					co.PairOrders[[2]int{a, b}]++
+				}
+			}
+		}
+	}
+	return &co, nil
+}
+
+func (s *memoryStore) getCustomers(ctx context.Context, filter customerFilter, page pageRequest) ([]Customer, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var productCustomers map[int]bool
+	if filter.ProductID != nil {
+		productCustomers = make(map[int]bool)
+		for _, o := range s.orders {
+			for _, l := range o.Lines {
+				if l.ProductID == *filter.ProductID {
+					productCustomers[o.CustomerID] = true
+				}
+			}
+		}
+	}
+	var customers []Customer
+	for _, c := range s.customers {
+		if filter.ID != nil && c.ID != *filter.ID ||
+			productCustomers != nil && !productCustomers[c.ID] ||
+			filter.Country != "" && c.Country != filter.Country ||
+			filter.City != "" && c.City != filter.City {
+			continue
+		}
+		customers = append(customers, c)
+	}
+	return memoryPage(customers, page, func(c Customer) int { return c.ID }, func(id int) (interface{}, bool) {
+		c, ok := s.customers[id]
+		return c, ok
+	}, customerSortValue), nil
+}
+
+func customerSortValue(row interface{}, column string) interface{} {
+	c := row.(Customer)
+	switch column {
+	case "full_name":
+		return c.FullName
+	case "company_name":
+		return c.CompanyName
+	case "country":
+		return c.Country
+	case "city":
+		return c.City
+	}
+	return c.ID
+}
+
+func (s *memoryStore) getProductCustomers(ctx context.Context, productID int, page pageRequest) ([]Customer, error) {
+	return s.getCustomers(ctx, customerFilter{ProductID: &productID}, page)
+}
+
+func (s *memoryStore) getCustomer(ctx context.Context, id int) (*Customer, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	c, ok := s.customers[id]
+	if !ok {
+		return nil, nil
+	}
+	return &c, nil
+}
+
+func (s *memoryStore) getCustomerDetails(ctx context.Context, id int) (*CustomerDetails, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	c, ok := s.customers[id]
+	if !ok {
+		return nil, nil
+	}
+	details := CustomerDetails{Customer: c}
+	for _, o := range s.orders {
+		if o.CustomerID != id {
+			continue
+		}
+		createdAt := o.CreatedAt
+		details.OrderCount++
+		if details.FirstOrderAt == nil || createdAt.Before(*details.FirstOrderAt) {
+			details.FirstOrderAt = &createdAt
+		}
+		if details.LastOrderAt == nil || createdAt.After(*details.LastOrderAt) {
+			details.LastOrderAt = &createdAt
+		}
+		if o.Status != OrderCancelled {
+			for _, l := range o.Lines {
+				details.TotalSpent += l.Amount * l.UnitPrice
+			}
+		}
+	}
+	return &details, nil
+}
+
+func (s *memoryStore) createCustomer(ctx context.Context, c *Customer) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	customer := *c
+	customer.ID = s.nextCustomerID
+	s.customers[customer.ID] = customer
+	s.nextCustomerID++
+	return customer.ID, nil
+}
+
+func (s *memoryStore) updateCustomer(ctx context.Context, c *Customer) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.customers[c.ID]; !ok {
+		return false, nil
+	}
+	s.customers[c.ID] = *c
+	return true, nil
+}
+
+func (s *memoryStore) deleteCustomer(ctx context.Context, id int) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, o := range s.orders {
+		if o.CustomerID == id {
+			return false, errCustomerHasOrders
+		}
+	}
+	if _, ok := s.customers[id]; !ok {
+		return false, nil
+	}
+	delete(s.customers, id)
+	return true, nil
+}
+
+func (s *memoryStore) anonymizeCustomer(ctx context.Context, id int) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.customers[id]
+	if !ok {
+		return false, nil
+	}
+	s.customers[id] = Customer{
+		ID:       id,
+		FullName: "Anonymous",
+		Email:    fmt.Sprintf("anonymous-%d@example.invalid", id),
+		Country:  c.Country,
+	}
+	return true, nil
+}
+
+func (s *memoryStore) getOrders(ctx context.Context, filter orderFilter, page pageRequest) ([]Order, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var orders []Order
+	for _, o := range s.orders {
+		if !filter.From.IsZero() && o.CreatedAt.Before(filter.From) ||
+			!filter.To.IsZero() && !o.CreatedAt.Before(filter.To) ||
+			filter.CustomerID != nil && o.CustomerID != *filter.CustomerID {
+			continue
+		}
+		if filter.ProductID != nil {
+			var found bool
+			for _, l := range o.Lines {
+				found = found || l.ProductID == *filter.ProductID
+			}
+			if !found {
+				continue
+			}
+		}
+		customer, ok := s.customers[o.CustomerID]
+		if !ok {
+			continue
+		}
+		order := o.order()
+		order.CustomerName = customer.FullName
+		for _, l := range o.Lines {
+			order.Total += l.Amount * l.UnitPrice
+		}
+		order.LineCount = len(o.Lines)
+		orders = append(orders, order)
+	}
+	return memoryPage(orders, page, func(o Order) int { return o.ID }, func(id int) (interface{}, bool) {
+		o, ok := s.orders[id]
+		if !ok {
+			return nil, false
+		}
+		return o.order(), true
+	}, orderSortValue), nil
+}
+
+func orderSortValue(row interface{}, column string) interface{} {
+	o := row.(Order)
+	switch column {
+	case "created_at":
+		return o.CreatedAt
+	case "customer_id":
+		return o.CustomerID
+	}
+	return o.ID
+}
+
+// order returns the order's details, excluding its lines.
+func (o *memoryOrder) order() Order {
+	return Order{
+		ID:         o.ID,
+		CreatedAt:  o.CreatedAt,
+		CustomerID: o.CustomerID,
+		Status:     o.Status,
+		History:    append([]OrderStatusChange(nil), o.History...),
+	}
+}
+
+func (s *memoryStore) getOrder(ctx context.Context, id int) (*Order, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	o, ok := s.orders[id]
+	if !ok {
+		return nil, nil
+	}
+	order := o.order()
+	for _, l := range o.Lines {
+		p, ok := s.products[l.ProductID]
+		if !ok {
+			continue
+		}
+		p.TypeName = ""
+		line := ProductOrderLine{
+			Product:   p,
+			Amount:    l.Amount,
+			UnitPrice: l.UnitPrice,
+			UnitCost:  l.UnitCost,
+			Total:     l.Amount * l.UnitPrice,
+		}
+		order.Total += line.Total
+		order.Lines = append(order.Lines, line)
+	}
+	order.LineCount = len(order.Lines)
+	return &order, nil
+}
+
+func (s *memoryStore) createOrder(ctx context.Context, customer *Customer, lines []ProductOrderLine) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	requested := make(map[int]int)
+	var productIDs []int
+	for _, line := range lines {
+		if _, ok := requested[line.Product.ID]; !ok {
+			productIDs = append(productIDs, line.Product.ID)
+		}
+		requested[line.Product.ID] += line.Amount
+	}
+	sort.Ints(productIDs)
+
+	var shortages []stockShortage
+	for _, id := range productIDs {
+		p, ok := s.products[id]
+		if !ok {
+			return -1, &unknownProductError{ProductID: id}
+		}
+		if requested[id] > p.Stock {
+			shortages = append(shortages, stockShortage{
+				ProductID: id,
+				Requested: requested[id],
+				Available: p.Stock,
+			})
+		}
+	}
+	if len(shortages) > 0 {
+		return -1, &insufficientStockError{Lines: shortages}
+	}
+	for _, id := range productIDs {
+		p := s.products[id]
+		p.Stock -= requested[id]
+		s.products[id] = p
+	}
+
+	order := s.insertOrder(customer.ID, time.Now())
+	for i, line := range lines {
+		p := s.products[line.Product.ID]
+		lines[i].UnitPrice = p.SellingPrice
+		lines[i].UnitCost = p.Cost
+		order.Lines = append(order.Lines, memoryOrderLine{
+			ProductID: p.ID,
+			Amount:    line.Amount,
+			UnitPrice: p.SellingPrice,
+			UnitCost:  p.Cost,
+		})
+	}
+	return order.ID, nil
+}
+
+// insertOrder inserts a pending order with no lines.
+// The caller must hold s.mu for writing.
+func (s *memoryStore) insertOrder(customerID int, createdAt time.Time) *memoryOrder {
+	createdAt = createdAt.UTC().Truncate(time.Second)
+	order := &memoryOrder{
+		ID:         s.nextOrderID,
+		CustomerID: customerID,
+		CreatedAt:  createdAt,
+		Status:     OrderPending,
+		History:    []OrderStatusChange{{Status: OrderPending, CreatedAt: createdAt}},
+	}
+	s.orders[order.ID] = order
+	s.nextOrderID++
+	return order
+}
+
+func (s *memoryStore) transitionOrder(ctx context.Context, id int, to OrderStatus) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	o, ok := s.orders[id]
+	if !ok {
+		return false, nil
+	}
+	if !o.Status.CanTransition(to) {
+		return false, &invalidTransitionError{From: o.Status, To: to}
+	}
+	if to == OrderCancelled {
+		// Return the order's reserved stock.
+		for _, l := range o.Lines {
+			if p, ok := s.products[l.ProductID]; ok {
+				p.Stock += l.Amount
+				s.products[l.ProductID] = p
+			}
+		}
+	}
+	o.Status = to
+	o.History = append(o.History, OrderStatusChange{
+		Status:    to,
+		CreatedAt: time.Now().UTC().Truncate(time.Second),
+	})
+	return true, nil
+}
+
+// memoryPage sorts rows and returns those in the given page, mirroring
+// the SQL generated by pageRequest. As with the SQL queries, one row
+// more than the limit is returned; see paginate.
+//
+// lookup returns the row with the given ID, regardless of any filter,
+// to obtain the sort value of the previous page's last row. sortValue
+// returns the value of the given column for a row.
+func memoryPage[T any](
+	rows []T, page pageRequest,
+	id func(T) int,
+	lookup func(id int) (interface{}, bool),
+	sortValue func(row interface{}, column string) interface{},
+) []T {
+	compare := func(aValue interface{}, aID int, bValue interface{}, bID int) int {
+		if c := compareValues(aValue, bValue); c != 0 {
+			return c
+		}
+		return compareValues(aID, bID)
+	}
+	value := func(row T) interface{} {
+		if page.Sort == "" {
+			return id(row)
+		}
+		return sortValue(row, page.Sort)
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		c := compare(value(rows[i]), id(rows[i]), value(rows[j]), id(rows[j]))
+		if page.Desc {
+			return c > 0
+		}
+		return c < 0
+	})
+
+	if page.After != nil {
+		lastID := *page.After
+		var lastValue interface{} = lastID
+		if page.Sort != "" {
+			last, ok := lookup(lastID)
+			if !ok {
+				// As with SQL, where the lookup yields NULL.
+				return nil
+			}
+			lastValue = sortValue(last, page.Sort)
+		}
+		i := sort.Search(len(rows), func(i int) bool {
+			c := compare(value(rows[i]), id(rows[i]), lastValue, lastID)
+			if page.Desc {
+				return c < 0
+			}
+			return c > 0
+		})
+		rows = rows[i:]
+	}
+	if page.Limit > 0 && len(rows) > page.Limit+1 {
+		rows = rows[:page.Limit+1]
+	}
+	return rows
+}
+
+// compareValues compares two values of the same type,
+// which must be an int, a string, or a time.Time.
+func compareValues(a, b interface{}) int {
+	switch a := a.(type) {
+	case int:
+		b := b.(int)
+		if a < b {
+			return -1
+		} else if a > b {
+			return 1
+		}
+		return 0
+	case string:
+		return strings.Compare(a, b.(string))
+	case time.Time:
+		b := b.(time.Time)
+		if a.Before(b) {
+			return -1
+		} else if a.After(b) {
+			return 1
+		}
+		return 0
+	}
+	panic(fmt.Sprintf("cannot compare values of type %T", a))
+}
+
+// seed populates the store with the dataset described by cfg,
+// and cfg.Orders generated orders, exactly as opbeansdb.Seed
+// populates a database.
+func (s *memoryStore) seed(cfg opbeansdb.SeedConfig) error {
+	rng := rand.New(rand.NewSource(cfg.Seed))
+	ds, err := opbeansdb.NewDataset(cfg, rng)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, pt := range ds.ProductTypes {
+		s.productTypes[pt.ID] = ProductType{ID: pt.ID, Name: pt.Name}
+	}
+	for _, p := range ds.Products {
+		s.products[p.ID] = Product{
+			ID:           p.ID,
+			SKU:          p.SKU,
+			Name:         p.Name,
+			Description:  p.Description,
+			TypeID:       p.TypeID,
+			Stock:        p.Stock,
+			Cost:         p.Cost,
+			SellingPrice: p.SellingPrice,
+		}
+		if p.ID >= s.nextProductID {
+			s.nextProductID = p.ID + 1
+		}
+	}
+	for _, c := range ds.Customers {
+		s.customers[c.ID] = Customer{
+			ID:          c.ID,
+			FullName:    c.FullName,
+			CompanyName: c.CompanyName,
+			Email:       c.Email,
+			Address:     c.Address,
+			PostalCode:  c.PostalCode,
+			City:        c.City,
+			Country:     c.Country,
+		}
+		if c.ID >= s.nextCustomerID {
+			s.nextCustomerID = c.ID + 1
+		}
+	}
+
+	gen := opbeansdb.NewOrderGenerator(ds.Products, ds.CustomerIDs(), rng, cfg.Time.Add(-cfg.Window), cfg.Time, cfg.Orders)
+	for {
+		generated, ok := gen.Next()
+		if !ok {
+			break
+		}
+		order := s.insertOrder(generated.CustomerID, generated.CreatedAt)
+		for _, l := range generated.Lines {
+			order.Lines = append(order.Lines, memoryOrderLine(l))
+		}
+	}
+	return nil
+}